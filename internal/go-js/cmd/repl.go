@@ -1,9 +1,13 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"strings"
 
 	"github.com/chzyer/readline"
@@ -223,8 +227,21 @@ func runtimeREPL() {
 	}
 	defer rl.Close()
 
-	rt := runtime.NewRuntime()
-	realm := runtime.NewRealm(rt)
+	// Inputs can require modules relative to the working directory.
+	loader := runtime.NewCommonJSLoader(os.DirFS("/"))
+	dir, err := os.Getwd()
+	if err != nil {
+		dir = "/"
+	}
+
+	newRealm := func() (*runtime.Runtime, *runtime.Realm) {
+		rt := runtime.NewRuntime()
+		rt.HTTPTransport = http.DefaultTransport
+		realm := runtime.NewRealm(rt)
+		runtime.DefineRequire(rt, realm, loader, filepath.ToSlash(dir))
+		return rt, realm
+	}
+	rt, realm := newRealm()
 
 	for {
 		input, err := rl.Readline()
@@ -241,9 +258,14 @@ func runtimeREPL() {
 			fmt.Printf("SyntaxError: %v\n", err)
 			continue
 		}
-		result := script.Evaluate(rt)
 
-		if result.Type == runtime.Throw {
+		// Ctrl+C stops a long-running input instead of exiting the REPL.
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		result := script.EvaluateContext(ctx, rt)
+
+		if result.Type == runtime.Terminate {
+			fmt.Printf("Error: %v\n", result.Value)
+		} else if result.Type == runtime.Throw {
 			jsError, ok := result.Value.(*runtime.JavaScriptValue)
 			if !ok {
 				panic("Assert failed: Expected a JavaScript value for the thrown error.")
@@ -251,20 +273,20 @@ func runtimeREPL() {
 
 			fmt.Println(runtime.ErrorToString(rt, jsError))
 		} else if result.Value != nil {
-			// Converting to a string may throw an error.
-			// For example, a reference to a non-existent property.
-			valueString, err := result.Value.(*runtime.JavaScriptValue).ToString(rt)
-			if err != nil {
-				fmt.Println(runtime.ErrorToString(rt, err))
-			} else if valueString != "undefined" {
-				fmt.Println(valueString)
-			}
+			printResult(rt, result.Value.(*runtime.JavaScriptValue))
+		}
+
+		// Run the jobs and timers queued by the input, such as promise reactions.
+		if jobsResult := rt.RunEventLoopContext(ctx); jobsResult.Type == runtime.Terminate {
+			fmt.Printf("Error: %v\n", jobsResult.Value)
+		} else if jobsResult.Type == runtime.Throw {
+			fmt.Println(runtime.ErrorToString(rt, jobsResult.Value.(*runtime.JavaScriptValue)))
 		}
+		stop()
 
 		// Reset the realm and runtime if the isolated flag is enabled.
 		if isolated {
-			rt = runtime.NewRuntime()
-			realm = runtime.NewRealm(rt)
+			rt, realm = newRealm()
 		}
 	}
 }
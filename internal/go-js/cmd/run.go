@@ -1,9 +1,13 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"zbrannelly.dev/go-js/pkg/lib-js/parser"
@@ -12,6 +16,9 @@ import (
 )
 
 var (
+	timeout  time.Duration
+	maxSteps uint64
+
 	runCmd = &cobra.Command{
 		Use:   "run [file]",
 		Short: "Run a JavaScript file",
@@ -40,6 +47,8 @@ var (
 func init() {
 	rootCmd.AddCommand(runCmd)
 	runCmd.Flags().StringVarP(&modeStr, "mode", "m", "runtime", "The mode to run the script in: parser, runtime")
+	runCmd.Flags().DurationVarP(&timeout, "timeout", "t", 0, "Stop the script after this long, e.g. 5s (0 means no limit)")
+	runCmd.Flags().Uint64Var(&maxSteps, "max-steps", 0, "Stop the script after this many statements, loop iterations and calls (0 means no limit)")
 }
 
 func parseFile(filePath string) {
@@ -84,7 +93,15 @@ func runFile(filePath string) {
 
 	// Parse the script.
 	rt := runtime.NewRuntime()
+	rt.HTTPTransport = http.DefaultTransport
 	realm := runtime.NewRealm(rt)
+
+	// The script can require modules relative to its own directory.
+	if absPath, err := filepath.Abs(filePath); err == nil {
+		loader := runtime.NewCommonJSLoader(os.DirFS("/"))
+		runtime.DefineRequire(rt, realm, loader, filepath.ToSlash(filepath.Dir(absPath)))
+	}
+
 	script, err := runtime.ParseScript(string(content), realm)
 
 	// TODO: Make ParseScript return SyntaxError objects (NativeError objects).
@@ -93,10 +110,22 @@ func runFile(filePath string) {
 		os.Exit(1)
 	}
 
+	rt.SetStepBudget(maxSteps)
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
 	// Evaluate the script.
-	result := script.Evaluate(rt)
+	result := script.EvaluateContext(ctx, rt)
 
-	if result.Type == runtime.Throw {
+	if result.Type == runtime.Terminate {
+		fmt.Printf("Error: %v\n", result.Value)
+		os.Exit(1)
+	} else if result.Type == runtime.Throw {
 		jsError, ok := result.Value.(*runtime.JavaScriptValue)
 		if !ok {
 			panic("Assert failed: Expected a JavaScript value for the thrown error.")
@@ -104,13 +133,42 @@ func runFile(filePath string) {
 		fmt.Println(runtime.ErrorToString(rt, jsError))
 		os.Exit(1)
 	} else if result.Value != nil {
-		// Converting to a string may throw an error.
-		// For example, a reference to a non-existent property.
-		valueString, err := result.Value.(*runtime.JavaScriptValue).ToString(rt)
-		if err != nil {
-			fmt.Println(runtime.ErrorToString(rt, err))
-		} else if valueString != "undefined" {
-			fmt.Println(valueString)
+		printResult(rt, result.Value.(*runtime.JavaScriptValue))
+	}
+
+	// Turn the event loop until no promise reactions, timers or host operations are left.
+	result = rt.RunEventLoopContext(ctx)
+	if result.Type == runtime.Terminate {
+		fmt.Printf("Error: %v\n", result.Value)
+		os.Exit(1)
+	} else if result.Type == runtime.Throw {
+		fmt.Println(runtime.ErrorToString(rt, result.Value.(*runtime.JavaScriptValue)))
+		os.Exit(1)
+	}
+}
+
+// printResult prints the completion value of a script, unless it is undefined. Values are described with
+// runtime.Inspect, in color when standard output is a terminal.
+func printResult(rt *runtime.Runtime, value *runtime.JavaScriptValue) {
+	// Resolving a reference may throw an error.
+	// For example, a reference to a non-existent property.
+	if value.Type == runtime.TypeReference {
+		completion := runtime.GetValue(rt, value)
+		if completion.Type != runtime.Normal {
+			fmt.Println(runtime.ErrorToString(rt, completion.Value.(*runtime.JavaScriptValue)))
+			return
 		}
+		value = completion.Value.(*runtime.JavaScriptValue)
 	}
+
+	if value.Type == runtime.TypeUndefined {
+		return
+	}
+
+	options := runtime.DefaultInspectOptions()
+	if info, err := os.Stdout.Stat(); err == nil && info.Mode()&os.ModeCharDevice != 0 {
+		options.Colors = true
+	}
+
+	fmt.Println(runtime.Inspect(rt, value, options))
 }
@@ -0,0 +1,104 @@
+package gojs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+const byteListSource = `
+	function bytes(buffer) {
+		const view = new Uint8Array(buffer);
+		const out = [];
+		for (let i = 0; i < view.length; i++) out.push(view[i]);
+		return out.join();
+	}
+`
+
+func TestArrayBufferPrototype(t *testing.T) {
+	vm := New()
+	run(t, vm, byteListSource+`
+		var buffer = new ArrayBuffer(8);
+		var view = new Uint8Array(buffer);
+		for (let i = 0; i < 8; i++) view[i] = i + 1;
+		var resizable = new ArrayBuffer(4, { maxByteLength: 8 });
+		var transferred = resizable.transfer(6);
+		var transferredState = [transferred.byteLength, transferred.resizable, transferred.maxByteLength].join();
+		var fixed = transferred.transferToFixedLength();
+	`)
+
+	tests := map[string]any{
+		`bytes(buffer.slice(2, -2))`:                                       "3,4,5,6",
+		`buffer.slice(-3).byteLength`:                                      int64(3),
+		`buffer.slice(5, 2).byteLength`:                                    int64(0),
+		`view[0] = 9; bytes(buffer.slice(0, 1))`:                           "9",
+		`[resizable.detached, resizable.byteLength].join()`:                "true,0",
+		`transferredState`:                                                 "6,true,8",
+		`[transferred.detached, fixed.resizable, fixed.byteLength].join()`: "true,false,6",
+		`ArrayBuffer.isView(new DataView(buffer))`:                         true,
+		`ArrayBuffer.isView(new Uint8Array(2))`:                            true,
+		`ArrayBuffer.isView(buffer)`:                                       false,
+		`ArrayBuffer.isView()`:                                             false,
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+
+	for _, source := range []string{`resizable.slice(0)`, `resizable.transfer()`, `transferred.resize(2)`} {
+		_, err := vm.RunString(source)
+		assert.ErrorContains(t, err, "TypeError", source)
+	}
+}
+
+func TestArrayBufferSpecies(t *testing.T) {
+	vm := New()
+	run(t, vm, `
+		class MyBuffer extends ArrayBuffer {}
+		class ShortBuffer extends ArrayBuffer {
+			static get [Symbol.species]() {
+				return function () { return new ArrayBuffer(1); };
+			}
+		}
+		class SameBuffer extends ArrayBuffer {
+			static get [Symbol.species]() {
+				const self = this;
+				return function () { return self.instance; };
+			}
+		}
+	`)
+
+	assert.Equal(t, true, run(t, vm, `ArrayBuffer[Symbol.species] === ArrayBuffer`).Export())
+	assert.Equal(t, true, run(t, vm, `new MyBuffer(4).slice(1) instanceof MyBuffer`).Export())
+
+	_, err := vm.RunString(`new ShortBuffer(4).slice(0, 4)`)
+	assert.ErrorContains(t, err, "TypeError")
+	_, err = vm.RunString(`SameBuffer.instance = new SameBuffer(4); SameBuffer.instance.slice(0)`)
+	assert.ErrorContains(t, err, "TypeError")
+}
+
+func TestArrayBufferFromBytes(t *testing.T) {
+	vm := New()
+	run(t, vm, byteListSource)
+
+	data := []byte{1, 2, 3, 4}
+	vm.inRealm(func() *runtime.Completion {
+		buffer := runtime.NewArrayBufferFromBytes(vm.runtime, data)
+		global := runtime.NewJavaScriptValue(runtime.TypeObject, vm.realm.GlobalObject)
+		return vm.realm.GlobalObject.Set(vm.runtime, runtime.NewStringValue("shared"), buffer, global)
+	})
+
+	// The buffer and the slice share memory, in both directions.
+	assert.Equal(t, "1,2,3,4", run(t, vm, `bytes(shared)`).Export())
+	data[0] = 10
+	assert.Equal(t, "10,2,3,4", run(t, vm, `bytes(shared)`).Export())
+	run(t, vm, `new Uint8Array(shared)[3] = 40;`)
+	assert.Equal(t, []byte{10, 2, 3, 40}, data)
+	assert.Equal(t, false, run(t, vm, `shared.resizable`).Export())
+
+	// Once transferred, the buffer is detached and no longer tied to the slice.
+	run(t, vm, `var moved = shared.transfer(); new Uint8Array(moved)[1] = 20;`)
+	assert.Equal(t, true, run(t, vm, `shared.detached && shared.byteLength === 0`).Export())
+	assert.Equal(t, "10,20,3,40", run(t, vm, `bytes(moved)`).Export())
+}
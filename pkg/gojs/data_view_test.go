@@ -0,0 +1,82 @@
+package gojs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataViewByteOrder(t *testing.T) {
+	vm := New()
+	run(t, vm, `var view = new DataView(new ArrayBuffer(8));`)
+
+	tests := map[string]any{
+		`view.setUint16(0, 0x1234); [view.getUint8(0), view.getUint8(1)].join()`:            "18,52",
+		`view.setUint16(0, 0x1234); view.getUint16(0, true)`:                                int64(0x3412),
+		`view.setUint32(0, 0x01020304, true); [view.getUint8(0), view.getUint32(0)].join()`: "4,67305985",
+		`view.setFloat64(0, 3.25, true); view.getFloat64(0, true)`:                          3.25,
+		`view.setFloat64(0, 3.25); view.getFloat64(0)`:                                      3.25,
+		`view.setInt8(0, -1); view.getUint8(0)`:                                             int64(255),
+		`view.setBigInt64(0, BigInt(-2)); String(view.getBigUint64(0, true))`:               "18374686479671623679",
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+}
+
+func TestDataViewFloat16(t *testing.T) {
+	vm := New()
+	run(t, vm, `var view = new DataView(new ArrayBuffer(4));`)
+
+	tests := map[string]any{
+		`view.setFloat16(0, 1.5); view.getUint16(0)`:            int64(0x3e00),
+		`view.setFloat16(0, 1.5); view.getFloat16(0)`:           1.5,
+		`view.setFloat16(0, 1.5, true); view.getUint8(1)`:       int64(0x3e),
+		`view.setFloat16(0, 65520); String(view.getFloat16(0))`: "Infinity",
+		`view.setFloat16(0, 1 + 2 ** -11); view.getFloat16(0)`:  int64(1),
+		`view.setUint16(0, 0x7e00); String(view.getFloat16(0))`: "NaN",
+
+		// Numbers are rounded straight to binary16, not through float32, so values just above halfway round up.
+		`view.setFloat16(0, 1 + 2 ** -11 + 2 ** -24); view.getFloat16(0)`:    1.0009765625,
+		`view.setFloat16(0, -(1 + 2 ** -11 + 2 ** -24)); view.getFloat16(0)`: -1.0009765625,
+		`view.setFloat16(0, 1 + 3 * 2 ** -11); view.getUint16(0)`:            int64(0x3c02),
+		`view.setFloat16(0, 2 ** -25); view.getUint16(0)`:                    int64(0),
+		`view.setFloat16(0, 2 ** -25 + 2 ** -40); view.getUint16(0)`:         int64(1),
+		`view.setFloat16(0, 65519.99); view.getFloat16(0)`:                   int64(65504),
+		`new Float16Array([1 + 2 ** -11 + 2 ** -24])[0]`:                     1.0009765625,
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+}
+
+func TestDataViewOutOfBounds(t *testing.T) {
+	vm := New()
+	run(t, vm, `
+		var buffer = new ArrayBuffer(4, { maxByteLength: 16 });
+		var tracking = new DataView(buffer);
+		var fixed = new DataView(buffer, 0, 4);
+		var detachable = new ArrayBuffer(4);
+		var detached = new DataView(detachable);
+		detachable.transfer();
+	`)
+
+	assert.Equal(t, "12,4", run(t, vm, `buffer.resize(12); [tracking.byteLength, fixed.byteLength].join()`).Export())
+	assert.Equal(t, int64(2), run(t, vm, `buffer.resize(2); tracking.byteLength`).Export())
+
+	for _, source := range []string{
+		`fixed.getUint8(0)`,
+		`fixed.byteLength`,
+		`detached.getUint8(0)`,
+		`detached.byteLength`,
+		`new DataView(detachable)`,
+	} {
+		_, err := vm.RunString(source)
+		assert.ErrorContains(t, err, "TypeError", source)
+	}
+
+	_, err := vm.RunString(`new DataView(new ArrayBuffer(8)).getInt32(6)`)
+	assert.ErrorContains(t, err, "RangeError")
+}
@@ -0,0 +1,53 @@
+package gojs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateAndSuppressedError(t *testing.T) {
+	vm := New()
+
+	tests := map[string]any{
+		`new AggregateError([1, 2], "many").errors.length`:                         int64(2),
+		`new AggregateError([new TypeError("x")].values()).errors[0].message`:      "x",
+		`new AggregateError([], "many", { cause: "why" }).cause`:                   "why",
+		`new AggregateError([]) instanceof Error`:                                  true,
+		`Object.getPrototypeOf(AggregateError) === Error`:                          true,
+		`AggregateError.prototype.name + " " + AggregateError.length`:              "AggregateError 2",
+		`Object.keys(new AggregateError([1])).length`:                              int64(0),
+		`new SuppressedError(1, 2, "failed").error`:                                int64(1),
+		`new SuppressedError(1, 2, "failed").suppressed`:                           int64(2),
+		`String(new SuppressedError(1, 2, "failed"))`:                              "SuppressedError: failed",
+		`SuppressedError.length`:                                                   int64(3),
+		`Error.isError(new AggregateError([])) && Error.isError(new RangeError())`: true,
+		`Error.isError({ name: "Error", message: "" })`:                            false,
+		`Error.isError(Object.create(Error.prototype))`:                            false,
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+
+	_, err := vm.RunString(`new AggregateError(1)`)
+	assert.ErrorContains(t, err, "TypeError")
+}
+
+func TestErrorCaptureStackTrace(t *testing.T) {
+	vm := New()
+
+	run(t, vm, `
+		function inner() { const target = { message: "custom" }; Error.captureStackTrace(target, inner); return target; }
+		function outer() { return inner(); }
+		function deep(n) { return n ? deep(n - 1) : new Error("deep"); }
+	`)
+
+	assert.Equal(t, "Error: custom\n    at outer\n    at <anonymous>", run(t, vm, `outer().stack`).Export())
+	assert.Equal(t, int64(10), run(t, vm, `Error.stackTraceLimit`).Export())
+	assert.Equal(t, "Error: deep\n    at deep\n    at deep", run(t, vm, `Error.stackTraceLimit = 2; deep(5).stack`).Export())
+	assert.Equal(t, false, run(t, vm, `Error.stackTraceLimit = undefined; "stack" in deep(1)`).Export())
+
+	_, err := vm.RunString(`Error.captureStackTrace(1)`)
+	assert.ErrorContains(t, err, "TypeError")
+}
@@ -0,0 +1,39 @@
+package gojs
+
+import (
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+// Exception is the error returned when JavaScript code throws. It wraps the thrown value.
+type Exception struct {
+	value Value
+}
+
+func (vm *VM) newException(value *runtime.JavaScriptValue) *Exception {
+	return &Exception{value: vm.wrap(value)}
+}
+
+// completionError returns the error for an abrupt completion: an *Exception if it threw, or the
+// *runtime.InterruptedError if execution was interrupted. It returns nil for other completions.
+func (vm *VM) completionError(completion *runtime.Completion) error {
+	switch completion.Type {
+	case runtime.Throw:
+		return vm.newException(completion.Value.(*runtime.JavaScriptValue))
+	case runtime.Terminate:
+		return completion.Value.(*runtime.InterruptedError)
+	}
+	return nil
+}
+
+// Value returns the value that was thrown.
+func (e *Exception) Value() Value {
+	return e.value
+}
+
+// Error describes the thrown value, using its toString method for Error objects (e.g. "TypeError: x is not a function").
+func (e *Exception) Error() string {
+	if e.value.value != nil && e.value.value.Type == runtime.TypeObject {
+		return runtime.ErrorToString(e.value.vm.runtime, e.value.value)
+	}
+	return e.value.String()
+}
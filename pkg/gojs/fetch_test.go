@@ -0,0 +1,330 @@
+package gojs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+func newFetchTestServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Add("Set-Cookie", "a=1")
+		w.Header().Add("Set-Cookie", "b=2")
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"method":"`+r.Method+`","token":"`+r.Header.Get("X-Token")+`","type":"`+r.Header.Get("Content-Type")+`","body":"`+string(body)+`","list":[1,2.5,null,true]}`)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/echo", http.StatusFound)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	mux.HandleFunc("/stream", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "partial")
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		chunk := strings.Repeat("x", 1<<10)
+		for range 8 << 10 {
+			if _, err := io.WriteString(w, chunk); err != nil {
+				return
+			}
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFetch(t *testing.T) {
+	server := newFetchTestServer(t)
+
+	vm := New()
+	vm.SetHTTPTransport(server.Client().Transport)
+	require.NoError(t, vm.Set("base", server.URL))
+
+	_, err := vm.RunString(`
+		var result = {};
+		fetch(base + "/echo", { method: "post", headers: { "X-Token": "abc" }, body: "hello" })
+			.then(function (response) {
+				result.response = response;
+				result.contentType = response.headers.get("content-type");
+				result.cookies = response.headers.getSetCookie();
+				result.bodyUsed = response.bodyUsed;
+				return response.json();
+			})
+			.then(function (data) {
+				result.data = data;
+			});
+	`)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(201), run(t, vm, `result.response.status`).Export())
+	assert.Equal(t, true, run(t, vm, `result.response.ok`).Export())
+	assert.Equal(t, "Created", run(t, vm, `result.response.statusText`).Export())
+	assert.Equal(t, "application/json", run(t, vm, `result.contentType`).Export())
+	assert.Equal(t, []any{"a=1", "b=2"}, run(t, vm, `result.cookies`).Export())
+	assert.Equal(t, false, run(t, vm, `result.response.redirected`).Export())
+	assert.Equal(t, false, run(t, vm, `result.bodyUsed`).Export())
+	assert.Equal(t, true, run(t, vm, `result.response.bodyUsed`).Export())
+
+	assert.Equal(t, "POST", run(t, vm, `result.data.method`).Export())
+	assert.Equal(t, "abc", run(t, vm, `result.data.token`).Export())
+	assert.Equal(t, "text/plain;charset=UTF-8", run(t, vm, `result.data.type`).Export())
+	assert.Equal(t, "hello", run(t, vm, `result.data.body`).Export())
+	assert.Equal(t, []any{int64(1), 2.5, nil, true}, run(t, vm, `result.data.list`).Export())
+
+	_, err = vm.RunString(`
+		result = {};
+		fetch(new Request(base + "/redirect"))
+			.then(function (response) {
+				result.response = response;
+				var clone = response.clone();
+				return response.text().then(function (text) {
+					result.text = text;
+					return clone.arrayBuffer();
+				});
+			})
+			.then(function (buffer) {
+				result.buffer = buffer;
+				return fetch(base + "/redirect", { redirect: "error" });
+			})
+			.catch(function (error) {
+				result.error = error;
+			});
+	`)
+	require.NoError(t, err)
+
+	assert.Equal(t, true, run(t, vm, `result.response.redirected`).Export())
+	assert.Equal(t, server.URL+"/echo", run(t, vm, `result.response.url`).Export())
+	assert.NotEmpty(t, run(t, vm, `result.text`).Export())
+	assert.Equal(t, true, run(t, vm, `result.response.bodyUsed`).Export())
+	assert.Equal(t, run(t, vm, `result.text.length`).Export(), run(t, vm, `result.buffer.byteLength`).Export())
+	assert.Equal(t, "TypeError", run(t, vm, `result.error.name`).Export())
+}
+
+func TestFetchAbort(t *testing.T) {
+	server := newFetchTestServer(t)
+
+	vm := New()
+	vm.SetHTTPTransport(server.Client().Transport)
+	require.NoError(t, vm.Set("base", server.URL))
+
+	_, err := vm.RunString(`
+		var result = {};
+		var controller = new AbortController();
+		controller.signal.addEventListener("abort", function (event) {
+			result.event = event.type;
+		});
+		fetch(base + "/slow", { signal: controller.signal }).catch(function (error) {
+			result.error = error.name;
+		});
+		controller.abort();
+
+		fetch(base + "/echo", { signal: AbortSignal.abort("stop") }).catch(function (reason) {
+			result.reason = reason;
+		});
+	`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "abort", run(t, vm, `result.event`).Export())
+	assert.Equal(t, true, run(t, vm, `controller.signal.aborted`).Export())
+	assert.Equal(t, "AbortError", run(t, vm, `result.error`).Export())
+	assert.Equal(t, "stop", run(t, vm, `result.reason`).Export())
+}
+
+// Terminating a script cancels its requests and the reading of their bodies, so later scripts don't wait for them and
+// their callbacks never run.
+func TestFetchTerminated(t *testing.T) {
+	server := newFetchTestServer(t)
+
+	var mutex sync.Mutex
+	var requests []context.Context
+	transport := server.Client().Transport
+
+	vm := New()
+	vm.SetHTTPTransport(roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		mutex.Lock()
+		requests = append(requests, request.Context())
+		mutex.Unlock()
+		return transport.RoundTrip(request)
+	}))
+	require.NoError(t, vm.Set("base", server.URL))
+	run(t, vm, `var late = [];`)
+
+	for _, source := range []string{
+		`fetch(base + "/slow").then(() => late.push("response"), () => late.push("rejected"));`,
+		`fetch(base + "/stream").then(response => response.text()).then(() => late.push("body"), () => late.push("rejected"));`,
+	} {
+		runWithin(t, 5*time.Second, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			_, err := vm.RunStringContext(ctx, source)
+			assert.True(t, errors.Is(err, context.DeadlineExceeded), source)
+
+			start := time.Now()
+			assert.Equal(t, int64(42), run(t, vm, `42`).Export())
+			assert.Less(t, time.Since(start), time.Second)
+		})
+
+		mutex.Lock()
+		request := requests[len(requests)-1]
+		mutex.Unlock()
+		assert.Error(t, request.Err(), source)
+	}
+
+	require.NoError(t, vm.RunEventLoop())
+	assert.Equal(t, []any{}, run(t, vm, `late`).Export())
+
+	// The runtime can still fetch once the terminated requests are gone.
+	run(t, vm, `fetch(base + "/echo").then(response => late.push(response.status));`)
+	assert.Equal(t, []any{int64(201)}, run(t, vm, `late`).Export())
+}
+
+// Bodies are read no further than the longest result the script may hold, and rejected with a RangeError if they are
+// longer.
+func TestFetchBodyLimits(t *testing.T) {
+	server := newFetchTestServer(t)
+
+	vm := New()
+	vm.SetHTTPTransport(server.Client().Transport)
+	vm.SetResourceLimits(runtime.ResourceLimits{MaxStringLength: 1 << 20, MaxArrayBufferByteLength: 1 << 20})
+	require.NoError(t, vm.Set("base", server.URL))
+
+	_, err := vm.RunString(`
+		var results = [];
+		for (const method of ["arrayBuffer", "bytes", "text", "json"]) {
+			fetch(base + "/large")
+				.then(response => response[method]())
+				.then(() => results.push(method + ": read"), error => results.push(method + ": " + error.name));
+		}
+	`)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []any{"arrayBuffer: RangeError", "bytes: RangeError", "text: RangeError", "json: RangeError"}, run(t, vm, `results`).Export())
+
+	_, err = vm.RunString(`
+		var result = {};
+		fetch(base + "/echo").then(response => response.bytes()).then(bytes => result.bytes = bytes);
+		new Response("x".repeat(1 << 19)).text().then(text => result.text = text);
+	`)
+	require.NoError(t, err)
+	assert.Greater(t, run(t, vm, `result.bytes.length`).Export(), int64(0))
+	assert.Equal(t, int64(1<<19), run(t, vm, `result.text.length`).Export())
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func TestFetchWithoutTransport(t *testing.T) {
+	vm := New()
+
+	_, err := vm.RunString(`
+		var result;
+		fetch("https://example.com/").catch(function (error) {
+			result = error.name;
+		});
+	`)
+	require.NoError(t, err)
+	assert.Equal(t, "TypeError", run(t, vm, `result`).Export())
+}
+
+func TestHeadersRequestResponse(t *testing.T) {
+	vm := New()
+
+	_, err := vm.RunString(`
+		var result = {};
+		var headers = new Headers([["B", "2"], ["a", " 1 "]]);
+		headers.append("b", "3");
+
+		result.entries = [];
+		for (var pair of headers) {
+			result.entries.push(pair[0] + "=" + pair[1]);
+		}
+
+		try {
+			headers.set("bad name", "x");
+		} catch (error) {
+			result.invalidNameError = error.name;
+		}
+	`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2, 3", run(t, vm, `headers.get("b")`).Export())
+	assert.Equal(t, true, run(t, vm, `headers.has("A")`).Export())
+	assert.Equal(t, []any{"a=1", "b=2, 3"}, run(t, vm, `result.entries`).Export())
+	assert.Equal(t, "TypeError", run(t, vm, `result.invalidNameError`).Export())
+
+	_, err = vm.RunString(`
+		var request = new Request("https://example.com/a#frag", { method: "put", body: new URLSearchParams({ q: "1" }) });
+
+		try {
+			new Request("https://example.com/", { body: "x" });
+		} catch (error) {
+			result.bodyWithGetError = error.name;
+		}
+	`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "PUT", run(t, vm, `request.method`).Export())
+	assert.Equal(t, "https://example.com/a#frag", run(t, vm, `request.url`).Export())
+	assert.Equal(t, "application/x-www-form-urlencoded;charset=UTF-8", run(t, vm, `request.headers.get("content-type")`).Export())
+	assert.Equal(t, "TypeError", run(t, vm, `result.bodyWithGetError`).Export())
+
+	_, err = vm.RunString(`
+		var response = new Response("text", { status: 404, headers: { "X-A": "b" } });
+		response.text().then(function (text) {
+			result.text = text;
+			return response.text();
+		}).catch(function (error) {
+			result.rereadError = error.name;
+		});
+
+		var redirect = Response.redirect("https://example.com/next", 301);
+		try {
+			redirect.headers.set("x", "y");
+		} catch (error) {
+			result.immutableError = error.name;
+		}
+	`)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(404), run(t, vm, `response.status`).Export())
+	assert.Equal(t, false, run(t, vm, `response.ok`).Export())
+	assert.Equal(t, "b", run(t, vm, `response.headers.get("x-a")`).Export())
+	assert.Equal(t, "default", run(t, vm, `response.type`).Export())
+	assert.Equal(t, "text", run(t, vm, `result.text`).Export())
+	assert.Equal(t, true, run(t, vm, `response.bodyUsed`).Export())
+	assert.Equal(t, "TypeError", run(t, vm, `result.rereadError`).Export())
+
+	assert.Equal(t, int64(301), run(t, vm, `redirect.status`).Export())
+	assert.Equal(t, "https://example.com/next", run(t, vm, `redirect.headers.get("location")`).Export())
+	assert.Equal(t, "TypeError", run(t, vm, `result.immutableError`).Export())
+	assert.Equal(t, "error", run(t, vm, `Response.error().type`).Export())
+}
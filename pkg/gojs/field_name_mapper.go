@@ -0,0 +1,72 @@
+package gojs
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FieldNameMapper decides the JavaScript property names of the exported fields and methods of Go structs.
+// Returning an empty string hides the field or method from scripts.
+type FieldNameMapper interface {
+	FieldName(t reflect.Type, field reflect.StructField) string
+	MethodName(t reflect.Type, method reflect.Method) string
+}
+
+type defaultFieldNameMapper struct{}
+
+func (defaultFieldNameMapper) FieldName(t reflect.Type, field reflect.StructField) string {
+	return field.Name
+}
+
+func (defaultFieldNameMapper) MethodName(t reflect.Type, method reflect.Method) string {
+	return method.Name
+}
+
+type tagFieldNameMapper struct {
+	tagName      string
+	uncapMethods bool
+}
+
+// TagFieldNameMapper names fields after the struct tag tagName, like encoding/json does: the tag value up to the first
+// comma is the name, "-" hides the field and an empty name keeps the Go field name. If uncapMethods is true, method
+// names start with a lower case letter.
+func TagFieldNameMapper(tagName string, uncapMethods bool) FieldNameMapper {
+	return tagFieldNameMapper{tagName: tagName, uncapMethods: uncapMethods}
+}
+
+func (m tagFieldNameMapper) FieldName(t reflect.Type, field reflect.StructField) string {
+	tag, ok := field.Tag.Lookup(m.tagName)
+	if !ok {
+		return field.Name
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+func (m tagFieldNameMapper) MethodName(t reflect.Type, method reflect.Method) string {
+	if !m.uncapMethods {
+		return method.Name
+	}
+
+	first, size := utf8.DecodeRuneInString(method.Name)
+	return string(unicode.ToLower(first)) + method.Name[size:]
+}
+
+// SetFieldNameMapper changes how struct fields and methods are named in scripts. Passing nil restores the default,
+// which uses the Go names unchanged. Objects that were already handed to scripts keep the names they had.
+func (vm *VM) SetFieldNameMapper(mapper FieldNameMapper) {
+	if mapper == nil {
+		mapper = defaultFieldNameMapper{}
+	}
+	vm.fieldNameMapper = mapper
+	vm.structTypes = make(map[reflect.Type]*structType)
+}
@@ -0,0 +1,294 @@
+// Package gojs is a high-level API for embedding the JavaScript engine in Go programs.
+//
+//	vm := gojs.New()
+//	vm.Set("greeting", "hello")
+//	result, err := vm.RunString(`greeting + " world"`)
+//	fmt.Println(result.Export()) // hello world
+//
+// A VM is not safe for concurrent use, use one VM per goroutine. The exception is Interrupt, which other goroutines
+// may call to stop a running script.
+package gojs
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"reflect"
+	"time"
+
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+type VM struct {
+	runtime *runtime.Runtime
+	realm   *runtime.Realm
+
+	fieldNameMapper FieldNameMapper
+	structTypes     map[reflect.Type]*structType
+
+	stepBudget uint64
+	steps      uint64
+
+	loader *runtime.CommonJSLoader
+}
+
+// New creates a VM with a fresh runtime and realm.
+func New() *VM {
+	rt := runtime.NewRuntime()
+	return &VM{
+		runtime:         rt,
+		realm:           runtime.NewRealm(rt),
+		fieldNameMapper: defaultFieldNameMapper{},
+		structTypes:     make(map[reflect.Type]*structType),
+	}
+}
+
+// NewRealm returns a VM that shares this VM's runtime, with its jobs, timers and limits, but evaluates scripts in a new
+// realm with its own global object and intrinsics, holding only the globals options selects. Values can be passed
+// between the two with Get and Set: functions keep running in the realm they were created in, so a sandbox can be
+// handed callbacks without being given the globals they use.
+func (vm *VM) NewRealm(options runtime.RealmOptions) *VM {
+	return &VM{
+		runtime:         vm.runtime,
+		realm:           vm.runtime.NewRealm(options),
+		fieldNameMapper: vm.fieldNameMapper,
+		structTypes:     make(map[reflect.Type]*structType),
+		stepBudget:      vm.stepBudget,
+	}
+}
+
+// Runtime returns the underlying runtime, for use with the lower-level runtime package.
+func (vm *VM) Runtime() *runtime.Runtime {
+	return vm.runtime
+}
+
+// Realm returns the realm scripts are evaluated in.
+func (vm *VM) Realm() *runtime.Realm {
+	return vm.realm
+}
+
+// RunString evaluates source as a script and runs the jobs it queued, such as promise reactions.
+// It returns the completion value of the script, or an *Exception if the script threw.
+// Syntax errors are reported as an *Exception wrapping a SyntaxError. If the engine panics, e.g. on a feature it doesn't
+// implement yet, the error is a *runtime.InterruptedError whose reason is the recovered *runtime.PanicError.
+func (vm *VM) RunString(source string) (Value, error) {
+	return vm.RunStringContext(context.Background(), source)
+}
+
+// RunStringContext is like RunString, but stops the script when ctx is done. The returned error is then a
+// *runtime.InterruptedError that wraps the cause of ctx, e.g. context.DeadlineExceeded.
+func (vm *VM) RunStringContext(ctx context.Context, source string) (Value, error) {
+	vm.runtime.ResetPeakResourceUsage()
+	vm.runtime.SetStepBudget(vm.stepBudget)
+
+	start := vm.runtime.Steps()
+	defer func() { vm.steps = vm.runtime.Steps() - start }()
+
+	result := vm.undefined()
+	completion := vm.runtime.ExecuteContext(ctx, func() *runtime.Completion {
+		script, err := runtime.ParseScript(source, vm.realm)
+		if err != nil {
+			return runtime.NewThrowCompletion(runtime.NewSyntaxError(vm.runtime, err.Error()))
+		}
+
+		completion := script.Evaluate(vm.runtime)
+		if completion.Type != runtime.Normal {
+			return completion
+		}
+
+		result = vm.wrap(completion.Value.(*runtime.JavaScriptValue))
+		return vm.runtime.RunJobs()
+	})
+
+	return result, vm.completionError(completion)
+}
+
+// RunEventLoop runs the timers scripts scheduled with setTimeout, setInterval and setImmediate, and the jobs they
+// queue, until none are left. It returns an *Exception if a callback threw.
+func (vm *VM) RunEventLoop() error {
+	return vm.RunEventLoopContext(context.Background())
+}
+
+// RunEventLoopContext is like RunEventLoop, but stops when ctx is done, e.g. to bound scripts that use setInterval.
+// The returned error is then a *runtime.InterruptedError that wraps the cause of ctx.
+func (vm *VM) RunEventLoopContext(ctx context.Context) error {
+	return vm.completionError(vm.runtime.RunEventLoopContext(ctx))
+}
+
+// CollectGarbage runs the Go garbage collector and then the cleanup callbacks of FinalizationRegistries whose targets
+// were collected, so WeakRefs and FinalizationRegistries behave deterministically in tests. It returns an *Exception
+// if a cleanup callback threw.
+func (vm *VM) CollectGarbage() error {
+	vm.runtime.CollectGarbage()
+	return vm.completionError(vm.runtime.RunJobs())
+}
+
+// Interrupt stops the running script with a *runtime.InterruptedError carrying reason, which the script can't catch.
+// It is safe to call from any goroutine. If no script is running, the next one is interrupted when it starts.
+func (vm *VM) Interrupt(reason any) {
+	vm.runtime.Interrupt(reason)
+}
+
+// ClearInterrupt discards an interrupt that hasn't stopped a script yet.
+func (vm *VM) ClearInterrupt() {
+	vm.runtime.ClearInterrupt()
+}
+
+// SetResourceLimits bounds the memory scripts may allocate. Creating a string or ArrayBuffer larger than its limit
+// throws a RangeError, and allocating more than one of the totals in a single RunString or RunEventLoop terminates the
+// script with a *runtime.InterruptedError whose reason is a *runtime.ResourceLimitError.
+func (vm *VM) SetResourceLimits(limits runtime.ResourceLimits) {
+	vm.runtime.SetResourceLimits(limits)
+}
+
+// ResourceUsage returns the memory scripts currently hold. It is only counted after SetResourceLimits.
+func (vm *VM) ResourceUsage() runtime.ResourceUsage {
+	return vm.runtime.ResourceUsage()
+}
+
+// PeakResourceUsage returns the most memory scripts held during the last RunString.
+func (vm *VM) PeakResourceUsage() runtime.ResourceUsage {
+	return vm.runtime.PeakResourceUsage()
+}
+
+// SetStepBudget limits how many steps each RunString may take: statements, loop iterations and function calls. A run
+// that takes more is terminated with a *runtime.InterruptedError whose reason is a *runtime.StepBudgetError. Unlike a
+// timeout, the same script is always stopped at the same point. Zero removes the limit.
+func (vm *VM) SetStepBudget(steps uint64) {
+	vm.stepBudget = steps
+}
+
+// Steps returns how many steps the last RunString took.
+func (vm *VM) Steps() uint64 {
+	return vm.steps
+}
+
+// SetDeterministic seeds Math.random and replaces the clock with one that starts at start and only advances with
+// each step, so runs of the same scripts can be replayed exactly.
+func (vm *VM) SetDeterministic(seed uint64, start time.Time) {
+	vm.runtime.SetDeterministic(seed, start)
+}
+
+// SetClock makes timers and the clock scripts observe follow clock, e.g. a *runtime.VirtualClock so tests can run
+// timers without waiting for them.
+func (vm *VM) SetClock(clock runtime.Clock) {
+	vm.runtime.Clock = clock
+	vm.runtime.Now = clock.Now
+}
+
+// SetConsole makes the console namespace write to sink instead of standard output and error, e.g. to capture the
+// messages of each level separately. A nil sink discards them.
+func (vm *VM) SetConsole(sink runtime.ConsoleSink) {
+	vm.runtime.Console = sink
+}
+
+// SetHTTPTransport makes fetch send its requests with transport, e.g. one that adds credentials or the client
+// transport of an httptest.Server. Without one, fetch rejects.
+func (vm *VM) SetHTTPTransport(transport http.RoundTripper) {
+	vm.runtime.HTTPTransport = transport
+}
+
+// SetTimeZone makes Intl.DateTimeFormat format times in location when scripts give no timeZone, instead of UTC. The
+// location should be loaded by its IANA name, e.g. with time.LoadLocation, as scripts see its name.
+func (vm *VM) SetTimeZone(location *time.Location) {
+	vm.runtime.TimeZone = location
+}
+
+// EnableRequire defines a global require that loads CommonJS modules from fsys, with the root of fsys as "/" and
+// scripts run from it. Modules are resolved the way Node resolves them, including node_modules and the main and
+// exports of package.json files.
+func (vm *VM) EnableRequire(fsys fs.FS) {
+	vm.loader = runtime.NewCommonJSLoader(fsys)
+	runtime.DefineRequire(vm.runtime, vm.realm, vm.loader, "/")
+}
+
+// Require loads the module specifier resolves to from "/" and returns its module.exports, running the jobs the module
+// queued like RunString. EnableRequire must be called first.
+func (vm *VM) Require(specifier string) (Value, error) {
+	if vm.loader == nil {
+		return vm.undefined(), errors.New("gojs: require is not enabled")
+	}
+
+	result := vm.undefined()
+	completion := vm.inRealm(func() *runtime.Completion {
+		completion := vm.loader.Require(vm.runtime, specifier, "/")
+		if completion.Type != runtime.Normal {
+			return completion
+		}
+
+		result = vm.wrap(completion.Value.(*runtime.JavaScriptValue))
+		return vm.runtime.RunJobs()
+	})
+
+	return result, vm.completionError(completion)
+}
+
+// RunFile reads the file at path and evaluates it with RunString.
+func (vm *VM) RunFile(path string) (Value, error) {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return vm.undefined(), err
+	}
+	return vm.RunString(string(source))
+}
+
+// Set assigns value to the global property name, converting it with ToValue.
+func (vm *VM) Set(name string, value any) error {
+	jsValue, err := vm.toValue(value)
+	if err != nil {
+		return err
+	}
+
+	global := vm.realm.GlobalObject
+	completion := global.Set(vm.runtime, runtime.NewStringValue(name), jsValue.value, runtime.NewJavaScriptValue(runtime.TypeObject, global))
+	if err := vm.completionError(completion); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Get returns the global property name, or undefined if it doesn't exist or its getter throws.
+func (vm *VM) Get(name string) Value {
+	global := vm.realm.GlobalObject
+	completion := global.Get(vm.runtime, runtime.NewStringValue(name), runtime.NewJavaScriptValue(runtime.TypeObject, global))
+	if completion.Type != runtime.Normal {
+		return vm.undefined()
+	}
+	return vm.wrap(completion.Value.(*runtime.JavaScriptValue))
+}
+
+// Serialize encodes a value the way structuredClone copies it. The encoding is stable, so it can be stored, or passed
+// to Deserialize on another VM. Values that can't be cloned, like functions and wrapped Go values, return an
+// *Exception wrapping a DataCloneError.
+func (vm *VM) Serialize(value Value) ([]byte, error) {
+	completion := vm.inRealm(func() *runtime.Completion {
+		return runtime.Serialize(vm.runtime, value.value)
+	})
+	if err := vm.completionError(completion); err != nil {
+		return nil, err
+	}
+	return completion.Value.([]byte), nil
+}
+
+// Deserialize decodes data written by Serialize into a new value in this VM's realm.
+func (vm *VM) Deserialize(data []byte) (Value, error) {
+	completion := vm.inRealm(func() *runtime.Completion {
+		return runtime.Deserialize(vm.runtime, data)
+	})
+	if err := vm.completionError(completion); err != nil {
+		return vm.undefined(), err
+	}
+	return vm.wrap(completion.Value.(*runtime.JavaScriptValue)), nil
+}
+
+// inRealm runs fn with this VM's realm as the running realm, so the objects it creates belong to it.
+func (vm *VM) inRealm(fn func() *runtime.Completion) *runtime.Completion {
+	return vm.runtime.Execute(func() *runtime.Completion {
+		vm.runtime.PushExecutionContext(&runtime.ExecutionContext{Realm: vm.realm})
+		defer vm.runtime.PopExecutionContext()
+		return fn()
+	})
+}
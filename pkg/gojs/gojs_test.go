@@ -0,0 +1,834 @@
+package gojs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+func TestRunString(t *testing.T) {
+	vm := New()
+
+	value, err := vm.RunString("1 + 2")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), value.Export())
+
+	value, err = vm.RunString("0.5 * 3")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, value.Export())
+	assert.Equal(t, "1.5", value.String())
+}
+
+func TestRunFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "script.js")
+	assert.NoError(t, os.WriteFile(path, []byte(`"from " + "file"`), 0o644))
+
+	value, err := New().RunFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "from file", value.Export())
+
+	_, err = New().RunFile(filepath.Join(t.TempDir(), "missing.js"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestThrownErrors(t *testing.T) {
+	vm := New()
+
+	_, err := vm.RunString(`throw new TypeError("bad thing")`)
+	var exception *Exception
+	assert.True(t, errors.As(err, &exception))
+	assert.Equal(t, "TypeError: bad thing", err.Error())
+	assert.Equal(t, "TypeError: bad thing", exception.Value().String())
+
+	_, err = vm.RunString(`throw "plain"`)
+	assert.Equal(t, "plain", err.Error())
+
+	_, err = vm.RunString(`let = = 1;`)
+	assert.True(t, errors.As(err, &exception))
+	assert.Contains(t, err.Error(), "SyntaxError")
+}
+
+func TestGlobals(t *testing.T) {
+	vm := New()
+
+	assert.NoError(t, vm.Set("count", 41))
+	assert.NoError(t, vm.Set("config", map[string]any{"name": "app", "tags": []any{"a", true, nil}}))
+
+	_, err := vm.RunString(`count = count + 1; var summary = config.name + ":" + config.tags.length;`)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(42), vm.Get("count").Export())
+	assert.Equal(t, "app:3", vm.Get("summary").Export())
+	assert.True(t, vm.Get("missing").IsUndefined())
+
+	_, err = vm.RunString(`var result = { list: [1, 2.5, "x"], nested: { ok: true }, nothing: null };`)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"list":    []any{int64(1), 2.5, "x"},
+		"nested":  map[string]any{"ok": true},
+		"nothing": nil,
+	}, vm.Get("result").Export())
+
+	assert.Error(t, vm.Set("unsupported", make(chan int)))
+}
+
+func TestFunctions(t *testing.T) {
+	vm := New()
+
+	assert.NoError(t, vm.Set("add", func(call FunctionCall) (Value, error) {
+		a := call.Argument(0).Export().(int64)
+		b := call.Argument(1).Export().(int64)
+		return vm.ToValue(a + b), nil
+	}))
+	assert.NoError(t, vm.Set("fail", Function(func(call FunctionCall) (Value, error) {
+		return Value{}, errors.New("went wrong")
+	})))
+
+	value, err := vm.RunString(`add(2, 3)`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), value.Export())
+
+	value, err = vm.RunString(`var message; try { fail(); } catch (e) { message = e.message; } message`)
+	assert.NoError(t, err)
+	assert.Equal(t, "went wrong", value.Export())
+
+	value, err = vm.RunString(`(function (name) { return "hello " + name; })`)
+	assert.NoError(t, err)
+	greet := value.Export().(func(args ...any) (any, error))
+	result, err := greet("go")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello go", result)
+}
+
+func TestPromiseJobsRun(t *testing.T) {
+	vm := New()
+
+	_, err := vm.RunString(`var settled = false; Promise.resolve(1).then(() => { settled = true; });`)
+	assert.NoError(t, err)
+	assert.Equal(t, true, vm.Get("settled").Export())
+}
+
+func TestRunStringContext(t *testing.T) {
+	vm := New()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := vm.RunStringContext(ctx, `
+		var cleanedUp = false;
+		try {
+			while (true) {}
+		} catch (e) {
+			cleanedUp = "caught";
+		} finally {
+			cleanedUp = true;
+		}
+	`)
+	var interrupted *runtime.InterruptedError
+	assert.True(t, errors.As(err, &interrupted))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, false, vm.Get("cleanedUp").Export())
+
+	// The VM can be used again after a timeout.
+	value, err := vm.RunString(`1 + 1`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), value.Export())
+
+	// Jobs are interrupted too.
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = vm.RunStringContext(ctx, `function spin() { Promise.resolve().then(spin); } spin();`)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	value, err = vm.RunString(`"still usable"`)
+	assert.NoError(t, err)
+	assert.Equal(t, "still usable", value.Export())
+}
+
+func TestInterrupt(t *testing.T) {
+	vm := New()
+
+	started := make(chan struct{})
+	assert.NoError(t, vm.Set("started", func() { close(started) }))
+	go func() {
+		<-started
+		vm.Interrupt("shutting down")
+	}()
+
+	_, err := vm.RunString(`
+		function fib(n) { return n < 2 ? n : fib(n - 1) + fib(n - 2); }
+		started();
+		for (;;) { fib(10); }
+	`)
+	var interrupted *runtime.InterruptedError
+	assert.True(t, errors.As(err, &interrupted))
+	assert.Equal(t, "shutting down", interrupted.Reason)
+
+	// Go functions that call back into the script can't turn the interrupt into an exception.
+	assert.NoError(t, vm.Set("callback", func(fn func() error) error { return fn() }))
+	vm.Interrupt("again")
+	_, err = vm.RunString(`try { callback(() => {}); } catch (e) {}`)
+	assert.True(t, errors.As(err, &interrupted))
+	assert.Equal(t, "again", interrupted.Reason)
+
+	vm.Interrupt("cleared")
+	vm.ClearInterrupt()
+	_, err = vm.RunString(`1`)
+	assert.NoError(t, err)
+}
+
+// Panics in the engine, such as on generators which it doesn't implement yet, are returned as errors.
+func TestEnginePanic(t *testing.T) {
+	vm := New()
+	stackDepth := len(vm.Runtime().ExecutionContextStack)
+
+	assertPanicked := func(t *testing.T, err error) {
+		var panicked *runtime.PanicError
+		if assert.True(t, errors.As(err, &panicked)) {
+			assert.Equal(t, "TODO: Call InstantiateGeneratorFunctionObject", panicked.Value)
+			assert.NotEmpty(t, panicked.Stack)
+		}
+		var interrupted *runtime.InterruptedError
+		assert.True(t, errors.As(err, &interrupted))
+	}
+
+	_, err := vm.RunString(`function* generator() {}`)
+	assertPanicked(t, err)
+
+	value, err := vm.RunString(`(function () { function* generator() {} })`)
+	assert.NoError(t, err)
+	_, err = value.Export().(func(args ...any) (any, error))()
+	assertPanicked(t, err)
+
+	// The panic unwinds Go functions that called back into the script, and try statements can't catch it.
+	assert.NoError(t, vm.Set("callback", func(fn func() error) error { return fn() }))
+	_, err = vm.RunString(`try { callback(function () { function* generator() {} }); } catch (e) {}`)
+	assertPanicked(t, err)
+
+	vm.EnableRequire(fstest.MapFS{"generator.js": {Data: []byte(`function* generator() {}`)}})
+	_, err = vm.Require("./generator")
+	assertPanicked(t, err)
+
+	// The VM is still usable afterwards.
+	assert.Equal(t, int64(2), run(t, vm, `1 + 1`).Export())
+	assert.Len(t, vm.Runtime().ExecutionContextStack, stackDepth)
+}
+
+func TestCallDepthLimit(t *testing.T) {
+	vm := New()
+	vm.Runtime().MaxCallDepth = 100
+
+	value, err := vm.RunString(`
+		var depth = 0;
+		function recurse() { depth++; recurse(); }
+		try { recurse(); } catch (e) { e instanceof RangeError && e.message; }
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, "Maximum call stack size exceeded", value.Export())
+	assert.Less(t, vm.Get("depth").Export(), int64(100))
+
+	_, err = vm.RunString(`function f() { f(); } f();`)
+	assert.EqualError(t, err, "RangeError: Maximum call stack size exceeded")
+
+	// Natives that recurse through other natives are limited too.
+	_, err = vm.RunString(`var object = {}; object.toLocaleString = function () { return [object].toLocaleString(); }; [object].toLocaleString();`)
+	assert.EqualError(t, err, "RangeError: Maximum call stack size exceeded")
+
+	// Errors can still be thrown by scripts that are at the limit.
+	value, err = vm.RunString(`
+		function nearLimit() {
+			try { nearLimit(); } catch (e) { if (e instanceof RangeError) undefined.x; throw e; }
+		}
+		try { nearLimit(); } catch (e) { e.name; }
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, "TypeError", value.Export())
+}
+
+// An array that contains itself is joined as an empty string where it recurs.
+func TestArrayJoinCycle(t *testing.T) {
+	vm := New()
+	assert.Equal(t, "", run(t, vm, `var a = []; a[0] = a; a.join()`).Export())
+	assert.Equal(t, "1,2,|1,2,|1,2,-1,2,", run(t, vm, `
+		var b = [1, 2];
+		b.push(b);
+		[b.join(), String(b), [b, [b]].join("-")].join("|");
+	`).Export())
+
+	// The same array may appear more than once without being a cycle.
+	assert.Equal(t, "1,2;1,2", run(t, vm, `var c = [1, 2]; [c, c].join(";")`).Export())
+}
+
+func TestResourceLimits(t *testing.T) {
+	vm := New()
+	vm.SetResourceLimits(runtime.ResourceLimits{
+		MaxStringLength:          1 << 20,
+		MaxArrayBufferByteLength: 1 << 20,
+		MaxStringBytes:           8 << 20,
+		MaxProperties:            100_000,
+	})
+
+	_, err := vm.RunString(`"x".repeat(1e9)`)
+	assert.EqualError(t, err, "RangeError: Invalid string length")
+
+	_, err = vm.RunString(`new ArrayBuffer(2 << 20)`)
+	assert.EqualError(t, err, "RangeError: Array buffer allocation failed")
+
+	var limitErr *runtime.ResourceLimitError
+
+	_, err = vm.RunString(`var strings = []; for (;;) strings.push("x".repeat(1 << 19) + strings.length);`)
+	if assert.ErrorAs(t, err, &limitErr) {
+		assert.Equal(t, "string bytes", limitErr.Resource)
+	}
+	assert.Greater(t, vm.PeakResourceUsage().StringBytes, int64(0))
+
+	_, err = vm.RunString(`var objects = []; for (;;) objects.push({});`)
+	if assert.ErrorAs(t, err, &limitErr) {
+		assert.Equal(t, "properties", limitErr.Resource)
+	}
+
+	// Each run has its own budget, so later runs aren't stopped by what earlier ones left in globals.
+	value, err := vm.RunString(`"ab".repeat(3)`)
+	assert.NoError(t, err)
+	assert.Equal(t, "ababab", value.Export())
+	assert.Greater(t, run(t, vm, `strings.length + objects.length`).Export(), int64(0))
+}
+
+// Limits count what a run allocates, not what is still reachable, so they stop a script at the same point regardless
+// of when the garbage collector runs.
+func TestResourceLimitsPerRun(t *testing.T) {
+	vm := New()
+	vm.SetResourceLimits(runtime.ResourceLimits{MaxObjects: 1000})
+
+	var limitErr *runtime.ResourceLimitError
+	_, err := vm.RunString(`var objects = []; for (;;) objects.push({});`)
+	if assert.ErrorAs(t, err, &limitErr) {
+		assert.Equal(t, "objects", limitErr.Resource)
+		assert.Equal(t, int64(1000), limitErr.Limit)
+	}
+	assert.Equal(t, int64(999), run(t, vm, `objects.length`).Export())
+
+	// Terminating the run doesn't free what globals still hold, but the next run starts from zero.
+	assert.Equal(t, int64(500), run(t, vm, `var more = []; for (var i = 0; i < 500; i++) more.push({}); more.length`).Export())
+
+	// Garbage counts too, so the same script is stopped at the same point every time.
+	for range 3 {
+		_, err = vm.RunString(`var created = 0; for (;;) { ({}); created++; }`)
+		assert.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, int64(1000), run(t, vm, `created`).Export())
+	}
+
+	// Deleting properties gives them back to the run.
+	vm.SetResourceLimits(runtime.ResourceLimits{MaxProperties: 1000})
+	value, err := vm.RunString(`
+		var object = {};
+		for (var i = 0; i < 5000; i++) { object["p" + i] = i; delete object["p" + i]; }
+		i;
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5000), value.Export())
+}
+
+// Strings made from encoded data are limited like other strings, even when the data they are made from is allowed.
+func TestResourceLimitsTextEncoding(t *testing.T) {
+	vm := New()
+	vm.SetResourceLimits(runtime.ResourceLimits{MaxStringLength: 1 << 10})
+
+	for _, source := range []string{
+		`btoa("x".repeat(800))`,
+		`atob("////".repeat(200))`,
+		`new TextDecoder().decode(new Uint8Array(2000))`,
+		`new TextDecoder("utf-16le").decode(new Uint8Array(3000))`,
+		`new URLSearchParams({ key: "/".repeat(400) }).toString()`,
+	} {
+		_, err := vm.RunString(source)
+		assert.EqualError(t, err, "RangeError: Invalid string length", source)
+	}
+
+	assert.Equal(t, int64(1000), run(t, vm, `atob(btoa("x".repeat(700))).length + new TextDecoder().decode(new Uint8Array(300)).length`).Export())
+	assert.Equal(t, "key=%2F%2F", run(t, vm, `new URLSearchParams({ key: "//" }).toString()`).Export())
+}
+
+func TestStepBudget(t *testing.T) {
+	vm := New()
+	vm.SetStepBudget(1000)
+
+	var budgetErr *runtime.StepBudgetError
+
+	_, err := vm.RunString(`var i = 0; while (true) { i++; }`)
+	if assert.ErrorAs(t, err, &budgetErr) {
+		assert.Equal(t, uint64(1000), budgetErr.Budget)
+	}
+	stoppedAt := vm.Get("i").Export()
+
+	// The budget is per run, and a script is stopped at the same point every time.
+	_, err = vm.RunString(`var i = 0; while (true) { i++; }`)
+	assert.ErrorAs(t, err, &budgetErr)
+	assert.Equal(t, stoppedAt, vm.Get("i").Export())
+
+	value, err := vm.RunString(`function add(a, b) { return a + b; } add(1, 2);`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), value.Export())
+	assert.Greater(t, vm.Steps(), uint64(0))
+	assert.LessOrEqual(t, vm.Steps(), uint64(1000))
+}
+
+func TestDeterministic(t *testing.T) {
+	run := func() any {
+		vm := New()
+		vm.SetDeterministic(42, time.Unix(0, 0))
+		value, err := vm.RunString(`[Math.random(), Math.random(), Math.random()]`)
+		assert.NoError(t, err)
+		return value.Export()
+	}
+
+	first := run()
+	assert.Equal(t, first, run())
+
+	for _, number := range first.([]any) {
+		assert.GreaterOrEqual(t, number, 0.0)
+		assert.Less(t, number, 1.0)
+	}
+}
+
+func TestConsole(t *testing.T) {
+	vm := New()
+
+	var messages []string
+	vm.SetConsole(runtime.ConsoleSinkFunc(func(level runtime.ConsoleLevel, message string) {
+		messages = append(messages, level.String()+": "+message)
+	}))
+
+	_, err := vm.RunString(`
+		console.log("%s is %d%% done", "upload", 99.5, "!");
+		console.warn("careful");
+		console.group("group");
+		console.error(new Error("failed"));
+		console.groupEnd();
+		console.assert(1 + 1 === 3, "math is broken");
+		console.count();
+		console.count();
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"log: upload is 99% done !",
+		"warn: careful",
+		"log: group",
+		"error:   Error: failed\n      at <anonymous>",
+		"error: Assertion failed: math is broken",
+		"info: default: 1",
+		"info: default: 2",
+	}, messages)
+}
+
+func TestInspect(t *testing.T) {
+	vm := New()
+
+	var messages []string
+	vm.SetConsole(runtime.ConsoleSinkFunc(func(level runtime.ConsoleLevel, message string) {
+		messages = append(messages, message)
+	}))
+
+	_, err := vm.RunString(`
+		class Point { constructor(x, y) { this.x = x; this.y = y; } }
+		const node = { name: "root", get size() { return 1; }, [Symbol("id")]: 7 };
+		node.self = node;
+		const sparse = [1, , 3];
+		sparse[5] = "six";
+
+		console.log(node);
+		console.log(new Point(1, 2), Point);
+		console.log({ a: { b: { c: { d: 1 } } } });
+		console.log(sparse, new Uint8Array([1, 2]));
+		console.log(Object.create(null), -0, "text", ["text"]);
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"<ref *1> {\n  name: 'root',\n  size: [Getter],\n  self: [Circular *1],\n  [Symbol(id)]: 7\n}",
+		"Point { x: 1, y: 2 } [class Point]",
+		"{ a: { b: { c: [Object] } } }",
+		"[ 1, <1 empty item>, 3, <2 empty items>, 'six' ] Uint8Array(2) [ 1, 2 ]",
+		"[Object: null prototype] {} -0 text [ 'text' ]",
+	}, messages)
+}
+
+func TestConsoleDir(t *testing.T) {
+	vm := New()
+
+	var messages []string
+	vm.SetConsole(runtime.ConsoleSinkFunc(func(level runtime.ConsoleLevel, message string) {
+		messages = append(messages, message)
+	}))
+
+	_, err := vm.RunString(`
+		const nested = { a: { b: { c: { d: 1 } } } };
+		const hidden = Object.defineProperty({ shown: 1 }, "hidden", { value: 2, enumerable: false });
+
+		console.dir(nested);
+		console.dir(nested, { depth: 0 });
+		console.dir(nested, { depth: null });
+		console.dir(nested, { depth: Infinity });
+		console.dir(hidden);
+		console.dir(hidden, { showHidden: true });
+		console.dir([1], { showHidden: true });
+		console.dir("text", { colors: true });
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"{ a: { b: { c: [Object] } } }",
+		"{ a: [Object] }",
+		"{\n  a: { b: { c: { d: 1 } } }\n}",
+		"{\n  a: { b: { c: { d: 1 } } }\n}",
+		"{ shown: 1 }",
+		"{ shown: 1, [hidden]: 2 }",
+		"[ 1, [length]: 1 ]",
+		"\x1b[32m'text'\x1b[39m",
+	}, messages)
+}
+
+func TestTimers(t *testing.T) {
+	vm := New()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := runtime.NewVirtualClock(start)
+	vm.SetClock(clock)
+
+	var events []string
+	assert.NoError(t, vm.Set("record", func(event string) {
+		events = append(events, fmt.Sprintf("%s@%s", event, clock.Now().Sub(start)))
+	}))
+
+	_, err := vm.RunString(`
+		setTimeout(() => record("hour"), 60 * 60 * 1000);
+		setTimeout((name) => record(name), 0, "zero");
+		setImmediate(() => record("immediate"));
+		queueMicrotask(() => record("microtask"));
+
+		let ticks = 0;
+		const interval = setInterval(() => {
+			record("tick");
+			if (++ticks === 3) {
+				clearInterval(interval);
+			}
+		}, 1000);
+		clearTimeout(setTimeout(() => record("cancelled"), 10));
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"microtask@0s"}, events)
+
+	began := time.Now()
+	assert.NoError(t, vm.RunEventLoop())
+	assert.Less(t, time.Since(began), time.Second)
+
+	assert.Equal(t, []string{
+		"microtask@0s",
+		"zero@0s",
+		"immediate@0s",
+		"tick@1s",
+		"tick@2s",
+		"tick@3s",
+		"hour@1h0m0s",
+	}, events)
+
+	_, err = vm.RunString(`setTimeout(() => { throw new Error("late"); }, 5)`)
+	assert.NoError(t, err)
+	assert.EqualError(t, vm.RunEventLoop(), "Error: late")
+
+	_, err = vm.RunString(`setInterval(() => {}, 1)`)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, vm.RunEventLoopContext(ctx), context.DeadlineExceeded)
+	assert.NoError(t, vm.RunEventLoop())
+}
+
+func TestWeakRefs(t *testing.T) {
+	vm := New()
+
+	var cleaned []string
+	assert.NoError(t, vm.Set("record", func(held string) {
+		cleaned = append(cleaned, held)
+	}))
+
+	_, err := vm.RunString(`
+		var kept = { name: "kept" };
+		var registry = new FinalizationRegistry((held) => record(held));
+		var token = {};
+
+		function track(name, unregisterToken) {
+			const target = { name: name };
+			registry.register(target, name, unregisterToken);
+			return new WeakRef(target);
+		}
+
+		var dropped = track("dropped");
+		var unregistered = track("unregistered", token);
+		var alive = new WeakRef(kept);
+		registry.register(kept, "kept");
+
+		// The target stays alive until the end of the job that created the WeakRef.
+		var derefedDuringJob = dropped.deref().name;
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, "dropped", vm.Get("derefedDuringJob").String())
+
+	value, err := vm.RunString(`registry.unregister(token)`)
+	assert.NoError(t, err)
+	assert.Equal(t, true, value.Export())
+
+	assert.NoError(t, vm.CollectGarbage())
+
+	value, err = vm.RunString(`[dropped.deref() === undefined, unregistered.deref() === undefined, alive.deref().name].join()`)
+	assert.NoError(t, err)
+	assert.Equal(t, "true,true,kept", value.String())
+	assert.Equal(t, []string{"dropped"}, cleaned)
+
+	_, err = vm.RunString(`
+		registry = new FinalizationRegistry(() => { throw new Error("cleanup failed"); });
+		track("thrower");
+	`)
+	assert.NoError(t, err)
+	assert.ErrorContains(t, vm.CollectGarbage(), "cleanup failed")
+}
+
+func TestRealms(t *testing.T) {
+	vm := New()
+
+	sandbox := vm.NewRealm(runtime.RealmOptions{
+		Globals:        []string{"globalThis", "Object", "Math", "setTimeout"},
+		ExcludeGlobals: []string{"setTimeout"},
+	})
+
+	value, err := sandbox.RunString(`[typeof Object, typeof Math.pow, typeof Array, typeof setTimeout, typeof undefined].join()`)
+	assert.NoError(t, err)
+	assert.Equal(t, "function,function,undefined,undefined,undefined", value.String())
+
+	_, err = sandbox.RunString(`function double(n) { return n * 2; }`)
+	assert.NoError(t, err)
+	assert.NoError(t, vm.Set("double", sandbox.Get("double")))
+	assert.NoError(t, vm.Set("sandboxObject", sandbox.Get("Object")))
+
+	value, err = vm.RunString(`[double(21), sandboxObject === Object, typeof double].join()`)
+	assert.NoError(t, err)
+	assert.Equal(t, "42,false,function", value.String())
+
+	vm.Runtime().ImportModule = func(rt *runtime.Runtime, realm *runtime.Realm, specifier string) *runtime.Completion {
+		script, err := runtime.ParseScript(`({ greet: (name) => "hello " + name, config: {} })`, realm)
+		if err != nil {
+			return runtime.NewThrowCompletion(runtime.NewSyntaxError(rt, err.Error()))
+		}
+		return script.Evaluate(rt)
+	}
+
+	_, err = vm.RunString(`
+		var shadow = new ShadowRealm();
+		shadow.evaluate("var count = 0;");
+		var increment = shadow.evaluate("(function increment(by) { count += by; return count; })");
+		var results = [increment(2), increment(3), increment.name, increment.length];
+
+		try {
+			shadow.evaluate("({})");
+		} catch (error) {
+			results.push(error.constructor === TypeError);
+		}
+
+		try {
+			shadow.evaluate("throw new RangeError('boom')");
+		} catch (error) {
+			results.push(error.constructor.name + ": " + error.message);
+		}
+
+		shadow.importValue("greeter", "greet").then((greet) => results.push(greet("realm")));
+		shadow.importValue("greeter", "config").catch((error) => results.push(error.name));
+		shadow.importValue("greeter", "missing").catch((error) => results.push(error.message));
+	`)
+	assert.NoError(t, err)
+
+	value, err = vm.RunString(`results.join("|")`)
+	assert.NoError(t, err)
+	assert.Equal(t, "2|5|increment|1|true|TypeError: RangeError: boom|hello realm|TypeError|'greeter' has no export named 'missing'", value.String())
+}
+
+// A ShadowRealm can't be used to reach the globals that were left out of the realm creating it.
+func TestShadowRealmGlobals(t *testing.T) {
+	vm := New()
+	vm.Runtime().ShadowRealmOptions = runtime.RealmOptions{ExcludeGlobals: []string{"queueMicrotask"}}
+
+	sandbox := vm.NewRealm(runtime.RealmOptions{ExcludeGlobals: []string{"fetch", "setTimeout", "require"}})
+	for _, name := range []string{"fetch", "setTimeout", "require", "queueMicrotask"} {
+		assert.Equal(t, "undefined", run(t, sandbox, `new ShadowRealm().evaluate("typeof `+name+`")`).Export(), name)
+		assert.Equal(t, "undefined", run(t, sandbox, `new ShadowRealm().evaluate("new ShadowRealm().evaluate('typeof `+name+`')")`).Export(), name)
+	}
+	assert.Equal(t, "function", run(t, sandbox, `new ShadowRealm().evaluate("typeof setInterval")`).Export())
+
+	// Realms that list their globals only pass on those that are also allowed for ShadowRealms.
+	vm.Runtime().ShadowRealmOptions = runtime.RealmOptions{Globals: []string{"ShadowRealm", "Math", "fetch"}}
+	allowed := vm.NewRealm(runtime.RealmOptions{Globals: []string{"ShadowRealm", "Math", "JSON"}})
+	assert.Equal(t, "function", run(t, allowed, `new ShadowRealm().evaluate("typeof Math.pow")`).Export())
+	assert.Equal(t, "undefined", run(t, allowed, `new ShadowRealm().evaluate("typeof fetch")`).Export())
+	assert.Equal(t, "undefined", run(t, allowed, `new ShadowRealm().evaluate("typeof JSON")`).Export())
+
+	// The VM's own realm has all the globals, so its ShadowRealms get those of ShadowRealmOptions.
+	assert.Equal(t, "function", run(t, vm, `new ShadowRealm().evaluate("typeof fetch")`).Export())
+	assert.Equal(t, "undefined", run(t, vm, `new ShadowRealm().evaluate("typeof setTimeout")`).Export())
+}
+
+func TestStructuredClone(t *testing.T) {
+	vm := New()
+
+	value, err := vm.RunString(`
+		var original = { name: "list", items: [1, "two", true], error: new RangeError("bad") };
+		original.self = original;
+		original.shared = [original.items, original.items];
+
+		var buffer = new ArrayBuffer(8);
+		original.buffer = buffer;
+		original.bytes = new Uint8Array(buffer);
+		original.view = new DataView(buffer, 4);
+		original.bytes[5] = 42;
+
+		var copy = structuredClone(original);
+		var results = [
+			copy !== original,
+			copy.self === copy,
+			copy.shared[0] === copy.shared[1],
+			copy.items.join(),
+			copy.error instanceof RangeError,
+			copy.error.message,
+			copy.view.buffer === copy.buffer,
+			copy.view.getUint8(1),
+		];
+
+		var transferred = structuredClone(buffer, { transfer: [buffer] });
+		results.push(buffer.detached, transferred.byteLength);
+
+		try {
+			structuredClone({ callback: () => 1 });
+		} catch (error) {
+			results.push(error.name);
+		}
+
+		results.join();
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, "true,true,true,1,two,true,true,bad,true,42,true,8,DataCloneError", value.String())
+
+	value, err = vm.RunString(`({ answer: 42, tags: ["a", "b"] })`)
+	assert.NoError(t, err)
+
+	data, err := vm.Serialize(value)
+	assert.NoError(t, err)
+
+	other := New()
+	decoded, err := other.Deserialize(data)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"answer": int64(42), "tags": []any{"a", "b"}}, decoded.Export())
+
+	_, err = vm.Serialize(vm.Get("structuredClone"))
+	var exception *Exception
+	assert.ErrorAs(t, err, &exception)
+	assert.Contains(t, err.Error(), "DataCloneError")
+
+	_, err = other.Deserialize([]byte{0xFF, 1, 'o'})
+	assert.ErrorContains(t, err, "Unable to deserialize cloned data.")
+}
+
+// Only objects without internal slots are cloned property by property, any other object that isn't handled explicitly
+// can't be cloned.
+func TestStructuredCloneInternalSlots(t *testing.T) {
+	vm := New()
+	run(t, vm, `
+		function cloneable(value) {
+			try {
+				structuredClone(value);
+				return "cloned";
+			} catch (error) {
+				return error.name;
+			}
+		}
+		class Point { #id = 1; constructor(x) { this.x = x; } }
+	`)
+
+	assert.Equal(t, "cloned,cloned,cloned,1", run(t, vm, `
+		var copy = structuredClone(new Point(1));
+		[cloneable({ a: 1 }), cloneable(Object.create(null)), cloneable(Object.preventExtensions({})), copy.x].join();
+	`).Export())
+
+	for _, source := range []string{
+		`Promise.resolve()`,
+		`Object(Symbol("symbol"))`,
+		`new WeakRef({})`,
+		`new FinalizationRegistry(() => {})`,
+		`[1].values()`,
+		`[1].values().map(x => x)`,
+		`new TextEncoder()`,
+		`new TextDecoder()`,
+		`new URL("https://example.com/")`,
+		`new URLSearchParams("a=1")`,
+		`new Headers()`,
+		`new AbortController()`,
+		`new AbortController().signal`,
+		`new Intl.Collator()`,
+		`new Intl.NumberFormat()`,
+	} {
+		assert.Equal(t, "DataCloneError", run(t, vm, "cloneable("+source+")").Export(), source)
+	}
+}
+
+func TestTextEncoding(t *testing.T) {
+	vm := New()
+
+	value, err := vm.RunString(`
+		var encoder = new TextEncoder();
+		var bytes = encoder.encode("h\u00e9\u20ac");
+		var destination = new Uint8Array(4);
+		var progress = encoder.encodeInto("a\u00e9\u20ac", destination);
+
+		var decoder = new TextDecoder();
+		var streamed = decoder.decode(new Uint8Array([0xF0, 0x9F]), { stream: true }) + decoder.decode(new Uint8Array([0x98, 0x80]));
+
+		var results = [
+			bytes.length,
+			progress.read + "/" + progress.written,
+			decoder.decode(bytes) === "h\u00e9\u20ac",
+			encoder.encode(streamed).length,
+			decoder.decode(new Uint8Array([0xEF, 0xBB, 0xBF, 0x61, 0xFF, 0x62])) === "a\ufffdb",
+			new TextDecoder("UTF-16", { ignoreBOM: true }).decode(new Uint8Array([0xFF, 0xFE, 0x68, 0x00])).length,
+			new TextDecoder("utf-16be").decode(new Uint8Array([0x00, 0x68, 0x00, 0x69])),
+			btoa("hello"),
+			atob(" aGVs bG8 "),
+		];
+
+		try {
+			new TextDecoder("utf-8", { fatal: true }).decode(new Uint8Array([0xC3]));
+		} catch (error) {
+			results.push(error.name);
+		}
+
+		try {
+			new TextDecoder("latin2");
+		} catch (error) {
+			results.push(error.name);
+		}
+
+		try {
+			btoa("\u20ac");
+		} catch (error) {
+			results.push(error.name);
+		}
+
+		results.join();
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, "6,2/3,true,4,true,2,hi,aGVsbG8=,hello,TypeError,RangeError,InvalidCharacterError", value.String())
+}
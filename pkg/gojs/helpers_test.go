@@ -0,0 +1,16 @@
+package gojs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// run runs source in vm and fails the test if the script throws.
+func run(t *testing.T, vm *VM, source string) Value {
+	t.Helper()
+
+	value, err := vm.RunString(source)
+	require.NoError(t, err)
+	return value
+}
@@ -0,0 +1,134 @@
+package gojs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntlNumberFormat(t *testing.T) {
+	vm := New()
+
+	tests := map[string]string{
+		`new Intl.NumberFormat("en-US").format(1234567.891)`:                                                         "1,234,567.891",
+		`new Intl.NumberFormat("en", { style: "percent" }).format(0.256)`:                                            "26%",
+		`new Intl.NumberFormat("en-US", { style: "currency", currency: "USD" }).format(-1234.5)`:                     "-$1,234.50",
+		`new Intl.NumberFormat("en", { style: "currency", currency: "EUR", currencySign: "accounting" }).format(-5)`: "(\u20ac5.00)",
+		`new Intl.NumberFormat("en", { style: "currency", currency: "JPY", currencyDisplay: "name" }).format(2)`:     "2 Japanese yen",
+		`new Intl.NumberFormat("und", { style: "currency", currency: "USD" }).format(12)`:                            "US$\u00a012.00",
+		`new Intl.NumberFormat("en", { notation: "compact" }).format(1234567)`:                                       "1.2M",
+		`new Intl.NumberFormat("en", { notation: "compact", compactDisplay: "long" }).format(12345)`:                 "12 thousand",
+		`new Intl.NumberFormat("en", { notation: "scientific" }).format(123456)`:                                     "1.235E5",
+		`new Intl.NumberFormat("en", { maximumSignificantDigits: 2 }).format(0.012345)`:                              "0.012",
+		`new Intl.NumberFormat("en", { signDisplay: "always" }).format(5)`:                                           "+5",
+		`Intl.NumberFormat("en").formatToParts(-1.5).map(part => part.type).join()`:                                  "minusSign,integer,decimal,fraction",
+		`(1234.5).toLocaleString()`:                                         "1,234.5",
+		`(0.5).toLocaleString("en", { style: "percent" })`:                  "50%",
+		`BigInt(9007199254740991).toLocaleString("en-US")`:                  "9,007,199,254,740,991",
+		`[1234, 5678.9].toLocaleString("en", { maximumFractionDigits: 0 })`: "1,234,5,679",
+		`new Intl.NumberFormat("en-GB").resolvedOptions().locale`:           "en",
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+
+	_, err := vm.RunString(`new Intl.NumberFormat("en", { style: "currency" })`)
+	assert.ErrorContains(t, err, "TypeError")
+	_, err = vm.RunString(`new Intl.NumberFormat("en", { maximumFractionDigits: 101 })`)
+	assert.ErrorContains(t, err, "RangeError")
+}
+
+func TestIntlDateTimeFormat(t *testing.T) {
+	vm := New()
+
+	// 2024-01-05T15:04:56.789Z
+	_, err := vm.RunString(`var time = 1704467096789;`)
+	require.NoError(t, err)
+
+	tests := map[string]string{
+		`new Intl.DateTimeFormat("en-US").format(time)`:                                                                        "1/5/2024",
+		`new Intl.DateTimeFormat("en-US", { dateStyle: "full", timeStyle: "long" }).format(time)`:                              "Friday, January 5, 2024 at 3:04:56\u202fPM UTC",
+		`new Intl.DateTimeFormat("en-US", { dateStyle: "medium", timeStyle: "short", timeZone: "-08:00" }).format(time)`:       "Jan 5, 2024, 7:04\u202fAM",
+		`new Intl.DateTimeFormat("en-US", { hour: "numeric", minute: "2-digit", hour12: false }).format(time)`:                 "15:04",
+		`new Intl.DateTimeFormat("en-US", { month: "long", day: "numeric", hour: "numeric" }).format(time)`:                    "January 5 at 3\u202fPM",
+		`new Intl.DateTimeFormat("en-US", { weekday: "short", year: "2-digit", month: "short", day: "2-digit" }).format(time)`: "Fri, Jan 05, 24",
+		`new Intl.DateTimeFormat("en-US", { timeZoneName: "short", timeZone: "+05:30" }).format(time)`:                         "1/5/2024, GMT+5:30",
+		`new Intl.DateTimeFormat("en-US", { minute: "2-digit", second: "2-digit", fractionalSecondDigits: 3 }).format(time)`:   "04:56.789",
+		`new Intl.DateTimeFormat("und", { dateStyle: "short", timeStyle: "short" }).format(time)`:                              "2024-01-05 15:04",
+		`new Intl.DateTimeFormat("en", { hour: "numeric" }).formatToParts(time).map(part => part.type).join()`:                 "hour,literal,dayPeriod",
+		`new Intl.DateTimeFormat("en", { hour: "numeric", minute: "numeric" }).resolvedOptions().minute`:                       "2-digit",
+		`[0, 86400000].map(new Intl.DateTimeFormat("en", { day: "numeric" }).format).join()`:                                   "1,2",
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+
+	location, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	vm.SetTimeZone(location)
+	assert.Equal(t, "1/6/24, 12:04\u202fAM", run(t, vm, `new Intl.DateTimeFormat("en-US", { dateStyle: "short", timeStyle: "short" }).format(time)`).Export())
+	assert.Equal(t, "Asia/Tokyo", run(t, vm, `new Intl.DateTimeFormat().resolvedOptions().timeZone`).Export())
+
+	_, err = vm.RunString(`new Intl.DateTimeFormat("en", { dateStyle: "short", hour: "numeric" })`)
+	assert.ErrorContains(t, err, "TypeError")
+	_, err = vm.RunString(`new Intl.DateTimeFormat("en").format(NaN)`)
+	assert.ErrorContains(t, err, "RangeError")
+	_, err = vm.RunString(`new Intl.DateTimeFormat("en", { timeZone: "Nowhere/Special" })`)
+	assert.ErrorContains(t, err, "RangeError")
+}
+
+func TestIntlCollator(t *testing.T) {
+	vm := New()
+
+	tests := map[string]any{
+		`["b", "a", "B", "A", "\u00e4"].sort(new Intl.Collator("en").compare).join()`:               "a,A,\u00e4,b,B",
+		`["b", "a", "B", "A"].sort(new Intl.Collator("en", { caseFirst: "upper" }).compare).join()`: "A,a,B,b",
+		`["10", "9", "2"].sort(new Intl.Collator("en", { numeric: true }).compare).join()`:          "2,9,10",
+		`new Intl.Collator("en", { sensitivity: "base" }).compare("r\u00e9sum\u00e9", "Resume")`:    int64(0),
+		`new Intl.Collator("en", { sensitivity: "accent" }).compare("r\u00e9sume", "resume")`:       int64(1),
+		`new Intl.Collator("en", { ignorePunctuation: true }).compare("a-b", "ab")`:                 int64(0),
+		`"a".localeCompare("B")`:                                int64(-1),
+		`"a".localeCompare("A", "en", { sensitivity: "base" })`: int64(0),
+		`new Intl.Collator().resolvedOptions().sensitivity`:     "variant",
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+}
+
+func TestIntlPluralListAndRelativeTime(t *testing.T) {
+	vm := New()
+
+	tests := map[string]any{
+		`[0, 1, 1.5, 2].map(n => new Intl.PluralRules("en-US").select(n)).join()`:                           "other,one,other,other",
+		`[1, 2, 3, 4, 11, 22].map(n => new Intl.PluralRules("en", { type: "ordinal" }).select(n)).join()`:   "one,two,few,other,other,two",
+		`new Intl.PluralRules("en").resolvedOptions().pluralCategories.join()`:                              "one,other",
+		`new Intl.PluralRules("und").select(1)`:                                                             "other",
+		`new Intl.ListFormat("en").format(["a", "b", "c"])`:                                                 "a, b, and c",
+		`new Intl.ListFormat("en", { type: "disjunction" }).format(["a", "b"][Symbol.iterator]())`:          "a or b",
+		`new Intl.ListFormat("en", { style: "short", type: "unit" }).format(["1", "2", "3"])`:               "1, 2, 3",
+		`new Intl.RelativeTimeFormat("en").format(-3, "hours")`:                                             "3 hours ago",
+		`new Intl.RelativeTimeFormat("en", { numeric: "auto" }).format(-1, "day")`:                          "yesterday",
+		`new Intl.RelativeTimeFormat("en", { style: "narrow" }).format(5, "minute")`:                        "in 5m",
+		`new Intl.RelativeTimeFormat("en").formatToParts(1000, "day").map(part => part.unit || "-").join()`: "-,day,day,day,-",
+		`Object.prototype.toString.call(new Intl.RelativeTimeFormat())`:                                     "[object Intl.RelativeTimeFormat]",
+		`Intl.getCanonicalLocales(["EN-us", "und"]).join()`:                                                 "en-US,und",
+		`Intl.Collator.supportedLocalesOf(["fr", "en-GB"]).join()`:                                          "en-GB",
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+
+	_, err := vm.RunString(`Intl.PluralRules()`)
+	assert.ErrorContains(t, err, "TypeError")
+	_, err = vm.RunString(`new Intl.ListFormat("en").format(["a", 1])`)
+	assert.ErrorContains(t, err, "TypeError")
+	_, err = vm.RunString(`new Intl.RelativeTimeFormat("en").format(1, "fortnight")`)
+	assert.ErrorContains(t, err, "RangeError")
+}
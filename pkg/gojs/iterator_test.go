@@ -0,0 +1,86 @@
+package gojs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingIteratorSource defines counter(limit), an iterator over 1..limit that logs its next and return calls.
+const countingIteratorSource = `
+	var log = [];
+	function counter(limit) {
+		let i = 0;
+		const iterator = Object.create(Iterator.prototype);
+		iterator.next = function () {
+			i++;
+			log.push("next" + i);
+			return { value: i, done: i > limit };
+		};
+		iterator["return"] = function () {
+			log.push("return");
+			return { done: true };
+		};
+		return iterator;
+	}
+	function trace(fn) {
+		log.length = 0;
+		let result;
+		try {
+			result = fn();
+		} catch (error) {
+			result = error.name;
+		}
+		return String(result) + "|" + log.join();
+	}
+`
+
+func TestIteratorHelpers(t *testing.T) {
+	vm := New()
+	run(t, vm, countingIteratorSource)
+
+	tests := map[string]string{
+		`trace(() => counter(5).map(v => v * 2).toArray().join())`:                           "2,4,6,8,10|next1,next2,next3,next4,next5,next6",
+		`trace(() => counter(5).filter(v => v % 2).toArray().join())`:                        "1,3,5|next1,next2,next3,next4,next5,next6",
+		`trace(() => counter(5).drop(3).toArray().join())`:                                   "4,5|next1,next2,next3,next4,next5,next6",
+		`trace(() => counter(2).flatMap(v => [v, v]).toArray().join())`:                      "1,1,2,2|next1,next2,next3",
+		`trace(() => counter(3).reduce((sum, v) => sum + v))`:                                "6|next1,next2,next3,next4",
+		`trace(() => counter(2).find(v => v > 5))`:                                           "undefined|next1,next2,next3",
+		`trace(() => Iterator.from({ next() { return { done: true }; } }).toArray().length)`: "0|",
+		`trace(() => Iterator.from([1, 2]).map(v => v + 1).toArray().join())`:                "2,3|",
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+}
+
+func TestIteratorHelpersCloseOnEarlyExit(t *testing.T) {
+	vm := New()
+	run(t, vm, countingIteratorSource)
+
+	tests := map[string]string{
+		// Methods that stop before the underlying iterator is done close it.
+		`trace(() => counter(10).take(2).toArray().join())`:                                        "1,2|next1,next2,return",
+		`trace(() => counter(10).take(0).next().done)`:                                             "true|return",
+		`trace(() => counter(10).find(v => v === 2))`:                                              "2|next1,next2,return",
+		`trace(() => counter(10).some(v => v === 2))`:                                              "true|next1,next2,return",
+		`trace(() => counter(10).every(v => v < 2))`:                                               "false|next1,next2,return",
+		`trace(() => { const m = counter(10).map(v => v); m.next(); return m["return"]().done; })`: "true|next1,return",
+
+		// So do callbacks that throw, and arguments that fail validation.
+		`trace(() => counter(10).map(() => { throw new TypeError(); }).next())`:       "TypeError|next1,return",
+		`trace(() => counter(10).filter(() => { throw new TypeError(); }).toArray())`: "TypeError|next1,return",
+		`trace(() => counter(10).flatMap(() => { throw new TypeError(); }).next())`:   "TypeError|next1,return",
+		`trace(() => counter(10).flatMap(() => 1).next())`:                            "TypeError|next1,return",
+		`trace(() => counter(10).forEach(() => { throw new TypeError(); }))`:          "TypeError|next1,return",
+		`trace(() => counter(10).reduce(() => { throw new TypeError(); }, 0))`:        "TypeError|next1,return",
+		`trace(() => counter(10).some(() => { throw new TypeError(); }))`:             "TypeError|next1,return",
+		`trace(() => counter(10).take(-1))`:                                           "RangeError|return",
+		`trace(() => counter(10).map(1))`:                                             "TypeError|return",
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+}
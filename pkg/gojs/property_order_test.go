@@ -0,0 +1,85 @@
+package gojs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+// Keys are listed integer indices first in ascending order, then the other strings in the order they were added, then
+// symbols in the order they were added. Strings that merely look numeric, like "-1", "01" and 2 ** 32 - 1, aren't
+// indices.
+func TestPropertyOrder(t *testing.T) {
+	vm := New()
+	run(t, vm, `
+		var first = Symbol("first");
+		var second = Symbol("second");
+		var object = {};
+		object.b = 1;
+		object[second] = 1;
+		object[2] = 1;
+		object.a = 1;
+		object[first] = 1;
+		object[1] = 1;
+		object["10"] = 1;
+		object["-1"] = 1;
+		object["01"] = 1;
+		object[4294967295] = 1;
+		object[4294967294] = 1;
+
+		function forIn(object) {
+			const keys = [];
+			for (const key in object) keys.push(key);
+			return keys.join();
+		}
+	`)
+
+	const before = "1,2,10,4294967294,b,a,-1,01,4294967295"
+	assert.Equal(t, before, run(t, vm, `Object.keys(object).join()`).Export())
+	assert.Equal(t, before, run(t, vm, `forIn(object)`).Export())
+	assert.Equal(t, before, run(t, vm, `Object.getOwnPropertyNames(object).join()`).Export())
+	assert.Equal(t, "Symbol(second),Symbol(first)", run(t, vm, `Object.getOwnPropertySymbols(object).map(String).join()`).Export())
+	assert.Equal(t, before+",Symbol(second),Symbol(first)", ownKeys(t, vm, "object"))
+
+	// A key that is deleted and added again moves to the end of its group, while indices stay sorted.
+	run(t, vm, `delete object.b; object.b = 2; delete object[1]; object[1] = 1; delete object[second]; object[second] = 1;`)
+
+	const after = "1,2,10,4294967294,a,-1,01,4294967295,b"
+	assert.Equal(t, after, run(t, vm, `Object.keys(object).join()`).Export())
+	assert.Equal(t, after, run(t, vm, `forIn(object)`).Export())
+	assert.Equal(t, after, run(t, vm, `Object.getOwnPropertyNames(object).join()`).Export())
+	assert.Equal(t, after+",Symbol(first),Symbol(second)", ownKeys(t, vm, "object"))
+	assert.Equal(t, "1,1|2,1|10,1", run(t, vm, `Object.entries(object).slice(0, 3).join("|")`).Export())
+
+	// for-in lists own keys before inherited ones, skipping shadowed and non-enumerable keys.
+	assert.Equal(t, "3,c,5,z", run(t, vm, `
+		var parent = { z: 1, c: 1, 5: 1 };
+		Object.defineProperty(parent, "hidden", { value: 1, enumerable: false });
+		var child = Object.create(parent);
+		child.c = 1;
+		child[3] = 1;
+		forIn(child);
+	`).Export())
+}
+
+// ownKeys lists the keys of a global object the way [[OwnPropertyKeys]] returns them.
+func ownKeys(t *testing.T, vm *VM, name string) string {
+	t.Helper()
+
+	object := vm.Get(name).JavaScriptValue().Value.(runtime.ObjectInterface)
+	completion := object.OwnPropertyKeys(vm.runtime)
+	require.Equal(t, runtime.Normal, completion.Type)
+
+	keys := []string{}
+	for _, key := range completion.Value.([]*runtime.JavaScriptValue) {
+		if key.Type == runtime.TypeSymbol {
+			keys = append(keys, "Symbol("+key.Value.(*runtime.Symbol).Description+")")
+		} else {
+			keys = append(keys, key.Value.(*runtime.String).Value)
+		}
+	}
+	return strings.Join(keys, ",")
+}
@@ -0,0 +1,633 @@
+package gojs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+var (
+	errorType      = reflect.TypeFor[error]()
+	valueType      = reflect.TypeFor[Value]()
+	jsValueType    = reflect.TypeFor[*runtime.JavaScriptValue]()
+	bigIntType     = reflect.TypeFor[*big.Int]()
+	errArrayLength = errors.New("invalid array length")
+)
+
+// reflectToValue converts a Go value to a JavaScript value, binding structs, maps, slices and funcs so that
+// scripts work on the Go value itself rather than a copy.
+func (vm *VM) reflectToValue(value reflect.Value) *runtime.JavaScriptValue {
+	if !value.IsValid() {
+		return runtime.NewNullValue()
+	}
+
+	if value.CanInterface() {
+		switch v := value.Interface().(type) {
+		case Value:
+			return v.JavaScriptValue()
+		case *runtime.JavaScriptValue:
+			if v == nil {
+				return runtime.NewUndefinedValue()
+			}
+			return v
+		case *big.Int:
+			if v == nil {
+				return runtime.NewNullValue()
+			}
+			return runtime.NewBigIntValue(new(big.Int).Set(v))
+		case Function:
+			if v == nil {
+				return runtime.NewNullValue()
+			}
+			return vm.function(v)
+		case func(FunctionCall) (Value, error):
+			if v == nil {
+				return runtime.NewNullValue()
+			}
+			return vm.function(v)
+		case error:
+			if isNil(value) {
+				return runtime.NewNullValue()
+			}
+			return runtime.NewNativeError(vm.runtime, runtime.IntrinsicErrorConstructor, v.Error())
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		return runtime.NewBooleanValue(value.Bool())
+	case reflect.String:
+		return runtime.NewStringValue(value.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return runtime.NewNumberValue(float64(value.Int()), false)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return runtime.NewNumberValue(float64(value.Uint()), false)
+	case reflect.Float32, reflect.Float64:
+		return newNumber(value.Float())
+	case reflect.Interface:
+		if value.IsNil() {
+			return runtime.NewNullValue()
+		}
+		return vm.reflectToValue(value.Elem())
+	case reflect.Pointer:
+		if value.IsNil() {
+			return runtime.NewNullValue()
+		}
+		switch value.Elem().Kind() {
+		case reflect.Struct:
+			return runtime.NewJavaScriptValue(runtime.TypeObject, vm.newGoStructObject(value, value.Elem()))
+		case reflect.Slice, reflect.Array:
+			return runtime.NewJavaScriptValue(runtime.TypeObject, vm.newGoSliceObject(value, value.Elem()))
+		}
+		return vm.reflectToValue(value.Elem())
+	case reflect.Struct:
+		return runtime.NewJavaScriptValue(runtime.TypeObject, vm.newGoStructObject(value, addressable(value)))
+	case reflect.Slice:
+		if value.IsNil() {
+			return runtime.NewNullValue()
+		}
+		return runtime.NewJavaScriptValue(runtime.TypeObject, vm.newGoSliceObject(value, addressable(value)))
+	case reflect.Array:
+		return runtime.NewJavaScriptValue(runtime.TypeObject, vm.newGoSliceObject(value, addressable(value)))
+	case reflect.Map:
+		if value.IsNil() {
+			return runtime.NewNullValue()
+		}
+		if !isConvertible(value.Type()) {
+			return runtime.NewUndefinedValue()
+		}
+		return runtime.NewJavaScriptValue(runtime.TypeObject, vm.newGoMapObject(value))
+	case reflect.Func:
+		if value.IsNil() {
+			return runtime.NewNullValue()
+		}
+		return vm.reflectFunction(value, "")
+	}
+
+	// Channels, complex numbers and unsafe pointers have no JavaScript equivalent.
+	return runtime.NewUndefinedValue()
+}
+
+func isNil(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return value.IsNil()
+	}
+	return false
+}
+
+// addressable returns value itself if it can be modified, or a modifiable copy of it.
+// A copied slice still shares its elements with the original.
+func addressable(value reflect.Value) reflect.Value {
+	if value.CanAddr() {
+		return value
+	}
+
+	copied := reflect.New(value.Type()).Elem()
+	copied.Set(value)
+	return copied
+}
+
+func isConvertible(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Chan, reflect.UnsafePointer, reflect.Complex64, reflect.Complex128:
+		return false
+	case reflect.Map:
+		switch t.Key().Kind() {
+		case reflect.String,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return true
+		}
+		return false
+	case reflect.Pointer:
+		return isConvertible(t.Elem())
+	}
+	return true
+}
+
+// reflectFunction wraps a Go func so scripts can call it. Arguments are converted to the parameter types and
+// results back to JavaScript: no results give undefined, one gives its value and several give an array.
+// A trailing error result is not part of the value, a non-nil error is thrown instead.
+func (vm *VM) reflectFunction(function reflect.Value, name string) *runtime.JavaScriptValue {
+	functionType := function.Type()
+
+	behaviour := func(
+		rt *runtime.Runtime,
+		functionObject *runtime.FunctionObject,
+		thisArg *runtime.JavaScriptValue,
+		arguments []*runtime.JavaScriptValue,
+		newTarget *runtime.JavaScriptValue,
+	) *runtime.Completion {
+		parameterCount := functionType.NumIn()
+		if functionType.IsVariadic() {
+			parameterCount--
+		}
+
+		in := make([]reflect.Value, 0, max(parameterCount, len(arguments)))
+		for idx := range max(parameterCount, len(arguments)) {
+			var parameterType reflect.Type
+			if idx < parameterCount {
+				parameterType = functionType.In(idx)
+			} else if functionType.IsVariadic() {
+				parameterType = functionType.In(parameterCount).Elem()
+			} else {
+				break
+			}
+
+			argument := runtime.NewUndefinedValue()
+			if idx < len(arguments) {
+				argument = arguments[idx]
+			}
+
+			converted, err := vm.exportTo(argument, parameterType)
+			if err != nil {
+				return vm.throwConversionError(err, fmt.Sprintf("argument %d", idx))
+			}
+			in = append(in, converted)
+		}
+
+		out := function.Call(in)
+
+		if count := len(out); count > 0 && functionType.Out(count-1) == errorType {
+			if err := out[count-1]; !err.IsNil() {
+				return vm.throwError(err.Interface().(error))
+			}
+			out = out[:count-1]
+		}
+
+		switch len(out) {
+		case 0:
+			return runtime.NewNormalCompletion(runtime.NewUndefinedValue())
+		case 1:
+			return runtime.NewNormalCompletion(vm.reflectToValue(out[0]))
+		}
+
+		results := make([]*runtime.JavaScriptValue, len(out))
+		for idx, result := range out {
+			results[idx] = vm.reflectToValue(result)
+		}
+		return runtime.NewNormalCompletion(runtime.NewJavaScriptValue(runtime.TypeObject, runtime.CreateArrayFromList(vm.runtime, results)))
+	}
+
+	length := functionType.NumIn()
+	if functionType.IsVariadic() {
+		length--
+	}
+
+	functionObject := runtime.CreateBuiltinFunction(vm.runtime, behaviour, length, runtime.NewStringValue(name), vm.realm, nil)
+	return runtime.NewJavaScriptValue(runtime.TypeObject, functionObject)
+}
+
+// throwError throws err in JavaScript: an *Exception throws the value it wraps, any other error throws an Error
+// with its message.
+func (vm *VM) throwError(err error) *runtime.Completion {
+	var exception *Exception
+	if errors.As(err, &exception) {
+		return runtime.NewThrowCompletion(exception.value.JavaScriptValue())
+	}
+
+	// Keep terminating the script if the error came from calling back into an interrupted script.
+	var interrupted *runtime.InterruptedError
+	if errors.As(err, &interrupted) {
+		return runtime.NewTerminateCompletion(interrupted)
+	}
+	return runtime.NewThrowCompletion(runtime.NewNativeError(vm.runtime, runtime.IntrinsicErrorConstructor, err.Error()))
+}
+
+// throwConversionError throws a TypeError for a value that couldn't be converted to a Go type,
+// or rethrows the exception that interrupted the conversion.
+func (vm *VM) throwConversionError(err error, what string) *runtime.Completion {
+	var exception *Exception
+	if errors.As(err, &exception) {
+		return runtime.NewThrowCompletion(exception.value.JavaScriptValue())
+	}
+
+	var interrupted *runtime.InterruptedError
+	if errors.As(err, &interrupted) {
+		return runtime.NewTerminateCompletion(interrupted)
+	}
+	return runtime.NewThrowCompletion(runtime.NewTypeError(vm.runtime, fmt.Sprintf("Invalid %s: %s", what, err.Error())))
+}
+
+// defineGoValue applies a property descriptor to a property backed by a Go value. Only data descriptors can be
+// applied, the property keeps its other attributes.
+func (vm *VM) defineGoValue(rt *runtime.Runtime, descriptor runtime.PropertyDescriptor, writable bool, set func(value *runtime.JavaScriptValue) error) *runtime.Completion {
+	dataDescriptor, ok := descriptor.(*runtime.DataPropertyDescriptor)
+	if !ok || !writable {
+		return runtime.NewNormalCompletion(runtime.NewBooleanValue(false))
+	}
+
+	if dataDescriptor.Value == nil {
+		return runtime.NewNormalCompletion(runtime.NewBooleanValue(true))
+	}
+
+	if err := set(dataDescriptor.Value); err != nil {
+		return vm.throwConversionError(err, "value")
+	}
+	return runtime.NewNormalCompletion(runtime.NewBooleanValue(true))
+}
+
+// ExportTo converts value to the type of the Go value target points to and stores it there.
+// Objects that wrap Go values are unwrapped, arrays and objects are copied into slices, maps and structs, and
+// functions are wrapped into Go funcs that call them.
+func (vm *VM) ExportTo(value Value, target any) error {
+	pointer := reflect.ValueOf(target)
+	if pointer.Kind() != reflect.Pointer || pointer.IsNil() {
+		return errors.New("gojs: ExportTo target must be a non-nil pointer")
+	}
+
+	converted, err := vm.exportTo(value.JavaScriptValue(), pointer.Type().Elem())
+	if err != nil {
+		return err
+	}
+
+	pointer.Elem().Set(converted)
+	return nil
+}
+
+func (vm *VM) exportTo(value *runtime.JavaScriptValue, t reflect.Type) (reflect.Value, error) {
+	switch t {
+	case valueType:
+		return reflect.ValueOf(vm.wrap(value)), nil
+	case jsValueType:
+		return reflect.ValueOf(value), nil
+	}
+
+	if value.Type == runtime.TypeObject {
+		if object, ok := value.Value.(goObject); ok {
+			origin := object.goValue()
+			if origin.Type().AssignableTo(t) {
+				return origin, nil
+			}
+			if origin.Kind() == reflect.Pointer && origin.Elem().Type().AssignableTo(t) {
+				return origin.Elem(), nil
+			}
+		}
+	}
+
+	nullish := value.Type == runtime.TypeUndefined || value.Type == runtime.TypeNull
+
+	switch t.Kind() {
+	case reflect.Interface:
+		if nullish {
+			return reflect.Zero(t), nil
+		}
+		if t == errorType && value.Type == runtime.TypeObject {
+			return reflect.ValueOf(vm.newException(value)), nil
+		}
+		exported := reflect.ValueOf(vm.wrap(value).Export())
+		if !exported.Type().AssignableTo(t) {
+			return reflect.Value{}, fmt.Errorf("cannot use %s as %s", exported.Type(), t)
+		}
+		return exported.Convert(t), nil
+
+	case reflect.Bool:
+		return reflect.ValueOf(runtime.ToBoolean(value).Value.(*runtime.JavaScriptValue).Value.(*runtime.Boolean).Value).Convert(t), nil
+
+	case reflect.String:
+		completion := runtime.ToString(vm.runtime, value)
+		if err := vm.completionError(completion); err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(completion.Value.(*runtime.JavaScriptValue).Value.(*runtime.String).Value).Convert(t), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return vm.exportNumber(value, t)
+
+	case reflect.Pointer:
+		if nullish {
+			return reflect.Zero(t), nil
+		}
+		if t == bigIntType && value.Type == runtime.TypeBigInt {
+			return reflect.ValueOf(new(big.Int).Set(value.Value.(*runtime.BigInt).Value)), nil
+		}
+		element, err := vm.exportTo(value, t.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		pointer := reflect.New(t.Elem())
+		pointer.Elem().Set(element)
+		return pointer, nil
+
+	case reflect.Slice, reflect.Array:
+		if nullish && t.Kind() == reflect.Slice {
+			return reflect.Zero(t), nil
+		}
+		return vm.exportList(value, t)
+
+	case reflect.Map:
+		if nullish {
+			return reflect.Zero(t), nil
+		}
+		return vm.exportMap(value, t)
+
+	case reflect.Struct:
+		return vm.exportStruct(value, t)
+
+	case reflect.Func:
+		if nullish {
+			return reflect.Zero(t), nil
+		}
+		if !runtime.IsCallable(value) {
+			return reflect.Value{}, errors.New("value is not a function")
+		}
+		return vm.exportFunction(value, t), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot convert to %s", t)
+}
+
+func (vm *VM) exportNumber(value *runtime.JavaScriptValue, t reflect.Type) (reflect.Value, error) {
+	completion := runtime.ToNumber(vm.runtime, value)
+	if err := vm.completionError(completion); err != nil {
+		return reflect.Value{}, err
+	}
+
+	number := completion.Value.(*runtime.JavaScriptValue).Value.(*runtime.Number)
+	result := reflect.New(t).Elem()
+
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if number.NaN {
+			result.SetFloat(math.NaN())
+		} else {
+			result.SetFloat(number.Value)
+		}
+		return result, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		integer := math.Trunc(number.Value)
+		if number.NaN || math.IsInf(integer, 0) || integer < math.MinInt64 || integer >= math.MaxInt64 || result.OverflowInt(int64(integer)) {
+			return reflect.Value{}, fmt.Errorf("%s is out of range for %s", runtime.NumberToString(number, 10).Value.(*runtime.String).Value, t)
+		}
+		result.SetInt(int64(integer))
+		return result, nil
+	}
+
+	integer := math.Trunc(number.Value)
+	if number.NaN || math.IsInf(integer, 0) || integer < 0 || integer >= math.MaxUint64 || result.OverflowUint(uint64(integer)) {
+		return reflect.Value{}, fmt.Errorf("%s is out of range for %s", runtime.NumberToString(number, 10).Value.(*runtime.String).Value, t)
+	}
+	result.SetUint(uint64(integer))
+	return result, nil
+}
+
+func (vm *VM) exportList(value *runtime.JavaScriptValue, t reflect.Type) (reflect.Value, error) {
+	if value.Type != runtime.TypeObject {
+		return reflect.Value{}, fmt.Errorf("cannot convert a %s to %s", typeName(value), t)
+	}
+
+	object := value.Value.(runtime.ObjectInterface)
+	lengthCompletion := runtime.LengthOfArrayLike(vm.runtime, object)
+	if err := vm.completionError(lengthCompletion); err != nil {
+		return reflect.Value{}, err
+	}
+	length := int(lengthCompletion.Value.(*runtime.JavaScriptValue).Value.(*runtime.Number).Value)
+
+	var list reflect.Value
+	if t.Kind() == reflect.Array {
+		if length != t.Len() {
+			return reflect.Value{}, fmt.Errorf("cannot convert an array of length %d to %s", length, t)
+		}
+		list = reflect.New(t).Elem()
+	} else {
+		list = reflect.MakeSlice(t, length, length)
+	}
+
+	for idx := range length {
+		completion := object.Get(vm.runtime, runtime.NewStringValue(strconv.Itoa(idx)), value)
+		if err := vm.completionError(completion); err != nil {
+			return reflect.Value{}, err
+		}
+
+		element, err := vm.exportTo(completion.Value.(*runtime.JavaScriptValue), t.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		list.Index(idx).Set(element)
+	}
+
+	return list, nil
+}
+
+// forEachEnumerableProperty calls fn with the own enumerable string-keyed properties of an object, in order.
+func (vm *VM) forEachEnumerableProperty(value *runtime.JavaScriptValue, fn func(key string, property *runtime.JavaScriptValue) error) error {
+	object := value.Value.(runtime.ObjectInterface)
+
+	keysCompletion := object.OwnPropertyKeys(vm.runtime)
+	if err := vm.completionError(keysCompletion); err != nil {
+		return err
+	}
+
+	for _, key := range keysCompletion.Value.([]*runtime.JavaScriptValue) {
+		if key.Type != runtime.TypeString {
+			continue
+		}
+
+		descriptorCompletion := object.GetOwnProperty(vm.runtime, key)
+		if err := vm.completionError(descriptorCompletion); err != nil {
+			return err
+		}
+
+		descriptor, ok := descriptorCompletion.Value.(runtime.PropertyDescriptor)
+		if !ok || descriptor == nil || !descriptor.GetEnumerable() {
+			continue
+		}
+
+		completion := object.Get(vm.runtime, key, value)
+		if err := vm.completionError(completion); err != nil {
+			return err
+		}
+
+		if err := fn(key.Value.(*runtime.String).Value, completion.Value.(*runtime.JavaScriptValue)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (vm *VM) exportMap(value *runtime.JavaScriptValue, t reflect.Type) (reflect.Value, error) {
+	if value.Type != runtime.TypeObject || !isConvertible(t) {
+		return reflect.Value{}, fmt.Errorf("cannot convert a %s to %s", typeName(value), t)
+	}
+
+	result := reflect.MakeMap(t)
+	err := vm.forEachEnumerableProperty(value, func(key string, property *runtime.JavaScriptValue) error {
+		mapKey, err := vm.exportTo(runtime.NewStringValue(key), t.Key())
+		if err != nil {
+			return err
+		}
+
+		element, err := vm.exportTo(property, t.Elem())
+		if err != nil {
+			return err
+		}
+
+		result.SetMapIndex(mapKey, element)
+		return nil
+	})
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	return result, nil
+}
+
+func (vm *VM) exportStruct(value *runtime.JavaScriptValue, t reflect.Type) (reflect.Value, error) {
+	if value.Type != runtime.TypeObject {
+		return reflect.Value{}, fmt.Errorf("cannot convert a %s to %s", typeName(value), t)
+	}
+
+	object := value.Value.(runtime.ObjectInterface)
+	result := reflect.New(t).Elem()
+	info := vm.structTypeOf(t)
+
+	for _, name := range info.fieldNames {
+		completion := object.Get(vm.runtime, runtime.NewStringValue(name), value)
+		if err := vm.completionError(completion); err != nil {
+			return reflect.Value{}, err
+		}
+
+		property := completion.Value.(*runtime.JavaScriptValue)
+		if property.Type == runtime.TypeUndefined {
+			continue
+		}
+
+		field, err := result.FieldByIndexErr(info.fields[name])
+		if err != nil {
+			continue
+		}
+
+		converted, err := vm.exportTo(property, field.Type())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("field %s: %w", name, err)
+		}
+		field.Set(converted)
+	}
+
+	return result, nil
+}
+
+// exportFunction wraps a JavaScript function in a Go func of type t. A thrown exception is returned if the func
+// has a trailing error result, and panics with the *Exception otherwise.
+func (vm *VM) exportFunction(function *runtime.JavaScriptValue, t reflect.Type) reflect.Value {
+	return reflect.MakeFunc(t, func(args []reflect.Value) []reflect.Value {
+		returnsError := t.NumOut() > 0 && t.Out(t.NumOut()-1) == errorType
+		results := make([]reflect.Value, t.NumOut())
+		for idx := range results {
+			results[idx] = reflect.Zero(t.Out(idx))
+		}
+
+		fail := func(err error) []reflect.Value {
+			if !returnsError {
+				panic(err)
+			}
+			results[len(results)-1] = reflect.ValueOf(&err).Elem()
+			return results
+		}
+
+		if t.IsVariadic() && len(args) > 0 {
+			variadic := args[len(args)-1]
+			args = args[:len(args)-1]
+			for idx := range variadic.Len() {
+				args = append(args, variadic.Index(idx))
+			}
+		}
+
+		arguments := make([]*runtime.JavaScriptValue, len(args))
+		for idx, arg := range args {
+			arguments[idx] = vm.reflectToValue(arg)
+		}
+
+		completion := vm.runtime.Execute(func() *runtime.Completion {
+			return runtime.Call(vm.runtime, function, runtime.NewUndefinedValue(), arguments)
+		})
+		if err := vm.completionError(completion); err != nil {
+			return fail(err)
+		}
+
+		valueCount := t.NumOut()
+		if returnsError {
+			valueCount--
+		}
+
+		if valueCount > 0 {
+			converted, err := vm.exportTo(completion.Value.(*runtime.JavaScriptValue), t.Out(0))
+			if err != nil {
+				return fail(err)
+			}
+			results[0] = converted
+		}
+
+		return results
+	})
+}
+
+func typeName(value *runtime.JavaScriptValue) string {
+	switch value.Type {
+	case runtime.TypeUndefined:
+		return "undefined"
+	case runtime.TypeNull:
+		return "null"
+	case runtime.TypeBoolean:
+		return "boolean"
+	case runtime.TypeString:
+		return "string"
+	case runtime.TypeNumber:
+		return "number"
+	case runtime.TypeBigInt:
+		return "bigint"
+	case runtime.TypeSymbol:
+		return "symbol"
+	}
+	return "object"
+}
@@ -0,0 +1,486 @@
+package gojs
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+// goObject is implemented by the objects that expose Go values to scripts, so they can be exported back unchanged.
+type goObject interface {
+	runtime.ObjectInterface
+	goValue() reflect.Value
+}
+
+// structType describes how a struct type is exposed to scripts.
+type structType struct {
+	fields      map[string][]int // Property name to field index path.
+	fieldNames  []string         // In declaration order.
+	methods     map[string]int   // Property name to method index of the pointer type.
+	methodNames []string
+}
+
+func (vm *VM) structTypeOf(t reflect.Type) *structType {
+	if info, ok := vm.structTypes[t]; ok {
+		return info
+	}
+
+	info := &structType{
+		fields:  make(map[string][]int),
+		methods: make(map[string]int),
+	}
+
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || (field.Anonymous && field.Type.Kind() == reflect.Struct) {
+			continue
+		}
+
+		name := vm.fieldNameMapper.FieldName(t, field)
+		if _, exists := info.fields[name]; name == "" || exists {
+			continue
+		}
+
+		info.fields[name] = field.Index
+		info.fieldNames = append(info.fieldNames, name)
+	}
+
+	pointerType := reflect.PointerTo(t)
+	for idx := range pointerType.NumMethod() {
+		method := pointerType.Method(idx)
+		name := vm.fieldNameMapper.MethodName(t, method)
+		if _, isField := info.fields[name]; name == "" || isField {
+			continue
+		}
+
+		info.methods[name] = idx
+		info.methodNames = append(info.methodNames, name)
+	}
+
+	vm.structTypes[t] = info
+	return info
+}
+
+// goStructObject exposes an addressable Go struct. Exported fields are writable data properties and
+// methods are read-only functions. Other properties, such as symbols, are stored like on ordinary objects.
+type goStructObject struct {
+	*runtime.Object
+	vm      *VM
+	origin  reflect.Value
+	value   reflect.Value
+	info    *structType
+	methods map[string]*runtime.JavaScriptValue
+}
+
+func (vm *VM) newGoStructObject(origin reflect.Value, value reflect.Value) *goStructObject {
+	return &goStructObject{
+		Object:  runtime.OrdinaryObjectCreate(vm.realm.GetIntrinsic(runtime.IntrinsicObjectPrototype)).(*runtime.Object),
+		vm:      vm,
+		origin:  origin,
+		value:   value,
+		info:    vm.structTypeOf(value.Type()),
+		methods: make(map[string]*runtime.JavaScriptValue),
+	}
+}
+
+func (o *goStructObject) goValue() reflect.Value {
+	return o.origin
+}
+
+func (o *goStructObject) field(key *runtime.JavaScriptValue) (reflect.Value, bool) {
+	if key.Type != runtime.TypeString {
+		return reflect.Value{}, false
+	}
+
+	index, ok := o.info.fields[key.Value.(*runtime.String).Value]
+	if !ok {
+		return reflect.Value{}, false
+	}
+
+	// Promoted fields of nil embedded pointers are treated as missing.
+	field, err := o.value.FieldByIndexErr(index)
+	return field, err == nil
+}
+
+func (o *goStructObject) method(key *runtime.JavaScriptValue) (*runtime.JavaScriptValue, bool) {
+	if key.Type != runtime.TypeString {
+		return nil, false
+	}
+
+	name := key.Value.(*runtime.String).Value
+	index, ok := o.info.methods[name]
+	if !ok {
+		return nil, false
+	}
+
+	if method, ok := o.methods[name]; ok {
+		return method, true
+	}
+
+	method := o.vm.reflectFunction(o.value.Addr().Method(index), name)
+	o.methods[name] = method
+	return method, true
+}
+
+func (o *goStructObject) GetOwnProperty(rt *runtime.Runtime, key *runtime.JavaScriptValue) *runtime.Completion {
+	if field, ok := o.field(key); ok {
+		return runtime.NewNormalCompletion(&runtime.DataPropertyDescriptor{
+			Value:        o.vm.reflectToValue(field),
+			Writable:     field.CanSet(),
+			Enumerable:   true,
+			Configurable: true,
+		})
+	}
+
+	if method, ok := o.method(key); ok {
+		return runtime.NewNormalCompletion(&runtime.DataPropertyDescriptor{
+			Value:        method,
+			Writable:     false,
+			Enumerable:   false,
+			Configurable: true,
+		})
+	}
+
+	return runtime.OrdinaryGetOwnProperty(rt, o, key)
+}
+
+func (o *goStructObject) DefineOwnProperty(rt *runtime.Runtime, key *runtime.JavaScriptValue, descriptor runtime.PropertyDescriptor) *runtime.Completion {
+	if field, ok := o.field(key); ok {
+		return o.vm.defineGoValue(rt, descriptor, field.CanSet(), func(value *runtime.JavaScriptValue) error {
+			converted, err := o.vm.exportTo(value, field.Type())
+			if err == nil {
+				field.Set(converted)
+			}
+			return err
+		})
+	}
+
+	if _, ok := o.method(key); ok {
+		return runtime.NewNormalCompletion(runtime.NewBooleanValue(false))
+	}
+
+	return runtime.OrdinaryDefineOwnProperty(rt, o, key, descriptor)
+}
+
+func (o *goStructObject) SetPrototypeOf(rt *runtime.Runtime, prototype *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinarySetPrototypeOf(rt, o, prototype)
+}
+
+func (o *goStructObject) HasProperty(rt *runtime.Runtime, key *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinaryHasProperty(rt, o, key)
+}
+
+func (o *goStructObject) Get(rt *runtime.Runtime, key *runtime.JavaScriptValue, receiver *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinaryGet(rt, o, key, receiver)
+}
+
+func (o *goStructObject) Set(rt *runtime.Runtime, key *runtime.JavaScriptValue, value *runtime.JavaScriptValue, receiver *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinarySet(rt, o, key, value, receiver)
+}
+
+func (o *goStructObject) Delete(rt *runtime.Runtime, key *runtime.JavaScriptValue) *runtime.Completion {
+	if _, ok := o.field(key); ok {
+		return runtime.NewNormalCompletion(runtime.NewBooleanValue(false))
+	}
+	if _, ok := o.method(key); ok {
+		return runtime.NewNormalCompletion(runtime.NewBooleanValue(false))
+	}
+	return runtime.OrdinaryDelete(rt, o, key)
+}
+
+func (o *goStructObject) OwnPropertyKeys(rt *runtime.Runtime) *runtime.Completion {
+	keys := make([]*runtime.JavaScriptValue, 0, len(o.info.fieldNames)+len(o.info.methodNames))
+	for _, name := range o.info.fieldNames {
+		if _, ok := o.field(runtime.NewStringValue(name)); ok {
+			keys = append(keys, runtime.NewStringValue(name))
+		}
+	}
+	for _, name := range o.info.methodNames {
+		keys = append(keys, runtime.NewStringValue(name))
+	}
+	return runtime.NewNormalCompletion(append(keys, runtime.OrdinaryOwnPropertyKeys(o)...))
+}
+
+// goMapObject exposes a Go map whose keys are strings or integers. Every entry is a writable data property and
+// assigning or deleting a property changes the map.
+type goMapObject struct {
+	*runtime.Object
+	vm    *VM
+	value reflect.Value
+}
+
+func (vm *VM) newGoMapObject(value reflect.Value) *goMapObject {
+	return &goMapObject{
+		Object: runtime.OrdinaryObjectCreate(vm.realm.GetIntrinsic(runtime.IntrinsicObjectPrototype)).(*runtime.Object),
+		vm:     vm,
+		value:  value,
+	}
+}
+
+func (o *goMapObject) goValue() reflect.Value {
+	return o.value
+}
+
+func (o *goMapObject) mapKey(key *runtime.JavaScriptValue) (reflect.Value, bool) {
+	if key.Type != runtime.TypeString {
+		return reflect.Value{}, false
+	}
+
+	name := key.Value.(*runtime.String).Value
+	keyType := o.value.Type().Key()
+
+	switch keyType.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(name).Convert(keyType), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, err := strconv.ParseInt(name, 10, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(number).Convert(keyType), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, err := strconv.ParseUint(name, 10, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(number).Convert(keyType), true
+	}
+
+	return reflect.Value{}, false
+}
+
+func (o *goMapObject) GetOwnProperty(rt *runtime.Runtime, key *runtime.JavaScriptValue) *runtime.Completion {
+	if mapKey, ok := o.mapKey(key); ok {
+		if entry := o.value.MapIndex(mapKey); entry.IsValid() {
+			return runtime.NewNormalCompletion(&runtime.DataPropertyDescriptor{
+				Value:        o.vm.reflectToValue(entry),
+				Writable:     true,
+				Enumerable:   true,
+				Configurable: true,
+			})
+		}
+		return runtime.NewNormalCompletion(nil)
+	}
+
+	return runtime.OrdinaryGetOwnProperty(rt, o, key)
+}
+
+func (o *goMapObject) DefineOwnProperty(rt *runtime.Runtime, key *runtime.JavaScriptValue, descriptor runtime.PropertyDescriptor) *runtime.Completion {
+	if mapKey, ok := o.mapKey(key); ok {
+		return o.vm.defineGoValue(rt, descriptor, !o.value.IsNil(), func(value *runtime.JavaScriptValue) error {
+			converted, err := o.vm.exportTo(value, o.value.Type().Elem())
+			if err == nil {
+				o.value.SetMapIndex(mapKey, converted)
+			}
+			return err
+		})
+	}
+
+	return runtime.OrdinaryDefineOwnProperty(rt, o, key, descriptor)
+}
+
+func (o *goMapObject) SetPrototypeOf(rt *runtime.Runtime, prototype *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinarySetPrototypeOf(rt, o, prototype)
+}
+
+func (o *goMapObject) HasProperty(rt *runtime.Runtime, key *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinaryHasProperty(rt, o, key)
+}
+
+func (o *goMapObject) Get(rt *runtime.Runtime, key *runtime.JavaScriptValue, receiver *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinaryGet(rt, o, key, receiver)
+}
+
+func (o *goMapObject) Set(rt *runtime.Runtime, key *runtime.JavaScriptValue, value *runtime.JavaScriptValue, receiver *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinarySet(rt, o, key, value, receiver)
+}
+
+func (o *goMapObject) Delete(rt *runtime.Runtime, key *runtime.JavaScriptValue) *runtime.Completion {
+	if mapKey, ok := o.mapKey(key); ok {
+		if !o.value.IsNil() {
+			o.value.SetMapIndex(mapKey, reflect.Value{})
+		}
+		return runtime.NewNormalCompletion(runtime.NewBooleanValue(true))
+	}
+	return runtime.OrdinaryDelete(rt, o, key)
+}
+
+// OwnPropertyKeys lists the map's keys in sorted order, since Go maps have no order of their own.
+func (o *goMapObject) OwnPropertyKeys(rt *runtime.Runtime) *runtime.Completion {
+	names := make([]string, 0, o.value.Len())
+	for _, mapKey := range o.value.MapKeys() {
+		names = append(names, formatMapKey(mapKey))
+	}
+	sort.Strings(names)
+
+	keys := make([]*runtime.JavaScriptValue, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, runtime.NewStringValue(name))
+	}
+	return runtime.NewNormalCompletion(append(keys, runtime.OrdinaryOwnPropertyKeys(o)...))
+}
+
+func formatMapKey(key reflect.Value) string {
+	switch key.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(key.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(key.Uint(), 10)
+	}
+	return key.String()
+}
+
+// goSliceObject is an array-like exotic object over an addressable Go slice. Writes to existing indices change
+// the slice's elements, and writes past the end or to "length" resize it.
+type goSliceObject struct {
+	*runtime.Object
+	vm     *VM
+	origin reflect.Value
+	value  reflect.Value
+}
+
+const lengthKey = "length"
+
+func (vm *VM) newGoSliceObject(origin reflect.Value, value reflect.Value) *goSliceObject {
+	return &goSliceObject{
+		Object: runtime.OrdinaryObjectCreate(vm.realm.GetIntrinsic(runtime.IntrinsicArrayPrototype)).(*runtime.Object),
+		vm:     vm,
+		origin: origin,
+		value:  value,
+	}
+}
+
+func (o *goSliceObject) goValue() reflect.Value {
+	return o.origin
+}
+
+func (o *goSliceObject) index(key *runtime.JavaScriptValue) (int, bool) {
+	if key.Type != runtime.TypeString {
+		return -1, false
+	}
+
+	index, ok := runtime.ArrayIndex(key.Value.(*runtime.String).Value)
+	return int(index), ok
+}
+
+func (o *goSliceObject) isLength(key *runtime.JavaScriptValue) bool {
+	return key.Type == runtime.TypeString && key.Value.(*runtime.String).Value == lengthKey
+}
+
+// resize changes the length of the slice, keeping the existing elements and zeroing new ones.
+func (o *goSliceObject) resize(length int) bool {
+	if o.value.Kind() == reflect.Array {
+		return length == o.value.Len()
+	}
+
+	if length <= o.value.Cap() {
+		previous := o.value.Len()
+		o.value.SetLen(length)
+		for idx := previous; idx < length; idx++ {
+			o.value.Index(idx).SetZero()
+		}
+		return true
+	}
+
+	grown := reflect.MakeSlice(o.value.Type(), length, length)
+	reflect.Copy(grown, o.value)
+	o.value.Set(grown)
+	return true
+}
+
+func (o *goSliceObject) GetOwnProperty(rt *runtime.Runtime, key *runtime.JavaScriptValue) *runtime.Completion {
+	if index, ok := o.index(key); ok {
+		if index >= o.value.Len() {
+			return runtime.NewNormalCompletion(nil)
+		}
+		return runtime.NewNormalCompletion(&runtime.DataPropertyDescriptor{
+			Value:        o.vm.reflectToValue(o.value.Index(index)),
+			Writable:     true,
+			Enumerable:   true,
+			Configurable: true,
+		})
+	}
+
+	if o.isLength(key) {
+		return runtime.NewNormalCompletion(&runtime.DataPropertyDescriptor{
+			Value:        runtime.NewNumberValue(float64(o.value.Len()), false),
+			Writable:     o.value.Kind() == reflect.Slice,
+			Enumerable:   false,
+			Configurable: false,
+		})
+	}
+
+	return runtime.OrdinaryGetOwnProperty(rt, o, key)
+}
+
+func (o *goSliceObject) DefineOwnProperty(rt *runtime.Runtime, key *runtime.JavaScriptValue, descriptor runtime.PropertyDescriptor) *runtime.Completion {
+	if index, ok := o.index(key); ok {
+		return o.vm.defineGoValue(rt, descriptor, true, func(value *runtime.JavaScriptValue) error {
+			converted, err := o.vm.exportTo(value, o.value.Type().Elem())
+			if err != nil {
+				return err
+			}
+			if index >= o.value.Len() && !o.resize(index+1) {
+				return errArrayLength
+			}
+			o.value.Index(index).Set(converted)
+			return nil
+		})
+	}
+
+	if o.isLength(key) {
+		return o.vm.defineGoValue(rt, descriptor, o.value.Kind() == reflect.Slice, func(value *runtime.JavaScriptValue) error {
+			var length uint32
+			converted, err := o.vm.exportTo(value, reflect.TypeOf(length))
+			if err != nil {
+				return err
+			}
+			if !o.resize(int(converted.Uint())) {
+				return errArrayLength
+			}
+			return nil
+		})
+	}
+
+	return runtime.OrdinaryDefineOwnProperty(rt, o, key, descriptor)
+}
+
+func (o *goSliceObject) SetPrototypeOf(rt *runtime.Runtime, prototype *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinarySetPrototypeOf(rt, o, prototype)
+}
+
+func (o *goSliceObject) HasProperty(rt *runtime.Runtime, key *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinaryHasProperty(rt, o, key)
+}
+
+func (o *goSliceObject) Get(rt *runtime.Runtime, key *runtime.JavaScriptValue, receiver *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinaryGet(rt, o, key, receiver)
+}
+
+func (o *goSliceObject) Set(rt *runtime.Runtime, key *runtime.JavaScriptValue, value *runtime.JavaScriptValue, receiver *runtime.JavaScriptValue) *runtime.Completion {
+	return runtime.OrdinarySet(rt, o, key, value, receiver)
+}
+
+// Delete sets an element to its zero value, since Go slices can't have holes.
+func (o *goSliceObject) Delete(rt *runtime.Runtime, key *runtime.JavaScriptValue) *runtime.Completion {
+	if index, ok := o.index(key); ok {
+		if index < o.value.Len() {
+			o.value.Index(index).SetZero()
+		}
+		return runtime.NewNormalCompletion(runtime.NewBooleanValue(true))
+	}
+	if o.isLength(key) {
+		return runtime.NewNormalCompletion(runtime.NewBooleanValue(false))
+	}
+	return runtime.OrdinaryDelete(rt, o, key)
+}
+
+func (o *goSliceObject) OwnPropertyKeys(rt *runtime.Runtime) *runtime.Completion {
+	keys := make([]*runtime.JavaScriptValue, 0, o.value.Len()+1)
+	for idx := range o.value.Len() {
+		keys = append(keys, runtime.NewStringValue(strconv.Itoa(idx)))
+	}
+	keys = append(keys, runtime.NewStringValue(lengthKey))
+	return runtime.NewNormalCompletion(append(keys, runtime.OrdinaryOwnPropertyKeys(o)...))
+}
@@ -0,0 +1,151 @@
+package gojs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	X, Y   int
+	Label  string `js:"label"`
+	secret int
+	Hidden bool `js:"-"`
+}
+
+func (p *point) Move(dx, dy int) {
+	p.X += dx
+	p.Y += dy
+}
+
+func (p point) String() string {
+	return fmt.Sprintf("(%d, %d)", p.X, p.Y)
+}
+
+type shape struct {
+	point
+	Name   string
+	Points []point
+}
+
+func TestStructs(t *testing.T) {
+	vm := New()
+	vm.SetFieldNameMapper(TagFieldNameMapper("js", false))
+	p := &point{X: 1, Y: 2, Label: "a"}
+	assert.NoError(t, vm.Set("p", p))
+
+	assert.Equal(t, "1,2,a,undefined,undefined", run(t, vm, `[p.X, p.Y, p.label, p.Label, p.secret].join(",")`).Export())
+
+	run(t, vm, `p.X = 10; p.Move(1, 1); p.label = "b";`)
+	assert.Equal(t, point{X: 11, Y: 3, Label: "b"}, *p)
+	assert.Equal(t, "(11, 3)", run(t, vm, `p.String()`).Export())
+	assert.Equal(t, "X,Y,label", run(t, vm, `Object.keys(p).join(",")`).Export())
+	assert.Same(t, p, vm.Get("p").Export())
+
+	_, err := vm.RunString(`p.X = "not a number"`)
+	assert.ErrorContains(t, err, "TypeError")
+}
+
+func TestFieldNameMapper(t *testing.T) {
+	vm := New()
+	vm.SetFieldNameMapper(TagFieldNameMapper("js", true))
+	assert.NoError(t, vm.Set("s", &shape{point: point{X: 5}, Name: "tri", Points: []point{{X: 1}, {X: 2}}}))
+
+	assert.Equal(t, "5,tri,2,false", run(t, vm, `[s.X, s.Name, s.Points.length, "Hidden" in s].join(",")`).Export())
+	run(t, vm, `s.move(1, 0); s.Points[1].move(40, 0);`)
+	shape := vm.Get("s").Export().(*shape)
+	assert.Equal(t, 6, shape.X)
+	assert.Equal(t, 42, shape.Points[1].X)
+}
+
+func TestSlices(t *testing.T) {
+	vm := New()
+	numbers := []int{1, 2, 3}
+	assert.NoError(t, vm.Set("numbers", numbers))
+
+	assert.Equal(t, "3:1-2-3", run(t, vm, `numbers.length + ":" + numbers.join("-")`).Export())
+	run(t, vm, `numbers[0] = 100;`)
+	assert.Equal(t, []int{100, 2, 3}, numbers)
+	assert.Equal(t, int64(6), run(t, vm, `numbers.map(n => n * 2)[2]`).Export())
+
+	growable := []string{"a"}
+	assert.NoError(t, vm.Set("growable", &growable))
+	run(t, vm, `growable.push("b"); growable[3] = "d";`)
+	assert.Equal(t, []string{"a", "b", "", "d"}, growable)
+	run(t, vm, `growable.length = 1;`)
+	assert.Equal(t, []string{"a"}, growable)
+}
+
+// NaN converts to the same value whether it is passed directly or inside a struct or slice.
+func TestNaN(t *testing.T) {
+	vm := New()
+	assert.NoError(t, vm.Set("direct", math.NaN()))
+	assert.NoError(t, vm.Set("inStruct", struct{ Value float64 }{math.NaN()}))
+	assert.NoError(t, vm.Set("inSlice", []float64{math.NaN()}))
+	assert.NoError(t, vm.Set("float32", float32(math.NaN())))
+
+	for _, source := range []string{`direct`, `inStruct.Value`, `inSlice[0]`, `float32`} {
+		t.Run(source, func(t *testing.T) {
+			assert.Equal(t, false, run(t, vm, source+` === `+source).Export())
+			assert.Equal(t, true, run(t, vm, `Object.is(`+source+`, NaN)`).Export())
+			assert.Equal(t, "NaN", run(t, vm, `String(`+source+`)`).Export())
+			assert.True(t, math.IsNaN(run(t, vm, source).Export().(float64)))
+		})
+	}
+}
+
+func TestMaps(t *testing.T) {
+	vm := New()
+	scores := map[string]int{"b": 2, "a": 1}
+	assert.NoError(t, vm.Set("scores", scores))
+
+	assert.Equal(t, "a,b", run(t, vm, `Object.keys(scores).join(",")`).Export())
+	run(t, vm, `scores.c = 3; delete scores.a; scores.b++;`)
+	assert.Equal(t, map[string]int{"b": 3, "c": 3}, scores)
+
+	byID := map[int]string{7: "seven"}
+	assert.NoError(t, vm.Set("byID", byID))
+	assert.Equal(t, "seven", run(t, vm, `byID[7]`).Export())
+}
+
+func TestFuncs(t *testing.T) {
+	vm := New()
+	assert.NoError(t, vm.Set("sum", func(values ...float64) float64 {
+		total := 0.0
+		for _, value := range values {
+			total += value
+		}
+		return total
+	}))
+	assert.NoError(t, vm.Set("divide", func(a, b int) (int, error) {
+		if b == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return a / b, nil
+	}))
+	assert.NoError(t, vm.Set("describe", func(p point, tags []string, options map[string]bool) string {
+		return fmt.Sprintf("%d/%d %v %v", p.X, p.Y, tags, options)
+	}))
+	assert.NoError(t, vm.Set("apply", func(fn func(int) int, value int) int {
+		return fn(value)
+	}))
+
+	assert.Equal(t, 7.5, run(t, vm, `sum(1, 2, 4.5)`).Export())
+	assert.Equal(t, int64(4), run(t, vm, `divide(9, 2)`).Export())
+	assert.Equal(t, "division by zero", run(t, vm, `var message; try { divide(1, 0); } catch (e) { message = e.message; } message`).Export())
+	assert.Equal(t, "3/4 [x y] map[on:true]", run(t, vm, `describe({ X: 3, Y: 4 }, ["x", "y"], { on: true })`).Export())
+	assert.Equal(t, int64(10), run(t, vm, `apply(n => n * 2, 5)`).Export())
+
+	var double func(int) (int, error)
+	assert.NoError(t, vm.ExportTo(run(t, vm, `(n) => n * 2`), &double))
+	result, err := double(21)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, result)
+
+	var target point
+	assert.NoError(t, vm.ExportTo(run(t, vm, `({ X: 1, Y: 2, label: "c" })`), &target))
+	assert.Equal(t, point{X: 1, Y: 2}, target)
+}
@@ -0,0 +1,121 @@
+package gojs
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequireTestFS() fstest.MapFS {
+	file := func(source string) *fstest.MapFile {
+		return &fstest.MapFile{Data: []byte(source)}
+	}
+
+	return fstest.MapFS{
+		"app/main.js":     file(`exports.name = "main"; exports.file = __filename; exports.dir = __dirname; exports.util = require("./lib/util");`),
+		"app/lib/util.js": file(`module.exports = function (a, b) { return a + b; };`),
+		"app/data.json":   file(`{"b": 2, "a": [1, true, null]}`),
+		"app/bad.json":    file(`{"a": }`),
+		"app/throws.js":   file("#!/usr/bin/env node\nglobalThis.throwCount = (globalThis.throwCount || 0) + 1; throw new Error(\"boom\");"),
+
+		"app/cycle/a.js": file(`exports.done = false; var b = require("./b"); exports.seen = b.seen; exports.done = true;`),
+		"app/cycle/b.js": file(`var a = require("./a"); exports.seen = a.done; exports.loaded = module.loaded;`),
+
+		"app/node_modules/plain/package.json": file(`{"main": "lib/entry"}`),
+		"app/node_modules/plain/lib/entry.js": file(`module.exports = "plain";`),
+
+		"app/node_modules/@scope/pkg/index.js": file(`module.exports = "scoped";`),
+
+		"node_modules/exported/package.json": file(`{
+			"exports": {
+				".": { "import": "./esm.js", "require": "./cjs.js" },
+				"./features/*": "./src/features/*.js",
+				"./features/private/*": null
+			}
+		}`),
+		"node_modules/exported/cjs.js":                    file(`module.exports = "cjs";`),
+		"node_modules/exported/esm.js":                    file(`export default "esm";`),
+		"node_modules/exported/src/features/a.js":         file(`module.exports = "feature a";`),
+		"node_modules/exported/src/features/private/b.js": file(`module.exports = "private";`),
+	}
+}
+
+func TestRequire(t *testing.T) {
+	vm := New()
+	vm.EnableRequire(newRequireTestFS())
+
+	_, err := vm.RunString(`
+		var main = require("./app/main");
+		var results = [main.name, main.file, main.dir, main.util(1, 2), require("./app/main.js") === main];
+
+		var data = require("/app/data.json");
+		results.push(Object.keys(data).join(","), data.a.length, data.a[2] === null);
+
+		var a = require("./app/cycle/a");
+		var b = require("./app/cycle/b");
+		results.push(a.done, a.seen, b.seen, b.loaded);
+		results.push(require.cache["/app/cycle/a.js"].loaded, require.resolve("./app/cycle/b"));
+	`)
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		"main|/app/main.js|/app|3|true|b,a|3|true|true|false|false|false|true|/app/cycle/b.js",
+		run(t, vm, `results.join("|")`).Export(),
+	)
+}
+
+func TestRequirePackages(t *testing.T) {
+	vm := New()
+	vm.EnableRequire(newRequireTestFS())
+
+	_, err := vm.RunString(`
+		var results = [];
+		results.push(require.cache["/app/main.js"] === undefined);
+
+		results.push(require("exported"), require("exported/features/a"));
+
+		function code(specifier) {
+			try {
+				require(specifier);
+				return "ok";
+			} catch (error) {
+				return error.code || error.name;
+			}
+		}
+		results.push(code("exported/cjs.js"), code("exported/features/private/b"), code("missing"), code("./nope"));
+		results.push(code("./app/bad.json"));
+	`)
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		"true|cjs|feature a|ERR_PACKAGE_PATH_NOT_EXPORTED|ERR_PACKAGE_PATH_NOT_EXPORTED|MODULE_NOT_FOUND|MODULE_NOT_FOUND|SyntaxError",
+		run(t, vm, `results.join("|")`).Export(),
+	)
+
+	value, err := vm.Require("./app/main")
+	require.NoError(t, err)
+	assert.Equal(t, "main", value.Export().(map[string]any)["name"])
+	assert.Equal(t, "plain,scoped", run(t, vm, `
+		var mainModule = require.cache["/app/main.js"];
+		mainModule.require("plain") + "," + mainModule.require("@scope/pkg");
+	`).Export())
+
+	// A module that threw is run again the next time it is required.
+	_, err = vm.Require("./app/throws")
+	require.Error(t, err)
+	_, err = vm.Require("./app/throws")
+	require.Error(t, err)
+	assert.Equal(t, int64(2), run(t, vm, `throwCount`).Export())
+}
+
+func TestRequireNotEnabled(t *testing.T) {
+	vm := New()
+
+	_, err := vm.Require("./a")
+	assert.Error(t, err)
+	assert.Equal(t, "undefined", run(t, vm, `typeof require`).Export())
+}
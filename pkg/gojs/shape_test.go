@@ -0,0 +1,255 @@
+package gojs
+
+import (
+	goruntime "runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+// readX and writeX each have a single property access site, so calling them repeatedly exercises the inline cache
+// for that site against whatever shapes have been seen before.
+const cacheSiteSource = `
+	function readX(object) { return object.x; }
+	function writeX(object, value) { object.x = value; return object.x; }
+	function readMany(object, times) {
+		const values = [];
+		for (let i = 0; i < times; i++) values.push(readX(object));
+		return values.join();
+	}
+`
+
+func TestInlineCachePrototypeSwap(t *testing.T) {
+	vm := New()
+	run(t, vm, cacheSiteSource)
+
+	assert.Equal(t, "1,1,1|2,2,2|3,3,3|4", run(t, vm, `
+		var first = { x: 1 };
+		var second = { x: 2 };
+		var object = Object.create(first);
+		var results = [readMany(object, 3)];
+		Object.setPrototypeOf(object, second);
+		results.push(readMany(object, 3));
+
+		// Changing the inherited value in place is seen at the same site.
+		second.x = 3;
+		results.push(readMany(object, 3));
+
+		// So is shadowing it with an own property.
+		object.x = 4;
+		results.push(readX(object));
+		results.join("|");
+	`).Export())
+
+	// A property added to a prototype further up the chain is found once the nearer prototype loses its own.
+	assert.Equal(t, "1|undefined|5", run(t, vm, `
+		var grandparent = {};
+		var parent = Object.create(grandparent);
+		parent.x = 1;
+		var child = Object.create(parent);
+		var results = [readX(child)];
+		delete parent.x;
+		results.push(readX(child));
+		grandparent.x = 5;
+		results.push(readX(child));
+		results.join("|");
+	`).Export())
+}
+
+func TestInlineCacheAccessorRedefinition(t *testing.T) {
+	vm := New()
+	run(t, vm, cacheSiteSource)
+
+	assert.Equal(t, "1,1|10,10|7,7|7|8|8", run(t, vm, `
+		var object = { x: 1 };
+		var results = [readMany(object, 2)];
+
+		Object.defineProperty(object, "x", { get() { return 10; }, configurable: true });
+		results.push(readMany(object, 2));
+
+		Object.defineProperty(object, "x", { value: 7, writable: true, configurable: true });
+		results.push(readMany(object, 2));
+
+		// A setter on the prototype intercepts writes at a site that cached a plain data write.
+		var stored;
+		var proto = {};
+		Object.defineProperty(proto, "x", { get() { return stored; }, set(value) { stored = value; }, configurable: true });
+		var plain = {};
+		results.push(writeX(plain, 7));
+		var derived = Object.create(proto);
+		results.push(writeX(derived, 8));
+		results.push(stored + (Object.getOwnPropertyNames(derived).length === 0 ? "" : " own"));
+		results.join("|");
+	`).Export())
+
+	// Once a property becomes non-writable, a cached write site leaves it alone.
+	assert.Equal(t, "1,2,2", run(t, vm, `
+		var object = { x: 0 };
+		var results = [writeX(object, 1), writeX(object, 2)];
+		Object.defineProperty(object, "x", { value: 2, writable: false, enumerable: true, configurable: true });
+		results.push(writeX(object, 3));
+		results.join();
+	`).Export())
+}
+
+func TestInlineCacheDelete(t *testing.T) {
+	vm := New()
+	run(t, vm, cacheSiteSource)
+
+	assert.Equal(t, "1,1|undefined|2|undefined", run(t, vm, `
+		var object = { a: 0, x: 1 };
+		var results = [readMany(object, 2)];
+		delete object.x;
+		results.push(readX(object));
+		object.x = 2;
+		results.push(readX(object));
+
+		// Objects that still have the old shape don't pick up deletes from other objects.
+		var other = { a: 0, x: 3 };
+		delete other.x;
+		results.push(readX(other));
+		results.join("|");
+	`).Export())
+
+	assert.Equal(t, int64(3), run(t, vm, `readX({ a: 0, x: 3 })`).Export())
+}
+
+// Objects with many properties leave the shape tree for dictionary mode, and must keep working at sites that cached
+// their earlier shapes.
+func TestInlineCacheDictionaryMode(t *testing.T) {
+	vm := New()
+	run(t, vm, cacheSiteSource)
+
+	assert.Equal(t, "1|1|2|199|undefined|100", run(t, vm, `
+		var object = { x: 1 };
+		var results = [readX(object)];
+		for (let i = 0; i < 200; i++) object["p" + i] = i;
+		results.push(readX(object));
+		results.push(writeX(object, 2));
+		results.push(object.p199);
+		delete object.x;
+		results.push(readX(object));
+		results.push(writeX(object, 100));
+		results.join("|");
+	`).Export())
+
+	assert.Equal(t, int64(201), run(t, vm, `Object.keys(object).length`).Export())
+}
+
+// A site that sees more shapes than it can cache keeps returning the right value for each of them.
+func TestInlineCachePolymorphicSite(t *testing.T) {
+	vm := New()
+	run(t, vm, cacheSiteSource)
+
+	assert.Equal(t, "0,1,2,3,4,5,6,7,0,1,2,3,4,5,6,7", run(t, vm, `
+		var objects = [
+			{ x: 0 },
+			{ a: 0, x: 1 },
+			{ b: 0, x: 2 },
+			{ c: 0, x: 3 },
+			{ d: 0, x: 4 },
+			Object.create({ x: 5 }),
+			{ e: 0, f: 0, x: 6 },
+			Object.create(Object.create({ x: 7 })),
+		];
+		var results = [];
+		for (let round = 0; round < 2; round++) {
+			for (let i = 0; i < objects.length; i++) results.push(readX(objects[i]));
+		}
+		results.join();
+	`).Export())
+
+	assert.Equal(t, "10,11,12,13,14,15,16,17", run(t, vm, `
+		var results = [];
+		for (let i = 0; i < objects.length; i++) results.push(writeX(objects[i], 10 + i));
+		results.join();
+	`).Export())
+}
+
+// Parsed scripts may be run by several runtimes. Their inline caches must neither match objects of another runtime nor
+// keep them alive once that runtime is gone.
+func TestInlineCacheSharedScript(t *testing.T) {
+	const source = `
+		var proto = { x: 1 };
+		var object = Object.create(proto);
+		function readX(object) { return object.x; }
+		readX(object) + readX(object);
+	`
+
+	first := New()
+	script, err := runtime.ParseScript(source, first.Realm())
+	require.NoError(t, err)
+	require.Equal(t, runtime.Normal, script.Evaluate(first.Runtime()).Type)
+
+	collected := make(chan struct{})
+	proto := first.Get("proto").JavaScriptValue().Value.(*runtime.Object)
+	goruntime.AddCleanup(proto, func(struct{}) { close(collected) }, struct{}{})
+	code := script.ScriptCode
+	proto, first, script = nil, nil, nil
+
+	second := New()
+	completion := (&runtime.Script{Realm: second.Realm(), ScriptCode: code}).Evaluate(second.Runtime())
+	require.Equal(t, runtime.Normal, completion.Type)
+	assert.Equal(t, int64(3), run(t, second, `proto.x = 2; readX(object) + readX({ x: 1 })`).Export())
+
+	// The parsed script, and the caches on its sites, must outlive the first runtime for this to test anything.
+	defer goruntime.KeepAlive(code)
+
+	deadline := time.After(5 * time.Second)
+	for {
+		goruntime.GC()
+		select {
+		case <-collected:
+			return
+		case <-deadline:
+			t.Fatal("the prototype of the first runtime was not collected")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// BenchmarkPropertyAccess reads properties through a prototype chain at a site the inline cache handles, and at one
+// that has seen more layouts than a site caches, four, and so does the full lookup every time. The difference between
+// them is what the cache saves, which is small next to the cost of evaluating the rest of the loop.
+func BenchmarkPropertyAccess(b *testing.B) {
+	for _, benchmark := range []struct {
+		name   string
+		shapes int
+	}{{"cached", 1}, {"uncached", 5}} {
+		b.Run(benchmark.name, func(b *testing.B) {
+			vm := New()
+			require.NoError(b, vm.Set("shapes", benchmark.shapes))
+			_, err := vm.RunString(`
+				var chain = { x: 1 };
+				for (let i = 0; i < 30; i++) chain["p" + i] = i;
+				for (let i = 0; i < 8; i++) { chain = Object.create(chain); chain["q" + i] = i; }
+
+				var objects = [];
+				for (let i = 0; i < shapes; i++) {
+					const object = Object.create(chain);
+					object["own" + i] = i;
+					objects.push(object);
+				}
+
+				function read(object) { return object.x + object.p29 + object.q0; }
+				function loop() {
+					let sum = 0;
+					for (let i = 0; i < 100; i++) sum += read(objects[i % objects.length]);
+					return sum;
+				}
+				loop();
+			`)
+			require.NoError(b, err)
+
+			b.ResetTimer()
+			for range b.N {
+				if _, err := vm.RunString(`loop()`); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
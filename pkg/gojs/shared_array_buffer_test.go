@@ -0,0 +1,215 @@
+package gojs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+// runWithin fails the test if fn doesn't return within timeout, instead of hanging the test binary.
+func runWithin(t *testing.T, timeout time.Duration, fn func()) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		t.Fatalf("did not return within %v", timeout)
+	}
+}
+
+func TestAtomicsReadModifyWrite(t *testing.T) {
+	vm := New()
+	run(t, vm, `
+		function readModifyWrite(Type, n) {
+			const view = new Type(new SharedArrayBuffer(Type.BYTES_PER_ELEMENT * 4));
+			view[1] = n(5);
+			return [
+				Atomics.add(view, 1, n(3)),
+				Atomics.sub(view, 1, n(1)),
+				Atomics.and(view, 1, n(6)),
+				Atomics.or(view, 1, n(9)),
+				Atomics.xor(view, 1, n(3)),
+				Atomics.exchange(view, 1, n(-1)),
+				Atomics.compareExchange(view, 1, n(-1), n(7)),
+				Atomics.compareExchange(view, 1, n(0), n(9)),
+				Atomics.load(view, 1),
+				view[0],
+				view[2],
+			].join();
+		}
+	`)
+
+	tests := map[string]string{
+		"Int8Array":      "5,8,7,6,15,12,-1,7,7,0,0",
+		"Uint8Array":     "5,8,7,6,15,12,255,7,7,0,0",
+		"Int16Array":     "5,8,7,6,15,12,-1,7,7,0,0",
+		"Uint16Array":    "5,8,7,6,15,12,65535,7,7,0,0",
+		"Int32Array":     "5,8,7,6,15,12,-1,7,7,0,0",
+		"Uint32Array":    "5,8,7,6,15,12,4294967295,7,7,0,0",
+		"BigInt64Array":  "5,8,7,6,15,12,-1,7,7,0,0",
+		"BigUint64Array": "5,8,7,6,15,12,18446744073709551615,7,7,0,0",
+	}
+
+	for name, expected := range tests {
+		convert := "Number"
+		if name == "BigInt64Array" || name == "BigUint64Array" {
+			convert = "BigInt"
+		}
+		assert.Equal(t, expected, run(t, vm, `readModifyWrite(`+name+`, `+convert+`)`).Export(), name)
+	}
+
+	assert.Equal(t, "300,44", run(t, vm, `
+		var bytes = new Int8Array(new SharedArrayBuffer(4));
+		[Atomics.store(bytes, 0, 300), bytes[0]].join();
+	`).Export())
+
+	for _, source := range []string{
+		`Atomics.add(new Float64Array(new SharedArrayBuffer(8)), 0, 1)`,
+		`Atomics.wait(new Int32Array(4), 0, 0, 0)`,
+		`Atomics.wait(new Int16Array(new SharedArrayBuffer(4)), 0, 0, 0)`,
+	} {
+		_, err := vm.RunString(source)
+		assert.ErrorContains(t, err, "TypeError", source)
+	}
+	_, err := vm.RunString(`Atomics.load(new Int32Array(new SharedArrayBuffer(8)), 2)`)
+	assert.ErrorContains(t, err, "RangeError")
+}
+
+func TestGrowableSharedArrayBuffer(t *testing.T) {
+	vm := New()
+	run(t, vm, `
+		var buffer = new SharedArrayBuffer(4, { maxByteLength: 16 });
+		var tracking = new Int32Array(buffer);
+		var fixed = new Int32Array(buffer, 0, 1);
+	`)
+
+	assert.Equal(t, "true,16,4,1", run(t, vm, `[buffer.growable, buffer.maxByteLength, buffer.byteLength, tracking.length].join()`).Export())
+	assert.Equal(t, "12,3,1", run(t, vm, `buffer.grow(12); [buffer.byteLength, tracking.length, fixed.length].join()`).Export())
+	assert.Equal(t, "5", run(t, vm, `Atomics.store(tracking, 2, 5); String(Atomics.load(new Int32Array(buffer), 2))`).Export())
+	assert.Equal(t, "false,4", run(t, vm, `var fixedLength = new SharedArrayBuffer(4); [fixedLength.growable, fixedLength.maxByteLength].join()`).Export())
+	assert.Equal(t, "true,2,8", run(t, vm, `
+		var shared = new SharedArrayBuffer(8);
+		new Uint8Array(shared)[7] = 8;
+		var copy = shared.slice(6);
+		[copy instanceof SharedArrayBuffer, copy.byteLength, new Uint8Array(copy)[1]].join();
+	`).Export())
+
+	for _, source := range []string{`buffer.grow(8)`, `buffer.grow(32)`} {
+		_, err := vm.RunString(source)
+		assert.ErrorContains(t, err, "RangeError", source)
+	}
+	_, err := vm.RunString(`fixedLength.grow(8)`)
+	assert.ErrorContains(t, err, "TypeError")
+}
+
+func TestAtomicsWait(t *testing.T) {
+	vm := New()
+	run(t, vm, `
+		var view = new Int32Array(new SharedArrayBuffer(8));
+		var results = [];
+	`)
+
+	assert.Equal(t, "not-equal,timed-out,0", run(t, vm, `[Atomics.wait(view, 0, 1), Atomics.wait(view, 0, 0, 10), Atomics.notify(view, 0)].join()`).Export())
+
+	runWithin(t, 5*time.Second, func() {
+		run(t, vm, `
+			var timedOut = Atomics.waitAsync(view, 0, 0, 20);
+			var notified = Atomics.waitAsync(view, 1, 0);
+			var notEqual = Atomics.waitAsync(view, 0, 1);
+			results.push(timedOut.async, notEqual.async, notEqual.value, Atomics.notify(view, 1));
+			timedOut.value.then(value => results.push(value));
+			notified.value.then(value => results.push(value));
+		`)
+	})
+	assert.Equal(t, "true,false,not-equal,1,ok,timed-out", run(t, vm, `results.join()`).Export())
+}
+
+func TestAgentClusterWaitNotify(t *testing.T) {
+	block := runtime.NewSharedDataBlock(8, 8, false)
+	cluster := runtime.NewAgentCluster()
+	results := make([]string, 2)
+
+	for idx, source := range []string{
+		// The waiter blocks until it is notified, then sees what was stored before the notification.
+		`Atomics.wait(view, 0, 0) + ":" + Atomics.load(view, 1)`,
+		// The notifier retries until the waiter is waiting.
+		`Atomics.store(view, 1, 42); while (Atomics.notify(view, 0, 1) === 0) {} "notified"`,
+	} {
+		cluster.Start(func(rt *runtime.Runtime, realm *runtime.Realm) error {
+			rt.PushExecutionContext(&runtime.ExecutionContext{Realm: realm})
+			defer rt.PopExecutionContext()
+
+			view := runtime.NewSharedArrayBufferFromDataBlock(rt, block)
+			global := runtime.NewJavaScriptValue(runtime.TypeObject, realm.GlobalObject)
+			realm.GlobalObject.Set(rt, runtime.NewStringValue("buffer"), view, global)
+
+			script, err := runtime.ParseScript(`var view = new Int32Array(buffer); `+source, realm)
+			if err != nil {
+				return err
+			}
+
+			completion := script.Evaluate(rt)
+			if completion.Type != runtime.Normal {
+				return errors.New(runtime.ErrorToString(rt, completion.Value.(*runtime.JavaScriptValue)))
+			}
+			results[idx] = completion.Value.(*runtime.JavaScriptValue).Value.(*runtime.String).Value
+			return nil
+		})
+	}
+
+	runWithin(t, 5*time.Second, func() {
+		require.NoError(t, cluster.Wait())
+	})
+	assert.Equal(t, []string{"ok:42", "notified"}, results)
+}
+
+func TestWaitAsyncTerminated(t *testing.T) {
+	vm := New()
+
+	runWithin(t, 5*time.Second, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		// The waiter is never notified, so the script's jobs never finish.
+		_, err := vm.RunStringContext(ctx, `
+			var late = "";
+			Atomics.waitAsync(new Int32Array(new SharedArrayBuffer(8)), 0, 0).value.then(() => { late = "ran"; });
+		`)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+		// Terminating the script dropped its waiter, so later scripts don't wait for it.
+		start := time.Now()
+		assert.Equal(t, int64(42), run(t, vm, `42`).Export())
+		assert.Less(t, time.Since(start), time.Second)
+		require.NoError(t, vm.RunEventLoop())
+		assert.Equal(t, "", run(t, vm, `late`).Export())
+	})
+}
+
+func TestAtomicsWaitTerminated(t *testing.T) {
+	vm := New()
+	run(t, vm, `var view = new Int32Array(new SharedArrayBuffer(8));`)
+
+	runWithin(t, 5*time.Second, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		// Nothing notifies the waiter and it has no timeout, so only the deadline ends the wait.
+		_, err := vm.RunStringContext(ctx, `Atomics.wait(view, 0, 0)`)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	// The waiter was removed when the wait was interrupted, so notify finds nothing to wake.
+	assert.Equal(t, "0,timed-out", run(t, vm, `[Atomics.notify(view, 0), Atomics.wait(view, 0, 0, 10)].join()`).Export())
+}
@@ -0,0 +1,85 @@
+package gojs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+func TestSymbolRegistry(t *testing.T) {
+	vm := New()
+	other := vm.NewRealm(runtime.RealmOptions{})
+
+	run(t, vm, `var app = Symbol.for("app"); var local = Symbol("app");`)
+	require.NoError(t, other.Set("app", vm.Get("app")))
+	require.NoError(t, other.Set("local", vm.Get("local")))
+
+	// The registry is shared by every realm of the runtime.
+	assert.Equal(t, true, run(t, other, `Symbol.for("app") === app`).Export())
+	assert.Equal(t, "app", run(t, other, `Symbol.keyFor(app)`).Export())
+	assert.Equal(t, false, run(t, other, `Symbol.for("app") === local`).Export())
+	assert.Nil(t, run(t, other, `Symbol.keyFor(local)`).Export())
+	assert.Nil(t, run(t, vm, `Symbol.keyFor(Symbol.iterator)`).Export())
+
+	// So are the well-known symbols, though each realm has its own Symbol constructor.
+	require.NoError(t, vm.Set("OtherSymbol", other.Get("Symbol")))
+	assert.Equal(t, "false,true", run(t, vm, `[OtherSymbol === Symbol, OtherSymbol.iterator === Symbol.iterator].join()`).Export())
+
+	_, err := vm.RunString(`Symbol.keyFor("app")`)
+	assert.ErrorContains(t, err, "TypeError")
+	_, err = vm.RunString(`new Symbol()`)
+	assert.ErrorContains(t, err, "TypeError")
+}
+
+func TestSymbolDescription(t *testing.T) {
+	vm := New()
+
+	tests := map[string]any{
+		`Symbol("d").description`:                     "d",
+		`Symbol("").description`:                      "",
+		`Symbol().description === undefined`:          true,
+		`Symbol.for("app").description`:               "app",
+		`Symbol.iterator.description`:                 "Symbol.iterator",
+		`Symbol("d").toString()`:                      "Symbol(d)",
+		`String(Symbol())`:                            "Symbol()",
+		`Object(Symbol("boxed")).description`:         "boxed",
+		`typeof Object(Symbol())`:                     "object",
+		`Symbol.prototype[Symbol.toStringTag]`:        "Symbol",
+		`var s = Symbol(); Object(s).valueOf() === s`: true,
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+}
+
+func TestSymbolToPrimitive(t *testing.T) {
+	vm := New()
+	run(t, vm, `
+		var hinted = { [Symbol.toPrimitive](hint) { return hint === "number" ? 42 : hint; } };
+		var ignored = { [Symbol.toPrimitive]: undefined, valueOf() { return 7; } };
+	`)
+
+	// ToPrimitive used to panic on objects with a Symbol.toPrimitive method.
+	tests := map[string]any{
+		`+hinted`:     int64(42),
+		`hinted * 2`:  int64(84),
+		"`${hinted}`": "string",
+		`hinted + ""`: "default",
+		`+ignored`:    int64(7),
+	}
+
+	for source, expected := range tests {
+		assert.Equal(t, expected, run(t, vm, source).Export(), source)
+	}
+
+	for _, source := range []string{
+		`+{ [Symbol.toPrimitive]() { return {}; } }`,
+		`+{ [Symbol.toPrimitive]: 1 }`,
+	} {
+		_, err := vm.RunString(source)
+		assert.ErrorContains(t, err, "TypeError", source)
+	}
+}
@@ -0,0 +1,108 @@
+package gojs
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+// FunctionCall holds the this value and arguments of a call from JavaScript into a Go Function.
+type FunctionCall struct {
+	This      Value
+	Arguments []Value
+}
+
+// Argument returns the argument at index, or undefined if fewer arguments were passed.
+func (c FunctionCall) Argument(index int) Value {
+	if index < 0 || index >= len(c.Arguments) {
+		return c.This.vm.undefined()
+	}
+	return c.Arguments[index]
+}
+
+// Function is a Go function that can be called from JavaScript. A returned error is thrown:
+// an *Exception throws the value it wraps, any other error throws an Error with its message.
+type Function func(call FunctionCall) (Value, error)
+
+// ToValue converts a Go value to a JavaScript value:
+//
+//   - nil becomes null, and Value and *runtime.JavaScriptValue are used as they are.
+//   - Booleans, strings, integers, floats and *big.Int become primitives.
+//   - Function and func(FunctionCall) (Value, error) become functions that receive the JavaScript arguments.
+//   - Other funcs become functions that convert their arguments and results, see reflectFunction.
+//   - Structs and pointers to structs become objects with their exported fields and methods as properties.
+//   - Maps with string or integer keys become objects whose properties are the map's entries.
+//   - Slices and arrays become array-like objects that share their elements with the Go value.
+//   - Errors become Error objects with the error's message.
+//
+// Structs, maps and slices are not copied, so changes made by scripts are visible to Go. A slice can only grow
+// in place when it is passed as a pointer. ToValue panics for types that can't be converted, such as channels.
+func (vm *VM) ToValue(value any) Value {
+	jsValue, err := vm.toValue(value)
+	if err != nil {
+		panic(err)
+	}
+	return jsValue
+}
+
+func (vm *VM) toValue(value any) (Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return vm.wrap(runtime.NewNullValue()), nil
+	case bool:
+		return vm.wrap(runtime.NewBooleanValue(v)), nil
+	case string:
+		return vm.wrap(runtime.NewStringValue(v)), nil
+	case int:
+		return vm.number(float64(v)), nil
+	case int64:
+		return vm.number(float64(v)), nil
+	case float64:
+		return vm.number(v), nil
+	}
+
+	reflected := reflect.ValueOf(value)
+	if !isConvertible(reflected.Type()) {
+		return vm.undefined(), fmt.Errorf("gojs: cannot convert %T to a JavaScript value", value)
+	}
+	return vm.wrap(vm.reflectToValue(reflected)), nil
+}
+
+func (vm *VM) number(value float64) Value {
+	return vm.wrap(newNumber(value))
+}
+
+// newNumber returns value as a Number, flagging NaN as the runtime expects.
+func newNumber(value float64) *runtime.JavaScriptValue {
+	return runtime.NewNumberValue(value, math.IsNaN(value))
+}
+
+func (vm *VM) function(function Function) *runtime.JavaScriptValue {
+	behaviour := func(
+		rt *runtime.Runtime,
+		functionObject *runtime.FunctionObject,
+		thisArg *runtime.JavaScriptValue,
+		arguments []*runtime.JavaScriptValue,
+		newTarget *runtime.JavaScriptValue,
+	) *runtime.Completion {
+		call := FunctionCall{
+			This:      vm.wrap(thisArg),
+			Arguments: make([]Value, len(arguments)),
+		}
+		for idx, argument := range arguments {
+			call.Arguments[idx] = vm.wrap(argument)
+		}
+
+		result, err := function(call)
+		if err != nil {
+			return vm.throwError(err)
+		}
+
+		return runtime.NewNormalCompletion(result.JavaScriptValue())
+	}
+
+	functionObject := runtime.CreateBuiltinFunction(vm.runtime, behaviour, 0, runtime.NewStringValue(""), vm.realm, nil)
+	return runtime.NewJavaScriptValue(runtime.TypeObject, functionObject)
+}
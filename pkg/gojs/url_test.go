@@ -0,0 +1,206 @@
+package gojs
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The URL tests come from the web-platform-tests project (url/resources), at commit
+// befe66343e5f21dc464c8c772c6d20695936714f.
+
+var urlComponents = []string{"href", "protocol", "username", "password", "host", "hostname", "port", "pathname", "search", "hash"}
+
+const urlTestFunctions = `
+	function describeURL(url) {
+		return {
+			href: url.href,
+			origin: url.origin,
+			protocol: url.protocol,
+			username: url.username,
+			password: url.password,
+			host: url.host,
+			hostname: url.hostname,
+			port: url.port,
+			pathname: url.pathname,
+			search: url.search,
+			hash: url.hash,
+			searchParams: url.searchParams.toString(),
+		};
+	}
+
+	function parseURL(input, base) {
+		var canParse = URL.canParse(input, base);
+		try {
+			var url = new URL(input, base);
+		} catch (error) {
+			return canParse || URL.parse(input, base) !== null ? "inconsistent" : error.name;
+		}
+		return canParse ? describeURL(url) : "inconsistent";
+	}
+
+	function setURL(href, setter, value) {
+		var url = new URL(href);
+		url[setter] = value;
+		return describeURL(url);
+	}
+`
+
+func loadURLTestData(t *testing.T, name string, data any) {
+	source, err := os.ReadFile("testdata/wpt/url/" + name)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(source, data))
+}
+
+func newURLTestVM(t *testing.T) *VM {
+	vm := New()
+	_, err := vm.RunString(urlTestFunctions)
+	require.NoError(t, err)
+	return vm
+}
+
+func TestURLParsing(t *testing.T) {
+	var entries []json.RawMessage
+	loadURLTestData(t, "urltestdata.json", &entries)
+
+	vm := newURLTestVM(t)
+	parseURL := vm.Get("parseURL").Export().(func(args ...any) (any, error))
+
+	for _, entry := range entries {
+		var test struct {
+			Input        string         `json:"input"`
+			Base         *string        `json:"base"`
+			Failure      bool           `json:"failure"`
+			Origin       *string        `json:"origin"`
+			SearchParams *string        `json:"searchParams"`
+			Expected     map[string]any `json:"-"`
+		}
+		if json.Unmarshal(entry, &test) != nil {
+			// The comments between the tests are strings.
+			continue
+		}
+		require.NoError(t, json.Unmarshal(entry, &test.Expected))
+
+		name := test.Input
+		if test.Base != nil {
+			name += " against " + *test.Base
+		}
+
+		args := []any{test.Input}
+		if test.Base != nil {
+			args = append(args, *test.Base)
+		}
+
+		result, err := parseURL(args...)
+		if !assert.NoError(t, err, name) {
+			continue
+		}
+
+		if test.Failure {
+			assert.Equal(t, "TypeError", result, name)
+			continue
+		}
+
+		actual, ok := result.(map[string]any)
+		if !assert.True(t, ok, "%s: got %v", name, result) {
+			continue
+		}
+
+		for _, component := range urlComponents {
+			assert.Equal(t, test.Expected[component], actual[component], "%s: %s", name, component)
+		}
+		if test.Origin != nil {
+			assert.Equal(t, *test.Origin, actual["origin"], "%s: origin", name)
+		}
+		if test.SearchParams != nil {
+			assert.Equal(t, *test.SearchParams, actual["searchParams"], "%s: searchParams", name)
+		}
+	}
+}
+
+func TestURLSetters(t *testing.T) {
+	var setters map[string]json.RawMessage
+	loadURLTestData(t, "setters_tests.json", &setters)
+
+	vm := newURLTestVM(t)
+	setURL := vm.Get("setURL").Export().(func(args ...any) (any, error))
+
+	for setter, data := range setters {
+		if setter == "comment" {
+			continue
+		}
+
+		var tests []struct {
+			Href     string            `json:"href"`
+			NewValue string            `json:"new_value"`
+			Expected map[string]string `json:"expected"`
+		}
+		require.NoError(t, json.Unmarshal(data, &tests))
+
+		for _, test := range tests {
+			name := "<" + test.Href + "> set " + setter + " to <" + test.NewValue + ">"
+
+			result, err := setURL(test.Href, setter, test.NewValue)
+			if !assert.NoError(t, err, name) {
+				continue
+			}
+
+			actual := result.(map[string]any)
+			for component, expected := range test.Expected {
+				assert.Equal(t, expected, actual[component], "%s: %s", name, component)
+			}
+		}
+	}
+}
+
+func TestURLSearchParams(t *testing.T) {
+	vm := New()
+
+	value, err := vm.RunString(`
+		var url = new URL("https://example.com/path?b=2&a=1&b=3#top");
+		var params = url.searchParams;
+		var results = [params.get("b"), params.getAll("b").length, params.has("a", "2"), params.size];
+
+		params.append("c", "x y&z");
+		params.set("b", "4");
+		params.delete("a");
+		results.push(url.search);
+
+		params.sort();
+		var pairs = [];
+		params.forEach(function (value, name) {
+			pairs.push(name + "=" + value);
+		});
+		results.push(pairs.join("&"));
+
+		url.search = "?q=%F0%9F%98%80";
+		results.push(new TextEncoder().encode(params.get("q")).length, params.toString());
+
+		var fromRecord = new URLSearchParams({ one: "1", two: "2" });
+		var fromPairs = new URLSearchParams([["x", "1"], ["x", "2"]]);
+		var keys = [];
+		for (var key of fromPairs.keys()) {
+			keys.push(key);
+		}
+		results.push(fromRecord.toString(), keys.join(), new URLSearchParams("?a=b+c").get("a"));
+
+		results.push(Object.prototype.toString.call(fromPairs.entries()), URL.canParse("//x", "https://a/"), URL.parse("http://[::1") === null);
+
+		try {
+			new URLSearchParams([["only one"]]);
+		} catch (error) {
+			results.push(error.name);
+		}
+
+		results.join("|");
+	`)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"2|2|false|3|?b=4&c=x+y%26z|b=4&c=x y&z|4|q=%F0%9F%98%80|one=1&two=2|x,x|b c|[object URLSearchParams Iterator]|true|true|TypeError",
+		value.String(),
+	)
+}
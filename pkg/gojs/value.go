@@ -0,0 +1,190 @@
+package gojs
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+
+	"zbrannelly.dev/go-js/pkg/lib-js/runtime"
+)
+
+// Value is a JavaScript value that belongs to a VM. The zero Value is undefined.
+type Value struct {
+	vm    *VM
+	value *runtime.JavaScriptValue
+}
+
+func (vm *VM) wrap(value *runtime.JavaScriptValue) Value {
+	return Value{vm: vm, value: value}
+}
+
+func (vm *VM) undefined() Value {
+	return vm.wrap(runtime.NewUndefinedValue())
+}
+
+// JavaScriptValue returns the underlying runtime value.
+func (v Value) JavaScriptValue() *runtime.JavaScriptValue {
+	if v.value == nil {
+		return runtime.NewUndefinedValue()
+	}
+	return v.value
+}
+
+func (v Value) IsUndefined() bool {
+	return v.value == nil || v.value.Type == runtime.TypeUndefined
+}
+
+func (v Value) IsNull() bool {
+	return v.value != nil && v.value.Type == runtime.TypeNull
+}
+
+// String converts the value with the ToString abstract operation. If the conversion throws,
+// for example for a Symbol, the thrown error is described instead.
+func (v Value) String() string {
+	if v.value == nil {
+		return "undefined"
+	}
+
+	if v.value.Type == runtime.TypeSymbol {
+		return runtime.SymbolDescriptiveString(v.value.Value.(*runtime.Symbol)).Value.(*runtime.String).Value
+	}
+
+	completion := runtime.ToString(v.vm.runtime, v.value)
+	if completion.Type == runtime.Throw {
+		return runtime.ErrorToString(v.vm.runtime, completion.Value.(*runtime.JavaScriptValue))
+	}
+	return completion.Value.(*runtime.JavaScriptValue).Value.(*runtime.String).Value
+}
+
+// Export converts the value to a Go value:
+//
+//   - Objects that wrap Go values become the Go value they wrap.
+//   - undefined and null become nil.
+//   - Booleans and strings become bool and string.
+//   - Numbers become int64 when they are integers that fit, and float64 otherwise.
+//   - BigInts become *big.Int and Symbols stay *runtime.Symbol.
+//   - Arrays become []any, with each element exported.
+//   - Functions become func(args ...any) (any, error), converting the arguments with ToValue.
+//   - Other objects become map[string]any of their own enumerable string-keyed properties.
+func (v Value) Export() any {
+	if v.value == nil {
+		return nil
+	}
+	return v.vm.export(v.value, make(map[runtime.ObjectInterface]any))
+}
+
+func (vm *VM) export(value *runtime.JavaScriptValue, seen map[runtime.ObjectInterface]any) any {
+	switch value.Type {
+	case runtime.TypeUndefined, runtime.TypeNull:
+		return nil
+	case runtime.TypeBoolean:
+		return value.Value.(*runtime.Boolean).Value
+	case runtime.TypeString:
+		return value.Value.(*runtime.String).Value
+	case runtime.TypeNumber:
+		number := value.Value.(*runtime.Number)
+		if number.NaN {
+			return math.NaN()
+		}
+		if number.Value == math.Trunc(number.Value) && math.Abs(number.Value) < 1<<63 && !(number.Value == 0 && math.Signbit(number.Value)) {
+			return int64(number.Value)
+		}
+		return number.Value
+	case runtime.TypeBigInt:
+		return new(big.Int).Set(value.Value.(*runtime.BigInt).Value)
+	case runtime.TypeSymbol:
+		return value.Value.(*runtime.Symbol)
+	case runtime.TypeObject:
+		return vm.exportObject(value, seen)
+	}
+	return nil
+}
+
+func (vm *VM) exportObject(value *runtime.JavaScriptValue, seen map[runtime.ObjectInterface]any) any {
+	object := value.Value.(runtime.ObjectInterface)
+	if exported, ok := seen[object]; ok {
+		return exported
+	}
+
+	if wrapper, ok := object.(goObject); ok {
+		return wrapper.goValue().Interface()
+	}
+
+	if runtime.IsCallable(value) {
+		return func(args ...any) (any, error) {
+			result, err := vm.call(value, runtime.NewUndefinedValue(), args)
+			if err != nil {
+				return nil, err
+			}
+			return result.Export(), nil
+		}
+	}
+
+	if array, ok := object.(*runtime.ArrayObject); ok {
+		lengthCompletion := runtime.LengthOfArrayLike(vm.runtime, array)
+		if lengthCompletion.Type != runtime.Normal {
+			return nil
+		}
+
+		length := int(lengthCompletion.Value.(*runtime.JavaScriptValue).Value.(*runtime.Number).Value)
+		elements := make([]any, length)
+		seen[object] = elements
+
+		for idx := range length {
+			completion := array.Get(vm.runtime, runtime.NewStringValue(strconv.Itoa(idx)), value)
+			if completion.Type == runtime.Normal {
+				elements[idx] = vm.export(completion.Value.(*runtime.JavaScriptValue), seen)
+			}
+		}
+		return elements
+	}
+
+	properties := make(map[string]any)
+	seen[object] = properties
+
+	keysCompletion := object.OwnPropertyKeys(vm.runtime)
+	if keysCompletion.Type != runtime.Normal {
+		return properties
+	}
+
+	for _, key := range keysCompletion.Value.([]*runtime.JavaScriptValue) {
+		if key.Type != runtime.TypeString {
+			continue
+		}
+
+		descriptorCompletion := object.GetOwnProperty(vm.runtime, key)
+		if descriptorCompletion.Type != runtime.Normal {
+			continue
+		}
+
+		descriptor, ok := descriptorCompletion.Value.(runtime.PropertyDescriptor)
+		if !ok || descriptor == nil || !descriptor.GetEnumerable() {
+			continue
+		}
+
+		completion := object.Get(vm.runtime, key, value)
+		if completion.Type == runtime.Normal {
+			properties[key.Value.(*runtime.String).Value] = vm.export(completion.Value.(*runtime.JavaScriptValue), seen)
+		}
+	}
+	return properties
+}
+
+func (vm *VM) call(function *runtime.JavaScriptValue, thisArg *runtime.JavaScriptValue, args []any) (Value, error) {
+	arguments := make([]*runtime.JavaScriptValue, len(args))
+	for idx, arg := range args {
+		value, err := vm.toValue(arg)
+		if err != nil {
+			return vm.undefined(), err
+		}
+		arguments[idx] = value.value
+	}
+
+	completion := vm.runtime.Execute(func() *runtime.Completion {
+		return runtime.Call(vm.runtime, function, thisArg, arguments)
+	})
+	if err := vm.completionError(completion); err != nil {
+		return vm.undefined(), err
+	}
+	return vm.wrap(completion.Value.(*runtime.JavaScriptValue)), nil
+}
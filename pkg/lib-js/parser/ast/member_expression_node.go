@@ -3,12 +3,16 @@ package ast
 import (
 	"fmt"
 	"slices"
+	"sync/atomic"
 )
 
 type MemberExpressionNode struct {
 	PropertyIdentifier string
 	Super              bool
 
+	// InlineCache is owned by the runtime, which uses it to remember the object layouts seen at this site.
+	InlineCache atomic.Value
+
 	parent   Node
 	object   Node
 	property Node
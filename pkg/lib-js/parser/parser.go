@@ -43,6 +43,25 @@ type Parser struct {
 	allowReturnStack  []bool
 	allowInStack      []bool
 	allowDefaultStack []bool
+
+	// How deeply statements and expressions are nested, bounded by maxNestingDepth.
+	nestingDepth int
+}
+
+// maxNestingDepth limits how deeply statements and expressions may be nested, so deeply nested input is a syntax
+// error instead of overflowing the Go stack.
+const maxNestingDepth = 10000
+
+func (p *Parser) enterNesting() error {
+	if p.nestingDepth >= maxNestingDepth {
+		return fmt.Errorf("maximum nesting depth exceeded")
+	}
+	p.nestingDepth++
+	return nil
+}
+
+func (p *Parser) leaveNesting() {
+	p.nestingDepth--
 }
 
 func (p *Parser) PushAllowIn(value bool) {
@@ -246,6 +265,11 @@ func parseStatementListItem(parser *Parser) (ast.Node, error) {
 }
 
 func parseStatement(parser *Parser) (ast.Node, error) {
+	if err := parser.enterNesting(); err != nil {
+		return nil, err
+	}
+	defer parser.leaveNesting()
+
 	// EmptyStatement
 	emptyStatement, emptyStatementErr := parseEmptyStatement(parser)
 	if emptyStatementErr != nil {
@@ -2954,6 +2978,11 @@ func parseBindingElementList(parser *Parser) ([]ast.Node, error) {
 }
 
 func parseAssignmentExpression(parser *Parser) (ast.Node, error) {
+	if err := parser.enterNesting(); err != nil {
+		return nil, err
+	}
+	defer parser.leaveNesting()
+
 	// Expressions are allowed.
 	parser.ExpressionAllowed = true
 
@@ -3725,6 +3754,11 @@ func parseExponentiationExpression(parser *Parser) (ast.Node, error) {
 }
 
 func parseUnaryExpression(parser *Parser) (ast.Node, error) {
+	if err := parser.enterNesting(); err != nil {
+		return nil, err
+	}
+	defer parser.leaveNesting()
+
 	// Expressions are allowed.
 	parser.ExpressionAllowed = true
 
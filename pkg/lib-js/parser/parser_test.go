@@ -2,6 +2,7 @@ package parser
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -4241,3 +4242,10 @@ func TestLexicalDeclaration(t *testing.T) {
 	testLexicalDeclaration("let", false)
 	testLexicalDeclaration("const", true)
 }
+
+func TestNestingDepthLimit(t *testing.T) {
+	parseScriptAndExpectNoErrors(t, strings.Repeat("(", 1000)+"1"+strings.Repeat(")", 1000)+";")
+
+	_, err := ParseText(strings.Repeat("[", maxNestingDepth+1)+strings.Repeat("]", maxNestingDepth+1)+";", ast.Script)
+	assert.EqualError(t, err, "maximum nesting depth exceeded")
+}
@@ -0,0 +1,47 @@
+package runtime
+
+func NewAbortControllerConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		AbortControllerConstructor,
+		0,
+		NewStringValue("AbortController"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// AbortController.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicAbortControllerPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+// AbortController()
+func AbortControllerConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "AbortController constructor requires 'new'"))
+	}
+
+	completion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicAbortControllerPrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	controllerVal := completion.Value.(*JavaScriptValue)
+	controllerVal.Value.(*Object).AbortControllerSignal = NewAbortSignal(runtime)
+
+	return NewNormalCompletion(controllerVal)
+}
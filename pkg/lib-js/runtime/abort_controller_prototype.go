@@ -0,0 +1,71 @@
+package runtime
+
+func NewAbortControllerPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineAbortControllerPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// AbortController.prototype.signal
+	DefineBuiltinAccessorFunction(runtime, prototype, "signal", AbortControllerPrototypeSignalGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// AbortController.prototype.abort
+	DefineBuiltinFunction(runtime, prototype, "abort", AbortControllerPrototypeAbort, 0)
+
+	// AbortController.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("AbortController"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func thisAbortController(runtime *Runtime, thisArg *JavaScriptValue, method string) (*Object, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.AbortControllerSignal != nil {
+			return object.AbortControllerSignal, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "AbortController.prototype."+method+" called on incompatible receiver"))
+}
+
+// get AbortController.prototype.signal
+func AbortControllerPrototypeSignalGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	signal, completion := thisAbortController(runtime, thisArg, "signal")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, signal))
+}
+
+// AbortController.prototype.abort(reason)
+func AbortControllerPrototypeAbort(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	signal, completion := thisAbortController(runtime, thisArg, "abort")
+	if completion != nil {
+		return completion
+	}
+
+	reason := NewUndefinedValue()
+	if len(arguments) > 0 {
+		reason = arguments[0]
+	}
+
+	return SignalAbort(runtime, signal, reason)
+}
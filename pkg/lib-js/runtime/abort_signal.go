@@ -0,0 +1,126 @@
+package runtime
+
+import "slices"
+
+// AbortSignalState is the state of an AbortSignal.
+type AbortSignalState struct {
+	Aborted bool
+	Reason  *JavaScriptValue // The abort reason, nil until the signal is aborted.
+
+	// What the host does when the signal is aborted, such as cancelling a request.
+	algorithms []func()
+
+	// The "abort" event listeners, in the order they were added. The onabort event handler takes up a place in the
+	// list once it is first set.
+	listeners []*abortSignalListener
+	onAbort   *JavaScriptValue
+}
+
+type abortSignalListener struct {
+	callback *JavaScriptValue // nil for the onabort event handler.
+	once     bool
+
+	// Set when the listener is removed, so a dispatch that is under way skips it.
+	removed bool
+}
+
+// AddAlgorithm registers fn to run when the signal is aborted. It isn't run if the signal has already been aborted.
+func (s *AbortSignalState) AddAlgorithm(fn func()) {
+	if !s.Aborted {
+		s.algorithms = append(s.algorithms, fn)
+	}
+}
+
+// NewAbortSignal creates an AbortSignal that hasn't been aborted.
+func NewAbortSignal(runtime *Runtime) *Object {
+	signal := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicAbortSignalPrototype)).(*Object)
+	signal.AbortSignal = &AbortSignalState{}
+	return signal
+}
+
+// NewAbortError creates the error a signal is aborted with when no reason is given.
+func NewAbortError(runtime *Runtime) *JavaScriptValue {
+	return NewNamedError(runtime, "AbortError", "This operation was aborted")
+}
+
+// SignalAbort aborts an AbortSignal with a reason, or with an AbortError if the reason is undefined, and then fires
+// its "abort" event. Every listener is called even if one throws, and the first exception is returned.
+func SignalAbort(runtime *Runtime, signal *Object, reason *JavaScriptValue) *Completion {
+	state := signal.AbortSignal
+	if state.Aborted {
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	if reason.Type == TypeUndefined {
+		reason = NewAbortError(runtime)
+	}
+	state.Aborted = true
+	state.Reason = reason
+
+	algorithms := state.algorithms
+	state.algorithms = nil
+	for _, algorithm := range algorithms {
+		algorithm()
+	}
+
+	signalVal := NewJavaScriptValue(TypeObject, signal)
+	event := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+	CreateDataProperty(runtime, event, NewStringValue("type"), NewStringValue("abort"))
+	CreateDataProperty(runtime, event, NewStringValue("target"), signalVal)
+	eventVal := NewJavaScriptValue(TypeObject, event)
+
+	var thrown *Completion
+	for _, listener := range slices.Clone(state.listeners) {
+		if listener.removed {
+			continue
+		}
+		if listener.once {
+			removeAbortSignalListener(state, listener.callback)
+		}
+
+		callback := listener.callback
+		if callback == nil {
+			callback = state.onAbort
+		}
+		if callback == nil || !IsCallable(callback) {
+			continue
+		}
+
+		completion := Call(runtime, callback, signalVal, []*JavaScriptValue{eventVal})
+		if completion.Type == Terminate {
+			return completion
+		}
+		if completion.Type == Throw && thrown == nil {
+			thrown = completion
+		}
+	}
+
+	if thrown != nil {
+		return thrown
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+func removeAbortSignalListener(state *AbortSignalState, callback *JavaScriptValue) {
+	state.listeners = slices.DeleteFunc(state.listeners, func(listener *abortSignalListener) bool {
+		if listener.callback != nil && listener.callback.Value == callback.Value {
+			listener.removed = true
+			return true
+		}
+		return false
+	})
+}
+
+// FollowAbortSignal makes signal abort with the reason of parent when parent is aborted, or straight away if parent
+// has already been aborted.
+func FollowAbortSignal(runtime *Runtime, signal *Object, parent *Object) {
+	if parent.AbortSignal.Aborted {
+		signal.AbortSignal.Aborted = true
+		signal.AbortSignal.Reason = parent.AbortSignal.Reason
+		return
+	}
+
+	parent.AbortSignal.AddAlgorithm(func() {
+		SignalAbort(runtime, signal, parent.AbortSignal.Reason)
+	})
+}
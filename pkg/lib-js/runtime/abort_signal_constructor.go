@@ -0,0 +1,62 @@
+package runtime
+
+func NewAbortSignalConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		AbortSignalConstructor,
+		0,
+		NewStringValue("AbortSignal"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// AbortSignal.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicAbortSignalPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	// AbortSignal.abort
+	DefineBuiltinFunction(runtime, constructor, "abort", AbortSignalAbort, 0)
+
+	return constructor
+}
+
+// AbortSignal()
+func AbortSignalConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	// Signals are only created by AbortController and the static methods.
+	return NewThrowCompletion(NewTypeError(runtime, "Illegal constructor"))
+}
+
+// AbortSignal.abort(reason)
+func AbortSignalAbort(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	reason := NewUndefinedValue()
+	if len(arguments) > 0 {
+		reason = arguments[0]
+	}
+	if reason.Type == TypeUndefined {
+		reason = NewAbortError(runtime)
+	}
+
+	signal := NewAbortSignal(runtime)
+	signal.AbortSignal.Aborted = true
+	signal.AbortSignal.Reason = reason
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, signal))
+}
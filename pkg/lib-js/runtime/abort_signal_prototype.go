@@ -0,0 +1,234 @@
+package runtime
+
+import "slices"
+
+func NewAbortSignalPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineAbortSignalPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// AbortSignal.prototype.aborted
+	DefineBuiltinAccessorFunction(runtime, prototype, "aborted", AbortSignalPrototypeAbortedGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// AbortSignal.prototype.reason
+	DefineBuiltinAccessorFunction(runtime, prototype, "reason", AbortSignalPrototypeReasonGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// AbortSignal.prototype.onabort
+	DefineBuiltinAccessorFunction(runtime, prototype, "onabort", AbortSignalPrototypeOnAbortGetter, AbortSignalPrototypeOnAbortSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// AbortSignal.prototype.throwIfAborted
+	DefineBuiltinFunction(runtime, prototype, "throwIfAborted", AbortSignalPrototypeThrowIfAborted, 0)
+
+	// AbortSignal.prototype.addEventListener
+	DefineBuiltinFunction(runtime, prototype, "addEventListener", AbortSignalPrototypeAddEventListener, 2)
+
+	// AbortSignal.prototype.removeEventListener
+	DefineBuiltinFunction(runtime, prototype, "removeEventListener", AbortSignalPrototypeRemoveEventListener, 2)
+
+	// AbortSignal.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("AbortSignal"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func thisAbortSignal(runtime *Runtime, thisArg *JavaScriptValue, method string) (*AbortSignalState, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.AbortSignal != nil {
+			return object.AbortSignal, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "AbortSignal.prototype."+method+" called on incompatible receiver"))
+}
+
+// get AbortSignal.prototype.aborted
+func AbortSignalPrototypeAbortedGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	state, completion := thisAbortSignal(runtime, thisArg, "aborted")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(state.Aborted))
+}
+
+// get AbortSignal.prototype.reason
+func AbortSignalPrototypeReasonGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	state, completion := thisAbortSignal(runtime, thisArg, "reason")
+	if completion != nil {
+		return completion
+	}
+
+	if state.Reason == nil {
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+	return NewNormalCompletion(state.Reason)
+}
+
+// get AbortSignal.prototype.onabort
+func AbortSignalPrototypeOnAbortGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	state, completion := thisAbortSignal(runtime, thisArg, "onabort")
+	if completion != nil {
+		return completion
+	}
+
+	if state.onAbort == nil {
+		return NewNormalCompletion(NewNullValue())
+	}
+	return NewNormalCompletion(state.onAbort)
+}
+
+// set AbortSignal.prototype.onabort
+func AbortSignalPrototypeOnAbortSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	state, completion := thisAbortSignal(runtime, thisArg, "onabort")
+	if completion != nil {
+		return completion
+	}
+
+	// Values other than functions and objects are treated as null.
+	if len(arguments) == 0 || arguments[0].Type != TypeObject {
+		state.onAbort = nil
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	isHandler := func(listener *abortSignalListener) bool { return listener.callback == nil }
+	if !slices.ContainsFunc(state.listeners, isHandler) {
+		state.listeners = append(state.listeners, &abortSignalListener{})
+	}
+	state.onAbort = arguments[0]
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// AbortSignal.prototype.throwIfAborted()
+func AbortSignalPrototypeThrowIfAborted(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	state, completion := thisAbortSignal(runtime, thisArg, "throwIfAborted")
+	if completion != nil {
+		return completion
+	}
+
+	if state.Aborted {
+		return NewThrowCompletion(state.Reason)
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// AbortSignal.prototype.addEventListener(type, callback, options)
+func AbortSignalPrototypeAddEventListener(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	state, completion := thisAbortSignal(runtime, thisArg, "addEventListener")
+	if completion != nil {
+		return completion
+	}
+
+	eventType, callback, completion := abortSignalListenerArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	// Signals only fire "abort" events, and a listener is only added once.
+	if eventType != "abort" || callback.Type != TypeObject {
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+	for _, listener := range state.listeners {
+		if listener.callback != nil && listener.callback.Value == callback.Value {
+			return NewNormalCompletion(NewUndefinedValue())
+		}
+	}
+
+	once := false
+	if len(arguments) > 2 && arguments[2].Type == TypeObject {
+		once, completion = getBooleanOption(runtime, arguments[2], "once")
+		if completion != nil {
+			return completion
+		}
+	}
+
+	state.listeners = append(state.listeners, &abortSignalListener{callback: callback, once: once})
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// AbortSignal.prototype.removeEventListener(type, callback, options)
+func AbortSignalPrototypeRemoveEventListener(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	state, completion := thisAbortSignal(runtime, thisArg, "removeEventListener")
+	if completion != nil {
+		return completion
+	}
+
+	eventType, callback, completion := abortSignalListenerArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	if eventType == "abort" && callback.Type == TypeObject {
+		removeAbortSignalListener(state, callback)
+	}
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+func abortSignalListenerArguments(runtime *Runtime, arguments []*JavaScriptValue) (string, *JavaScriptValue, *Completion) {
+	if len(arguments) < 2 {
+		return "", nil, NewThrowCompletion(NewTypeError(runtime, "The \"type\" and \"listener\" arguments must be specified"))
+	}
+
+	completion := ToString(runtime, arguments[0])
+	if completion.Type != Normal {
+		return "", nil, completion
+	}
+
+	return completion.Value.(*JavaScriptValue).Value.(*String).Value, arguments[1], nil
+}
@@ -0,0 +1,58 @@
+package runtime
+
+import (
+	"errors"
+	"sync"
+)
+
+// AgentCluster runs several agents, each with its own Runtime, on separate goroutines.
+// A Runtime must only be used by the goroutine that owns it, so agents communicate through
+// SharedDataBlocks, e.g. by passing GetSharedDataBlock's result to NewSharedArrayBufferFromDataBlock.
+type AgentCluster struct {
+	waitGroup sync.WaitGroup
+	mutex     sync.Mutex
+	errors    []error
+}
+
+func NewAgentCluster() *AgentCluster {
+	return &AgentCluster{}
+}
+
+// Start creates a new agent with a fresh Runtime and Realm and runs fn on its own goroutine.
+// After fn returns, the agent's remaining jobs are run before the agent terminates.
+func (c *AgentCluster) Start(fn func(runtime *Runtime, realm *Realm) error) {
+	c.waitGroup.Add(1)
+
+	go func() {
+		defer c.waitGroup.Done()
+
+		runtime := NewRuntime()
+		realm := NewRealm(runtime)
+
+		err := fn(runtime, realm)
+		if err == nil {
+			completion := runtime.RunJobs()
+			switch completion.Type {
+			case Throw:
+				err = errors.New(ErrorToString(runtime, completion.Value.(*JavaScriptValue)))
+			case Terminate:
+				err = completion.Value.(*InterruptedError)
+			}
+		}
+
+		if err != nil {
+			c.mutex.Lock()
+			c.errors = append(c.errors, err)
+			c.mutex.Unlock()
+		}
+	}()
+}
+
+// Wait blocks until all agents have terminated and returns their errors joined together.
+func (c *AgentCluster) Wait() error {
+	c.waitGroup.Wait()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return errors.Join(c.errors...)
+}
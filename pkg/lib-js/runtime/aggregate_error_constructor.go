@@ -0,0 +1,106 @@
+package runtime
+
+func NewAggregateErrorConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		AggregateErrorConstructor,
+		2,
+		NewStringValue("AggregateError"),
+		realm,
+		realm.GetIntrinsic(IntrinsicErrorConstructor),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// AggregateError.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicAggregateErrorPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+func AggregateErrorConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		newTarget = NewJavaScriptValue(TypeObject, function)
+	}
+
+	newTargetObj := newTarget.Value.(FunctionInterface)
+
+	completion := OrdinaryCreateFromConstructor(runtime, newTargetObj, IntrinsicAggregateErrorPrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	objectVal := completion.Value.(*JavaScriptValue)
+	object := objectVal.Value.(*Object)
+
+	// Set [[ErrorData]] internal slot.
+	object.IsError = true
+
+	messageVal := arguments[1]
+
+	if messageVal.Type != TypeUndefined {
+		completion = ToString(runtime, messageVal)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		messageVal = completion.Value.(*JavaScriptValue)
+
+		completion = object.DefineOwnProperty(runtime, messageStr, &DataPropertyDescriptor{
+			Value:        messageVal,
+			Writable:     true,
+			Enumerable:   false,
+			Configurable: true,
+		})
+		if completion.Type != Normal {
+			panic("Assert failed: Failed to define 'message' property on AggregateError object.")
+		}
+	}
+
+	completion = InstallErrorCause(runtime, object, arguments[2])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = GetIterator(runtime, arguments[0], IteratorKindSync)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = IteratorToList(runtime, completion.Value.(*Iterator))
+	if completion.Type != Normal {
+		return completion
+	}
+
+	errorsList := completion.Value.([]*JavaScriptValue)
+	completion = object.DefineOwnProperty(runtime, errorsStr, &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, CreateArrayFromList(runtime, errorsList)),
+		Writable:     true,
+		Enumerable:   false,
+		Configurable: true,
+	})
+	if completion.Type != Normal {
+		panic("Assert failed: Failed to define 'errors' property on AggregateError object.")
+	}
+
+	InstallErrorStack(runtime, object)
+
+	return NewNormalCompletion(objectVal)
+}
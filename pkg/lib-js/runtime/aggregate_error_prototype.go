@@ -0,0 +1,23 @@
+package runtime
+
+func NewAggregateErrorPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicErrorPrototype))
+}
+
+func DefineAggregateErrorPrototypeProperties(runtime *Runtime, errorProto ObjectInterface) {
+	// AggregateError.prototype.message
+	errorProto.DefineOwnProperty(runtime, messageStr, &DataPropertyDescriptor{
+		Value:        NewStringValue(""),
+		Writable:     true,
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// AggregateError.prototype.name
+	errorProto.DefineOwnProperty(runtime, nameStr, &DataPropertyDescriptor{
+		Value:        NewStringValue("AggregateError"),
+		Writable:     true,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
@@ -7,23 +7,21 @@ import (
 )
 
 type ArgumentsObject struct {
-	Prototype        ObjectInterface
-	Properties       map[string]PropertyDescriptor
-	SymbolProperties map[*Symbol]PropertyDescriptor
-	Extensible       bool
-	PrivateElements  []*PrivateElement
+	Prototype       ObjectInterface
+	Properties      *PropertyStore
+	Extensible      bool
+	PrivateElements []*PrivateElement
 
 	ParameterMap ObjectInterface
 }
 
 func CreateUnmappedArgumentsObject(runtime *Runtime, arguments []*JavaScriptValue) ObjectInterface {
 	obj := &ArgumentsObject{
-		Prototype:        runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype),
-		Properties:       make(map[string]PropertyDescriptor),
-		SymbolProperties: make(map[*Symbol]PropertyDescriptor),
-		Extensible:       true,
-		PrivateElements:  make([]*PrivateElement, 0),
-		ParameterMap:     nil,
+		Prototype:       runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype),
+		Properties:      NewPropertyStore(),
+		Extensible:      true,
+		PrivateElements: make([]*PrivateElement, 0),
+		ParameterMap:    nil,
 	}
 
 	// "length" property.
@@ -92,12 +90,11 @@ func CreateMappedArgumentsObject(
 	parameterMap := OrdinaryObjectCreate(nil)
 
 	obj := &ArgumentsObject{
-		Prototype:        runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype),
-		Properties:       make(map[string]PropertyDescriptor),
-		SymbolProperties: make(map[*Symbol]PropertyDescriptor),
-		Extensible:       true,
-		PrivateElements:  make([]*PrivateElement, 0),
-		ParameterMap:     parameterMap,
+		Prototype:       runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype),
+		Properties:      NewPropertyStore(),
+		Extensible:      true,
+		PrivateElements: make([]*PrivateElement, 0),
+		ParameterMap:    parameterMap,
 	}
 
 	parameterNames := make([]string, 0)
@@ -223,22 +220,10 @@ func (o *ArgumentsObject) SetPrototype(prototype ObjectInterface) {
 	o.Prototype = prototype
 }
 
-func (o *ArgumentsObject) GetProperties() map[string]PropertyDescriptor {
+func (o *ArgumentsObject) GetProperties() *PropertyStore {
 	return o.Properties
 }
 
-func (o *ArgumentsObject) SetProperties(properties map[string]PropertyDescriptor) {
-	o.Properties = properties
-}
-
-func (o *ArgumentsObject) GetSymbolProperties() map[*Symbol]PropertyDescriptor {
-	return o.SymbolProperties
-}
-
-func (o *ArgumentsObject) SetSymbolProperties(symbolProperties map[*Symbol]PropertyDescriptor) {
-	o.SymbolProperties = symbolProperties
-}
-
 func (o *ArgumentsObject) IsExtensible(runtime *Runtime) *Completion {
 	return NewNormalCompletion(NewBooleanValue(o.Extensible))
 }
@@ -10,20 +10,18 @@ var (
 )
 
 type ArrayObject struct {
-	Prototype        ObjectInterface
-	Properties       map[string]PropertyDescriptor
-	SymbolProperties map[*Symbol]PropertyDescriptor
-	Extensible       bool
-	PrivateElements  []*PrivateElement
+	Prototype       ObjectInterface
+	Properties      *PropertyStore
+	Extensible      bool
+	PrivateElements []*PrivateElement
 }
 
 func NewArrayObject(runtime *Runtime, length uint) *ArrayObject {
 	obj := &ArrayObject{
-		Prototype:        runtime.GetRunningRealm().GetIntrinsic(IntrinsicArrayPrototype),
-		Properties:       make(map[string]PropertyDescriptor),
-		SymbolProperties: make(map[*Symbol]PropertyDescriptor),
-		Extensible:       true,
-		PrivateElements:  make([]*PrivateElement, 0),
+		Prototype:       runtime.GetRunningRealm().GetIntrinsic(IntrinsicArrayPrototype),
+		Properties:      NewPropertyStore(),
+		Extensible:      true,
+		PrivateElements: make([]*PrivateElement, 0),
 	}
 	OrdinaryDefineOwnProperty(runtime, obj, NewStringValue("length"), &DataPropertyDescriptor{
 		Value:        NewNumberValue(float64(length), false),
@@ -309,22 +307,10 @@ func (o *ArrayObject) SetPrototype(prototype ObjectInterface) {
 	o.Prototype = prototype
 }
 
-func (o *ArrayObject) GetProperties() map[string]PropertyDescriptor {
+func (o *ArrayObject) GetProperties() *PropertyStore {
 	return o.Properties
 }
 
-func (o *ArrayObject) SetProperties(properties map[string]PropertyDescriptor) {
-	o.Properties = properties
-}
-
-func (o *ArrayObject) GetSymbolProperties() map[*Symbol]PropertyDescriptor {
-	return o.SymbolProperties
-}
-
-func (o *ArrayObject) SetSymbolProperties(symbolProperties map[*Symbol]PropertyDescriptor) {
-	o.SymbolProperties = symbolProperties
-}
-
 func (o *ArrayObject) IsExtensible(runtime *Runtime) *Completion {
 	return NewNormalCompletion(NewBooleanValue(o.Extensible))
 }
@@ -375,7 +361,7 @@ func (o *ArrayObject) SetPrivateElements(privateElements []*PrivateElement) {
 }
 
 func (o *ArrayObject) GetLength() int {
-	desc, ok := o.Properties["length"]
+	desc, ok := o.Properties.GetString("length")
 	if !ok {
 		panic("Assert failed: Length property is not defined.")
 	}
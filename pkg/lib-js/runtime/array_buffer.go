@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"encoding/binary"
+	"fmt"
 	"math"
 	"math/big"
 	"slices"
@@ -22,8 +23,14 @@ func AllocateArrayBuffer(runtime *Runtime, constructor FunctionInterface, byteLe
 		return NewThrowCompletion(NewRangeError(runtime, "ArrayBuffer length too large"))
 	}
 
+	if completion := runtime.CheckArrayBufferLength(byteLength); completion != nil {
+		return completion
+	}
+
+	obj.IsArrayBuffer = true
 	obj.ArrayBufferByteLength = byteLength
 	obj.ArrayBufferData = make([]byte, byteLength)
+	runtime.trackArrayBuffer(obj.ArrayBufferData)
 
 	for i := range obj.ArrayBufferData {
 		obj.ArrayBufferData[i] = 0
@@ -49,8 +56,14 @@ func AllocateArrayBufferWithMaxByteLength(runtime *Runtime, constructor Function
 		return NewThrowCompletion(NewRangeError(runtime, "ArrayBuffer length too large"))
 	}
 
+	if completion := runtime.CheckArrayBufferLength(byteLength); completion != nil {
+		return completion
+	}
+
+	obj.IsArrayBuffer = true
 	obj.ArrayBufferByteLength = byteLength
 	obj.ArrayBufferData = make([]byte, byteLength)
+	runtime.trackArrayBuffer(obj.ArrayBufferData)
 
 	for i := range obj.ArrayBufferData {
 		obj.ArrayBufferData[i] = 0
@@ -64,6 +77,169 @@ func AllocateArrayBufferWithMaxByteLength(runtime *Runtime, constructor Function
 	return NewNormalCompletion(NewJavaScriptValue(TypeObject, obj))
 }
 
+// NewArrayBufferFromBytes creates a fixed-length ArrayBuffer that uses data as its backing store.
+// The slice is not copied, so writes made from JavaScript are visible to the caller and vice versa
+// until the buffer is detached or transferred.
+func NewArrayBufferFromBytes(runtime *Runtime, data []byte) *JavaScriptValue {
+	if data == nil {
+		// A nil data block is how detached buffers are represented.
+		data = []byte{}
+	}
+
+	prototype := runtime.GetRunningRealm().GetIntrinsic(IntrinsicArrayBufferPrototype)
+	obj := OrdinaryObjectCreate(prototype).(*Object)
+	obj.IsArrayBuffer = true
+	obj.ArrayBufferByteLength = uint(len(data))
+	obj.ArrayBufferData = data
+
+	return NewJavaScriptValue(TypeObject, obj)
+}
+
+// BufferSourceBytes returns the bytes of an ArrayBuffer, SharedArrayBuffer, typed array or DataView, which Web APIs
+// call a BufferSource. The slice is not copied. Detached buffers and views that are out of bounds have no bytes. It
+// reports false if value is not a BufferSource.
+func BufferSourceBytes(value *JavaScriptValue) ([]byte, bool) {
+	if value.Type != TypeObject {
+		return nil, false
+	}
+
+	switch object := value.Value.(type) {
+	case *TypedArrayObject:
+		witness := MakeTypedArrayWithBufferWitness(object, false)
+		if witness.IsTypedArrayOutOfBounds() {
+			return []byte{}, true
+		}
+
+		byteLength := witness.TypedArrayLength() * TypedArrayElementSize(object)
+		return object.ViewedArrayBuffer.ArrayBufferData[object.ByteOffset : object.ByteOffset+byteLength], true
+	case *Object:
+		if object.IsArrayBuffer {
+			if IsDetachedArrayBuffer(object) {
+				return []byte{}, true
+			}
+			return object.ArrayBufferData[:ArrayBufferByteLength(object, false)], true
+		}
+
+		if object.IsDataView {
+			witness := MakeDataViewWithBufferWitnessRecord(object, false)
+			if witness.IsViewOutOfBounds() {
+				return []byte{}, true
+			}
+
+			byteLength := witness.GetViewByteLength()
+			return object.DataViewViewedArrayBuffer.ArrayBufferData[object.DataViewByteOffset : object.DataViewByteOffset+byteLength], true
+		}
+	}
+
+	return nil, false
+}
+
+func DetachArrayBuffer(runtime *Runtime, arrayBuffer *Object, key *JavaScriptValue) *Completion {
+	if IsSharedArrayBuffer(arrayBuffer) {
+		panic("Assert failed: Cannot detach a SharedArrayBuffer.")
+	}
+
+	if key == nil {
+		key = NewUndefinedValue()
+	}
+
+	detachKey := arrayBuffer.ArrayBufferDetachKey
+	if detachKey == nil {
+		detachKey = NewUndefinedValue()
+	}
+
+	if !SameValue(detachKey, key).Value.(*JavaScriptValue).Value.(*Boolean).Value {
+		return NewThrowCompletion(NewTypeError(runtime, "ArrayBuffer detach key mismatch"))
+	}
+
+	arrayBuffer.ArrayBufferData = nil
+	arrayBuffer.ArrayBufferByteLength = 0
+
+	return NewNormalCompletion(nil)
+}
+
+func ArrayBufferCopyAndDetach(
+	runtime *Runtime,
+	arrayBuffer *JavaScriptValue,
+	newLength *JavaScriptValue,
+	preserveResizability bool,
+	methodName string,
+) *Completion {
+	obj, errCompletion := thisArrayBufferValue(runtime, arrayBuffer, methodName)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	var newByteLength uint
+	if newLength.Type == TypeUndefined {
+		newByteLength = obj.ArrayBufferByteLength
+	} else {
+		completion := ToIndex(runtime, newLength)
+		if completion.Type != Normal {
+			return completion
+		}
+		newByteLength = uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+	}
+
+	if IsDetachedArrayBuffer(obj) {
+		return NewThrowCompletion(NewTypeError(runtime, "ArrayBuffer is detached"))
+	}
+
+	arrayBufferConstructor := runtime.GetRunningRealm().GetIntrinsic(IntrinsicArrayBufferConstructor).(FunctionInterface)
+
+	var completion *Completion
+	if preserveResizability && !IsFixedLengthArrayBuffer(obj) {
+		completion = AllocateArrayBufferWithMaxByteLength(runtime, arrayBufferConstructor, newByteLength, obj.ArrayBufferMaxByteLength)
+	} else {
+		completion = AllocateArrayBuffer(runtime, arrayBufferConstructor, newByteLength)
+	}
+	if completion.Type != Normal {
+		return completion
+	}
+
+	newBufferVal := completion.Value.(*JavaScriptValue)
+	newBuffer := newBufferVal.Value.(*Object)
+
+	if obj.ArrayBufferDetachKey != nil && obj.ArrayBufferDetachKey.Type != TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "ArrayBuffer is not detachable"))
+	}
+
+	copy(newBuffer.ArrayBufferData, obj.ArrayBufferData)
+
+	completion = DetachArrayBuffer(runtime, obj, nil)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return NewNormalCompletion(newBufferVal)
+}
+
+func IsArrayBufferView(value *JavaScriptValue) bool {
+	if value.Type != TypeObject {
+		return false
+	}
+
+	if _, ok := value.Value.(*TypedArrayObject); ok {
+		return true
+	}
+
+	if object, ok := value.Value.(*Object); ok && object.IsDataView {
+		return true
+	}
+
+	return false
+}
+
+func thisArrayBufferValue(runtime *Runtime, value *JavaScriptValue, methodName string) (*Object, *Completion) {
+	if value.Type == TypeObject {
+		if object, ok := value.Value.(*Object); ok && object.IsArrayBuffer && !object.ArrayBufferDataIsShared {
+			return object, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, fmt.Sprintf("ArrayBuffer.prototype.%s called on incompatible receiver", methodName)))
+}
+
 func IsFixedLengthArrayBuffer(object *Object) bool {
 	return !object.ArrayBufferHasMaxByteLength
 }
@@ -81,7 +257,7 @@ func IsDetachedArrayBuffer(object *Object) bool {
 
 func ArrayBufferByteLength(object *Object, unordered bool) uint {
 	if IsSharedArrayBuffer(object) {
-		panic("TODO: Support shared array buffer in ArrayBufferByteLength.")
+		return object.ArrayBufferSharedDataBlock.ByteLength()
 	}
 
 	if IsDetachedArrayBuffer(object) {
@@ -98,6 +274,26 @@ func GetValueFromBuffer(
 	dataType TypedArrayName,
 	isTypedArray bool,
 	unordered bool,
+) *JavaScriptValue {
+	return GetValueFromBufferWithEndianness(
+		runtime,
+		arrayBuffer,
+		byteIndex,
+		dataType,
+		isTypedArray,
+		unordered,
+		runtime.IsLittleEndian(),
+	)
+}
+
+func GetValueFromBufferWithEndianness(
+	runtime *Runtime,
+	arrayBuffer *Object,
+	byteIndex uint,
+	dataType TypedArrayName,
+	isTypedArray bool,
+	unordered bool,
+	littleEndian bool,
 ) *JavaScriptValue {
 	elementSize, ok := TypedArrayElementSizes[dataType]
 	if !ok {
@@ -106,12 +302,13 @@ func GetValueFromBuffer(
 
 	var rawValue []byte
 	if IsSharedArrayBuffer(arrayBuffer) {
-		panic("TODO: Support shared array buffer in GetValueFromBuffer.")
+		rawValue = arrayBuffer.ArrayBufferSharedDataBlock.Load(byteIndex, elementSize)
 	} else {
-		rawValue = arrayBuffer.ArrayBufferData[byteIndex : byteIndex+elementSize]
+		// Copy the bytes so the conversion below can't modify the buffer.
+		rawValue = slices.Clone(arrayBuffer.ArrayBufferData[byteIndex : byteIndex+elementSize])
 	}
 
-	return RawBytesToNumber(dataType, rawValue, runtime.IsLittleEndian())
+	return RawBytesToNumber(dataType, rawValue, littleEndian)
 }
 
 func SetValueInBuffer(
@@ -120,45 +317,80 @@ func SetValueInBuffer(
 	byteIndex uint,
 	dataType TypedArrayName,
 	value *JavaScriptValue,
+) {
+	SetValueInBufferWithEndianness(runtime, arrayBuffer, byteIndex, dataType, value, runtime.IsLittleEndian())
+}
+
+func SetValueInBufferWithEndianness(
+	runtime *Runtime,
+	arrayBuffer *Object,
+	byteIndex uint,
+	dataType TypedArrayName,
+	value *JavaScriptValue,
+	littleEndian bool,
 ) {
 	elementSize, ok := TypedArrayElementSizes[dataType]
 	if !ok {
 		panic("Assert failed: Provided dataType is not mapped in TypedArrayElementSizes.")
 	}
 
-	rawBytes := NumericToRawBytes(runtime, dataType, value, runtime.IsLittleEndian())
+	rawBytes := NumericToRawBytes(runtime, dataType, value, littleEndian)
 
 	if IsSharedArrayBuffer(arrayBuffer) {
-		panic("TODO: Support shared array buffer in SetValueInBuffer.")
+		arrayBuffer.ArrayBufferSharedDataBlock.Store(byteIndex, rawBytes)
 	} else {
 		copy(arrayBuffer.ArrayBufferData[byteIndex:byteIndex+elementSize], rawBytes)
 	}
 }
 
-func NumericToRawBytes(runtime *Runtime, dataType TypedArrayName, value *JavaScriptValue, littleEndian bool) []byte {
-	var numberValue float64
-	switch value.Type {
-	case TypeNumber:
-		numberValue = value.Value.(*Number).Value
-	case TypeBigInt:
-		numberValue = float64(value.Value.(*BigInt).Value.Int64())
-	default:
-		panic("Assert failed: Invalid value type for NumericToRawBytes.")
+// ReadModifyWriteOperation computes the new raw element bits (in host byte order) from the old bits and the operand.
+type ReadModifyWriteOperation func(old uint64, operand uint64) uint64
+
+func GetModifySetValueInBuffer(
+	runtime *Runtime,
+	arrayBuffer *Object,
+	byteIndex uint,
+	dataType TypedArrayName,
+	value *JavaScriptValue,
+	operation ReadModifyWriteOperation,
+) *JavaScriptValue {
+	elementSize, ok := TypedArrayElementSizes[dataType]
+	if !ok {
+		panic("Assert failed: Provided dataType is not mapped in TypedArrayElementSizes.")
 	}
 
+	littleEndian := runtime.IsLittleEndian()
+	operand := elementBits(NumericToRawBytes(runtime, dataType, value, littleEndian))
+
+	rawBytesRead := make([]byte, elementSize)
+	if IsSharedArrayBuffer(arrayBuffer) {
+		old := arrayBuffer.ArrayBufferSharedDataBlock.modifyBits(byteIndex, elementSize, func(old uint64) uint64 {
+			return operation(old, operand)
+		})
+		putElementBits(rawBytesRead, old)
+	} else {
+		block := arrayBuffer.ArrayBufferData[byteIndex : byteIndex+elementSize]
+		copy(rawBytesRead, block)
+		putElementBits(block, operation(elementBits(rawBytesRead), operand))
+	}
+
+	return RawBytesToNumber(dataType, rawBytesRead, littleEndian)
+}
+
+func NumericToRawBytes(runtime *Runtime, dataType TypedArrayName, value *JavaScriptValue, littleEndian bool) []byte {
 	var rawBytes []byte
 
 	switch dataType {
 	case TypedArrayNameFloat16:
-		bits := float16.Fromfloat32(float32(numberValue)).Bits()
+		bits := float64ToFloat16Bits(numericToFloat64(value))
 		rawBytes = make([]byte, 2)
 		binary.LittleEndian.PutUint16(rawBytes, bits)
 	case TypedArrayNameFloat32:
-		bits := math.Float32bits(float32(numberValue))
+		bits := math.Float32bits(float32(numericToFloat64(value)))
 		rawBytes = make([]byte, 4)
 		binary.LittleEndian.PutUint32(rawBytes, bits)
 	case TypedArrayNameFloat64:
-		bits := math.Float64bits(numberValue)
+		bits := math.Float64bits(numericToFloat64(value))
 		rawBytes = make([]byte, 8)
 		binary.LittleEndian.PutUint64(rawBytes, bits)
 	default:
@@ -177,27 +409,34 @@ func NumericToRawBytes(runtime *Runtime, dataType TypedArrayName, value *JavaScr
 			panic("Assert failed: Conversion function returned an error.")
 		}
 
-		switch value.Type {
+		// The conversion function has already reduced the value into the range of the element type,
+		// so only the two's complement bit pattern needs to be extracted.
+		var intValue uint64
+		convertedValue := completion.Value.(*JavaScriptValue)
+		switch convertedValue.Type {
 		case TypeNumber:
-			numberValue = value.Value.(*Number).Value
+			intValue = uint64(int64(convertedValue.Value.(*Number).Value))
 		case TypeBigInt:
-			numberValue = float64(value.Value.(*BigInt).Value.Int64())
+			bigValue := convertedValue.Value.(*BigInt).Value
+			if bigValue.Sign() < 0 {
+				intValue = uint64(bigValue.Int64())
+			} else {
+				intValue = bigValue.Uint64()
+			}
 		default:
 			panic("Assert failed: Invalid value type for conversion.")
 		}
 
-		int64Value := int64(numberValue)
-
 		// Copy elementSize bytes from the 64-bit value
 		switch elementSize {
 		case 1:
-			rawBytes[0] = byte(int64Value)
+			rawBytes[0] = byte(intValue)
 		case 2:
-			binary.LittleEndian.PutUint16(rawBytes, uint16(int64Value))
+			binary.LittleEndian.PutUint16(rawBytes, uint16(intValue))
 		case 4:
-			binary.LittleEndian.PutUint32(rawBytes, uint32(int64Value))
+			binary.LittleEndian.PutUint32(rawBytes, uint32(intValue))
 		case 8:
-			binary.LittleEndian.PutUint64(rawBytes, uint64(int64Value))
+			binary.LittleEndian.PutUint64(rawBytes, intValue)
 		default:
 			panic("Assert failed: Unsupported element size.")
 		}
@@ -210,6 +449,50 @@ func NumericToRawBytes(runtime *Runtime, dataType TypedArrayName, value *JavaScr
 	return rawBytes
 }
 
+// float64ToFloat16Bits converts a number to the bits of the nearest IEEE 754 binary16 value, rounding ties to even.
+// Converting through float32 instead would round twice, which is wrong for numbers just above halfway between two
+// binary16 values.
+func float64ToFloat16Bits(value float64) uint16 {
+	sign := uint16(math.Float64bits(value)>>48) & 0x8000
+	abs := math.Abs(value)
+
+	switch {
+	case math.IsNaN(value):
+		return 0x7E00
+	case abs == 0:
+		return sign
+	case abs >= 65520:
+		// Halfway between the largest finite value, 65504, and 65536 rounds to even, which is Infinity.
+		return sign | 0x7C00
+	}
+
+	// Scale the value so that its last significant binary16 bit is the units bit, which makes rounding it exact. Below
+	// 2^-14 values are subnormal, and keep the same unit of 2^-24.
+	_, exponent := math.Frexp(abs)
+	exponent = max(exponent-1, -14)
+	significand := math.RoundToEven(math.Ldexp(abs, 10-exponent))
+
+	// The significand includes the implicit leading bit, so adding it carries into the exponent field, including when
+	// rounding overflows to the next power of two.
+	return sign | (uint16(exponent+14)<<10 + uint16(significand))
+}
+
+func numericToFloat64(value *JavaScriptValue) float64 {
+	switch value.Type {
+	case TypeNumber:
+		number := value.Value.(*Number)
+		if number.NaN {
+			return math.NaN()
+		}
+		return number.Value
+	case TypeBigInt:
+		floatValue, _ := new(big.Float).SetInt(value.Value.(*BigInt).Value).Float64()
+		return floatValue
+	}
+
+	panic("Assert failed: Invalid value type for NumericToRawBytes.")
+}
+
 func RawBytesToNumber(dataType TypedArrayName, rawValue []byte, littleEndian bool) *JavaScriptValue {
 	if !littleEndian {
 		slices.Reverse(rawValue)
@@ -226,13 +509,13 @@ func RawBytesToNumber(dataType TypedArrayName, rawValue []byte, littleEndian boo
 		value := uint8(rawValue[0])
 		return NewNumberValue(float64(value), false)
 	case TypedArrayNameInt16:
-		value := binary.LittleEndian.Uint16(rawValue)
+		value := int16(binary.LittleEndian.Uint16(rawValue))
 		return NewNumberValue(float64(value), false)
 	case TypedArrayNameUint16:
 		value := binary.LittleEndian.Uint16(rawValue)
 		return NewNumberValue(float64(value), false)
 	case TypedArrayNameInt32:
-		value := binary.LittleEndian.Uint32(rawValue)
+		value := int32(binary.LittleEndian.Uint32(rawValue))
 		return NewNumberValue(float64(value), false)
 	case TypedArrayNameUint32:
 		value := binary.LittleEndian.Uint32(rawValue)
@@ -242,7 +525,7 @@ func RawBytesToNumber(dataType TypedArrayName, rawValue []byte, littleEndian boo
 		return NewBigIntValue(big.NewInt(int64(value)))
 	case TypedArrayNameBigUint64:
 		value := binary.LittleEndian.Uint64(rawValue)
-		return NewBigIntValue(big.NewInt(int64(value)))
+		return NewBigIntValue(new(big.Int).SetUint64(value))
 	case TypedArrayNameFloat16:
 		value := float16.Frombits(binary.LittleEndian.Uint16(rawValue))
 		valueFloat := float64(value.Float32())
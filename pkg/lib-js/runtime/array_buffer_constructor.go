@@ -20,7 +20,14 @@ func NewArrayBufferConstructor(runtime *Runtime) *FunctionObject {
 		Configurable: false,
 	})
 
-	// TODO: Define other properties.
+	// ArrayBuffer.isView
+	DefineBuiltinFunction(runtime, constructor, "isView", ArrayBufferIsView, 1)
+
+	// ArrayBuffer[%Symbol.species%]
+	DefineBuiltinSymbolAccessorFunction(runtime, constructor, runtime.SymbolSpecies, ArrayBufferSpeciesGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
 
 	return constructor
 }
@@ -89,3 +96,27 @@ func GetArrayBufferMaxByteLengthOption(runtime *Runtime, options *JavaScriptValu
 
 	return ToIndex(runtime, maxByteLengthVal)
 }
+
+func ArrayBufferIsView(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		return NewNormalCompletion(NewBooleanValue(false))
+	}
+
+	return NewNormalCompletion(NewBooleanValue(IsArrayBufferView(arguments[0])))
+}
+
+func ArrayBufferSpeciesGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return NewNormalCompletion(thisArg)
+}
@@ -1,15 +1,128 @@
 package runtime
 
+import "math"
+
 func NewArrayBufferPrototype(runtime *Runtime) ObjectInterface {
 	prototype := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
 	return prototype
 }
 
 func DefineArrayBufferPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// ArrayBuffer.prototype.byteLength
+	DefineBuiltinAccessorFunction(runtime, prototype, "byteLength", ArrayBufferPrototypeByteLengthGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// ArrayBuffer.prototype.detached
+	DefineBuiltinAccessorFunction(runtime, prototype, "detached", ArrayBufferPrototypeDetachedGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// ArrayBuffer.prototype.maxByteLength
+	DefineBuiltinAccessorFunction(runtime, prototype, "maxByteLength", ArrayBufferPrototypeMaxByteLengthGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// ArrayBuffer.prototype.resizable
+	DefineBuiltinAccessorFunction(runtime, prototype, "resizable", ArrayBufferPrototypeResizableGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
 	// ArrayBuffer.prototype.resize
 	DefineBuiltinFunction(runtime, prototype, "resize", ArrayBufferPrototypeResize, 1)
 
-	// TODO: Define other properties.
+	// ArrayBuffer.prototype.slice
+	DefineBuiltinFunction(runtime, prototype, "slice", ArrayBufferPrototypeSlice, 2)
+
+	// ArrayBuffer.prototype.transfer
+	DefineBuiltinFunction(runtime, prototype, "transfer", ArrayBufferPrototypeTransfer, 0)
+
+	// ArrayBuffer.prototype.transferToFixedLength
+	DefineBuiltinFunction(runtime, prototype, "transferToFixedLength", ArrayBufferPrototypeTransferToFixedLength, 0)
+
+	// ArrayBuffer.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("ArrayBuffer"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func ArrayBufferPrototypeByteLengthGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	obj, errCompletion := thisArrayBufferValue(runtime, thisArg, "byteLength")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	if IsDetachedArrayBuffer(obj) {
+		return NewNormalCompletion(NewNumberValue(0, false))
+	}
+
+	return NewNormalCompletion(NewNumberValue(float64(obj.ArrayBufferByteLength), false))
+}
+
+func ArrayBufferPrototypeDetachedGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	obj, errCompletion := thisArrayBufferValue(runtime, thisArg, "detached")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(IsDetachedArrayBuffer(obj)))
+}
+
+func ArrayBufferPrototypeMaxByteLengthGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	obj, errCompletion := thisArrayBufferValue(runtime, thisArg, "maxByteLength")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	if IsDetachedArrayBuffer(obj) {
+		return NewNormalCompletion(NewNumberValue(0, false))
+	}
+
+	if IsFixedLengthArrayBuffer(obj) {
+		return NewNormalCompletion(NewNumberValue(float64(obj.ArrayBufferByteLength), false))
+	}
+
+	return NewNormalCompletion(NewNumberValue(float64(obj.ArrayBufferMaxByteLength), false))
+}
+
+func ArrayBufferPrototypeResizableGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	obj, errCompletion := thisArrayBufferValue(runtime, thisArg, "resizable")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(!IsFixedLengthArrayBuffer(obj)))
 }
 
 func ArrayBufferPrototypeResize(
@@ -56,11 +169,144 @@ func ArrayBufferPrototypeResize(
 		return NewThrowCompletion(NewRangeError(runtime, "New length exceeds the maximum byte length."))
 	}
 
+	if completion := runtime.CheckArrayBufferLength(newByteLength); completion != nil {
+		return completion
+	}
+
 	// Resize the array buffer.
 	obj.ArrayBufferByteLength = newByteLength
 	newData := make([]byte, newByteLength)
 	copy(newData, obj.ArrayBufferData)
 	obj.ArrayBufferData = newData
+	runtime.trackArrayBuffer(newData)
 
 	return NewNormalCompletion(NewUndefinedValue())
 }
+
+func ArrayBufferPrototypeSlice(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	obj, errCompletion := thisArrayBufferValue(runtime, thisArg, "slice")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	if IsDetachedArrayBuffer(obj) {
+		return NewThrowCompletion(NewTypeError(runtime, "ArrayBuffer is detached"))
+	}
+
+	length := float64(obj.ArrayBufferByteLength)
+
+	completion := ToIntegerOrInfinity(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	relativeStart := completion.Value.(*JavaScriptValue).Value.(*Number).Value
+
+	var first float64
+	if relativeStart < 0 {
+		first = math.Max(length+relativeStart, 0)
+	} else {
+		first = math.Min(relativeStart, length)
+	}
+
+	relativeEnd := length
+	if arguments[1].Type != TypeUndefined {
+		completion = ToIntegerOrInfinity(runtime, arguments[1])
+		if completion.Type != Normal {
+			return completion
+		}
+		relativeEnd = completion.Value.(*JavaScriptValue).Value.(*Number).Value
+	}
+
+	var final float64
+	if relativeEnd < 0 {
+		final = math.Max(length+relativeEnd, 0)
+	} else {
+		final = math.Min(relativeEnd, length)
+	}
+
+	newLength := math.Max(final-first, 0)
+
+	defaultConstructor := runtime.GetRunningRealm().GetIntrinsic(IntrinsicArrayBufferConstructor).(FunctionInterface)
+	completion = SpeciesConstructor(runtime, obj, defaultConstructor)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	constructor := completion.Value.(*JavaScriptValue).Value.(FunctionInterface)
+	completion = Construct(runtime, constructor, []*JavaScriptValue{NewNumberValue(newLength, false)}, nil)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	newVal := completion.Value.(*JavaScriptValue)
+	newObj, ok := newVal.Value.(*Object)
+	if !ok || !newObj.IsArrayBuffer || IsSharedArrayBuffer(newObj) {
+		return NewThrowCompletion(NewTypeError(runtime, "ArrayBuffer subclass returned this from species constructor"))
+	}
+
+	if IsDetachedArrayBuffer(newObj) {
+		return NewThrowCompletion(NewTypeError(runtime, "ArrayBuffer is detached"))
+	}
+
+	if newObj == obj {
+		return NewThrowCompletion(NewTypeError(runtime, "ArrayBuffer subclass returned this from species constructor"))
+	}
+
+	if float64(newObj.ArrayBufferByteLength) < newLength {
+		return NewThrowCompletion(NewTypeError(runtime, "Species constructor returned an ArrayBuffer that is too small"))
+	}
+
+	// The species constructor may have run user code that detached or shrunk the buffer.
+	if IsDetachedArrayBuffer(obj) {
+		return NewThrowCompletion(NewTypeError(runtime, "ArrayBuffer is detached"))
+	}
+
+	currentLength := float64(obj.ArrayBufferByteLength)
+	if first < currentLength {
+		count := math.Min(newLength, currentLength-first)
+		copy(newObj.ArrayBufferData[:uint(count)], obj.ArrayBufferData[uint(first):uint(first+count)])
+	}
+
+	return NewNormalCompletion(newVal)
+}
+
+func ArrayBufferPrototypeTransfer(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	return ArrayBufferCopyAndDetach(runtime, thisArg, arguments[0], true, "transfer")
+}
+
+func ArrayBufferPrototypeTransferToFixedLength(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	return ArrayBufferCopyAndDetach(runtime, thisArg, arguments[0], false, "transferToFixedLength")
+}
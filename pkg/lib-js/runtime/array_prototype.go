@@ -15,11 +15,10 @@ var (
 
 func NewArrayPrototype(runtime *Runtime) ObjectInterface {
 	obj := &ArrayObject{
-		Prototype:        runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype),
-		Properties:       make(map[string]PropertyDescriptor),
-		SymbolProperties: make(map[*Symbol]PropertyDescriptor),
-		Extensible:       true,
-		PrivateElements:  make([]*PrivateElement, 0),
+		Prototype:       runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype),
+		Properties:      NewPropertyStore(),
+		Extensible:      true,
+		PrivateElements: make([]*PrivateElement, 0),
 	}
 	OrdinaryDefineOwnProperty(runtime, obj, NewStringValue("length"), &DataPropertyDescriptor{
 		Value:        NewNumberValue(0, false),
@@ -328,6 +327,11 @@ func ArrayPrototypeConcat(
 				if !completion.Value.(*JavaScriptValue).Value.(*Boolean).Value {
 					return NewThrowCompletion(NewTypeError(runtime, "Failed to create data property."))
 				}
+
+				// Copying a large array may go over the runtime's resource limits, or take long enough to be interrupted.
+				if completion := runtime.CheckInterrupt(); completion != nil {
+					return completion
+				}
 				index++
 			}
 		} else {
@@ -1316,12 +1320,23 @@ func ArrayPrototypeJoin(
 	objectVal := completion.Value.(*JavaScriptValue)
 	object := objectVal.Value.(ObjectInterface)
 
+	// Like other engines, an array that contains itself, directly or through other arrays, is joined as an empty string
+	// where it recurs instead of recursing until the call stack is exhausted.
+	if _, ok := runtime.joining[object]; ok {
+		return NewNormalCompletion(NewStringValue(""))
+	}
+	if runtime.joining == nil {
+		runtime.joining = make(map[ObjectInterface]struct{})
+	}
+	runtime.joining[object] = struct{}{}
+	defer delete(runtime.joining, object)
+
 	completion = LengthOfArrayLike(runtime, object)
 	if completion.Type != Normal {
 		return completion
 	}
 
-	len := completion.Value.(*JavaScriptValue).Value.(*Number).Value
+	length := completion.Value.(*JavaScriptValue).Value.(*Number).Value
 
 	if separator.Type == TypeUndefined {
 		separator = NewStringValue(",")
@@ -1335,7 +1350,11 @@ func ArrayPrototypeJoin(
 
 	resultString := ""
 
-	for idx := range int(len) {
+	for idx := range int(length) {
+		if completion := runtime.CheckInterrupt(); completion != nil {
+			return completion
+		}
+
 		if idx > 0 {
 			resultString += separator.Value.(*String).Value
 		}
@@ -1359,10 +1378,14 @@ func ArrayPrototypeJoin(
 		}
 
 		valueStr := completion.Value.(*JavaScriptValue).Value.(*String).Value
+		if completion := runtime.CheckStringLength(len(resultString) + len(valueStr)); completion != nil {
+			return completion
+		}
+
 		resultString += valueStr
 	}
 
-	return NewNormalCompletion(NewStringValue(resultString))
+	return NewNormalCompletion(NewJavaScriptValue(TypeString, runtime.trackString(&String{Value: resultString})))
 }
 
 func ArrayPrototypeKeys(
@@ -2710,6 +2733,15 @@ func ArrayPrototypeToLocaleString(
 	arguments []*JavaScriptValue,
 	newTarget *JavaScriptValue,
 ) *Completion {
+	// The locales and options are passed on to the toLocaleString of each element.
+	locales, options := NewUndefinedValue(), NewUndefinedValue()
+	if len(arguments) > 0 {
+		locales = arguments[0]
+	}
+	if len(arguments) > 1 {
+		options = arguments[1]
+	}
+
 	completion := ToObject(runtime, thisArg)
 	if completion.Type != Normal {
 		return completion
@@ -2750,7 +2782,7 @@ func ArrayPrototypeToLocaleString(
 			continue
 		}
 
-		completion = Invoke(runtime, element, toLocaleStringStr, nil)
+		completion = Invoke(runtime, element, toLocaleStringStr, []*JavaScriptValue{locales, options})
 		if completion.Type != Normal {
 			return completion
 		}
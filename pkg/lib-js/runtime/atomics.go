@@ -0,0 +1,711 @@
+package runtime
+
+import (
+	"math"
+	"time"
+)
+
+type AtomicsWaitMode int
+
+const (
+	AtomicsWaitModeSync AtomicsWaitMode = iota
+	AtomicsWaitModeAsync
+)
+
+// atomicsWaiter corresponds to a Waiter Record in the spec.
+type atomicsWaiter struct {
+	// Sync waiters block on this channel, which is closed when they are notified.
+	notified chan struct{}
+
+	// Async waiters are resolved with a job on the agent that called Atomics.waitAsync.
+	operation  *HostOperation
+	realm      *Realm
+	capability *PromiseCapability
+	timer      *time.Timer
+}
+
+func NewAtomicsObject(runtime *Runtime) ObjectInterface {
+	atomicsObj := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+
+	// Atomics.add
+	DefineBuiltinFunction(runtime, atomicsObj, "add", AtomicsAdd, 3)
+
+	// Atomics.and
+	DefineBuiltinFunction(runtime, atomicsObj, "and", AtomicsAnd, 3)
+
+	// Atomics.compareExchange
+	DefineBuiltinFunction(runtime, atomicsObj, "compareExchange", AtomicsCompareExchange, 4)
+
+	// Atomics.exchange
+	DefineBuiltinFunction(runtime, atomicsObj, "exchange", AtomicsExchange, 3)
+
+	// Atomics.isLockFree
+	DefineBuiltinFunction(runtime, atomicsObj, "isLockFree", AtomicsIsLockFree, 1)
+
+	// Atomics.load
+	DefineBuiltinFunction(runtime, atomicsObj, "load", AtomicsLoad, 2)
+
+	// Atomics.notify
+	DefineBuiltinFunction(runtime, atomicsObj, "notify", AtomicsNotify, 3)
+
+	// Atomics.or
+	DefineBuiltinFunction(runtime, atomicsObj, "or", AtomicsOr, 3)
+
+	// Atomics.pause
+	DefineBuiltinFunction(runtime, atomicsObj, "pause", AtomicsPause, 0)
+
+	// Atomics.store
+	DefineBuiltinFunction(runtime, atomicsObj, "store", AtomicsStore, 3)
+
+	// Atomics.sub
+	DefineBuiltinFunction(runtime, atomicsObj, "sub", AtomicsSub, 3)
+
+	// Atomics.wait
+	DefineBuiltinFunction(runtime, atomicsObj, "wait", AtomicsWait, 4)
+
+	// Atomics.waitAsync
+	DefineBuiltinFunction(runtime, atomicsObj, "waitAsync", AtomicsWaitAsync, 4)
+
+	// Atomics.xor
+	DefineBuiltinFunction(runtime, atomicsObj, "xor", AtomicsXor, 3)
+
+	// Atomics[%Symbol.toStringTag%]
+	atomicsObj.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("Atomics"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	return atomicsObj
+}
+
+func ValidateIntegerTypedArray(runtime *Runtime, typedArray *JavaScriptValue, waitable bool) (*TypedArrayWithBufferWitness, *Completion) {
+	object, ok := typedArray.Value.(*TypedArrayObject)
+	if typedArray.Type != TypeObject || !ok {
+		return nil, NewThrowCompletion(NewTypeError(runtime, "Argument is not a typed array"))
+	}
+
+	taRecord := MakeTypedArrayWithBufferWitness(object, false)
+	if taRecord.IsTypedArrayOutOfBounds() {
+		return nil, NewThrowCompletion(NewTypeError(runtime, "Typed array is out of bounds"))
+	}
+
+	if waitable {
+		if object.TypedArrayName != TypedArrayNameInt32 && object.TypedArrayName != TypedArrayNameBigInt64 {
+			return nil, NewThrowCompletion(NewTypeError(runtime, "Atomics operation requires an Int32Array or BigInt64Array"))
+		}
+	} else {
+		switch object.TypedArrayName {
+		case TypedArrayNameUint8Clamped, TypedArrayNameFloat16, TypedArrayNameFloat32, TypedArrayNameFloat64:
+			return nil, NewThrowCompletion(NewTypeError(runtime, "Atomics operation requires an integer typed array"))
+		}
+	}
+
+	return taRecord, nil
+}
+
+func ValidateAtomicAccess(runtime *Runtime, taRecord *TypedArrayWithBufferWitness, requestIndex *JavaScriptValue) *Completion {
+	length := taRecord.TypedArrayLength()
+
+	completion := ToIndex(runtime, requestIndex)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	accessIndex := uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+	if accessIndex >= length {
+		return NewThrowCompletion(NewRangeError(runtime, "Atomics access index out of range"))
+	}
+
+	typedArray := taRecord.Object
+	byteIndexInBuffer := accessIndex*TypedArrayElementSize(typedArray) + typedArray.ByteOffset
+
+	return NewNormalCompletion(NewNumberValue(float64(byteIndexInBuffer), false))
+}
+
+func ValidateAtomicAccessOnIntegerTypedArray(
+	runtime *Runtime,
+	typedArray *JavaScriptValue,
+	requestIndex *JavaScriptValue,
+	waitable bool,
+) (*TypedArrayObject, uint, *Completion) {
+	taRecord, errCompletion := ValidateIntegerTypedArray(runtime, typedArray, waitable)
+	if errCompletion != nil {
+		return nil, 0, errCompletion
+	}
+
+	completion := ValidateAtomicAccess(runtime, taRecord, requestIndex)
+	if completion.Type != Normal {
+		return nil, 0, completion
+	}
+
+	byteIndexInBuffer := uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+	return taRecord.Object, byteIndexInBuffer, nil
+}
+
+func RevalidateAtomicAccess(runtime *Runtime, typedArray *TypedArrayObject, byteIndexInBuffer uint) *Completion {
+	taRecord := MakeTypedArrayWithBufferWitness(typedArray, true)
+	if taRecord.IsTypedArrayOutOfBounds() {
+		return NewThrowCompletion(NewTypeError(runtime, "Typed array is out of bounds"))
+	}
+
+	if byteIndexInBuffer >= taRecord.CachedBufferByteLength {
+		return NewThrowCompletion(NewRangeError(runtime, "Atomics access index out of range"))
+	}
+
+	return NewUnusedCompletion()
+}
+
+// toAtomicsOperand converts a value to the numeric type stored in the typed array.
+func toAtomicsOperand(runtime *Runtime, typedArray *TypedArrayObject, value *JavaScriptValue) *Completion {
+	if typedArray.ContentType == TypedArrayContentTypeBigInt {
+		return ToBigInt(runtime, value)
+	}
+
+	return ToIntegerOrInfinity(runtime, value)
+}
+
+func AtomicReadModifyWrite(
+	runtime *Runtime,
+	typedArray *JavaScriptValue,
+	index *JavaScriptValue,
+	value *JavaScriptValue,
+	operation ReadModifyWriteOperation,
+) *Completion {
+	typedArrayObj, byteIndexInBuffer, errCompletion := ValidateAtomicAccessOnIntegerTypedArray(runtime, typedArray, index, false)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	completion := toAtomicsOperand(runtime, typedArrayObj, value)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	v := completion.Value.(*JavaScriptValue)
+
+	completion = RevalidateAtomicAccess(runtime, typedArrayObj, byteIndexInBuffer)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return NewNormalCompletion(GetModifySetValueInBuffer(
+		runtime,
+		typedArrayObj.ViewedArrayBuffer,
+		byteIndexInBuffer,
+		typedArrayObj.TypedArrayName,
+		v,
+		operation,
+	))
+}
+
+func atomicsReadModifyWriteFunction(operation ReadModifyWriteOperation) NativeFunctionBehaviour {
+	return func(
+		runtime *Runtime,
+		function *FunctionObject,
+		thisArg *JavaScriptValue,
+		arguments []*JavaScriptValue,
+		newTarget *JavaScriptValue,
+	) *Completion {
+		for idx := range 3 {
+			if idx >= len(arguments) {
+				arguments = append(arguments, NewUndefinedValue())
+			}
+		}
+
+		return AtomicReadModifyWrite(runtime, arguments[0], arguments[1], arguments[2], operation)
+	}
+}
+
+var (
+	AtomicsAdd = atomicsReadModifyWriteFunction(func(old uint64, operand uint64) uint64 {
+		return old + operand
+	})
+	AtomicsAnd = atomicsReadModifyWriteFunction(func(old uint64, operand uint64) uint64 {
+		return old & operand
+	})
+	AtomicsExchange = atomicsReadModifyWriteFunction(func(old uint64, operand uint64) uint64 {
+		return operand
+	})
+	AtomicsOr = atomicsReadModifyWriteFunction(func(old uint64, operand uint64) uint64 {
+		return old | operand
+	})
+	AtomicsSub = atomicsReadModifyWriteFunction(func(old uint64, operand uint64) uint64 {
+		return old - operand
+	})
+	AtomicsXor = atomicsReadModifyWriteFunction(func(old uint64, operand uint64) uint64 {
+		return old ^ operand
+	})
+)
+
+func AtomicsCompareExchange(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 4 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	typedArrayObj, byteIndexInBuffer, errCompletion := ValidateAtomicAccessOnIntegerTypedArray(runtime, arguments[0], arguments[1], false)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	completion := toAtomicsOperand(runtime, typedArrayObj, arguments[2])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	expected := completion.Value.(*JavaScriptValue)
+
+	completion = toAtomicsOperand(runtime, typedArrayObj, arguments[3])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	replacement := completion.Value.(*JavaScriptValue)
+
+	completion = RevalidateAtomicAccess(runtime, typedArrayObj, byteIndexInBuffer)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	elementType := typedArrayObj.TypedArrayName
+	elementSize := TypedArrayElementSize(typedArrayObj)
+	expectedBits := elementBits(NumericToRawBytes(runtime, elementType, expected, runtime.IsLittleEndian()))
+	mask := elementMask(elementSize)
+
+	return NewNormalCompletion(GetModifySetValueInBuffer(
+		runtime,
+		typedArrayObj.ViewedArrayBuffer,
+		byteIndexInBuffer,
+		elementType,
+		replacement,
+		func(old uint64, operand uint64) uint64 {
+			if old&mask == expectedBits&mask {
+				return operand
+			}
+			return old
+		},
+	))
+}
+
+func AtomicsIsLockFree(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	completion := ToIntegerOrInfinity(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	// sync/atomic provides lock-free operations for every element size of the integer typed arrays.
+	switch completion.Value.(*JavaScriptValue).Value.(*Number).Value {
+	case 1, 2, 4, 8:
+		return NewNormalCompletion(NewBooleanValue(true))
+	}
+
+	return NewNormalCompletion(NewBooleanValue(false))
+}
+
+func AtomicsLoad(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	typedArrayObj, byteIndexInBuffer, errCompletion := ValidateAtomicAccessOnIntegerTypedArray(runtime, arguments[0], arguments[1], false)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	completion := RevalidateAtomicAccess(runtime, typedArrayObj, byteIndexInBuffer)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return NewNormalCompletion(GetValueFromBuffer(
+		runtime,
+		typedArrayObj.ViewedArrayBuffer,
+		byteIndexInBuffer,
+		typedArrayObj.TypedArrayName,
+		true,  /* isTypedArray */
+		false, /* unordered */
+	))
+}
+
+func AtomicsStore(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	typedArrayObj, byteIndexInBuffer, errCompletion := ValidateAtomicAccessOnIntegerTypedArray(runtime, arguments[0], arguments[1], false)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	completion := toAtomicsOperand(runtime, typedArrayObj, arguments[2])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	v := completion.Value.(*JavaScriptValue)
+	if number, ok := v.Value.(*Number); ok && number.Value == 0 {
+		// Normalize -0 to +0.
+		v = NewNumberValue(0, false)
+	}
+
+	completion = RevalidateAtomicAccess(runtime, typedArrayObj, byteIndexInBuffer)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	SetValueInBuffer(runtime, typedArrayObj.ViewedArrayBuffer, byteIndexInBuffer, typedArrayObj.TypedArrayName, v)
+	return NewNormalCompletion(v)
+}
+
+func AtomicsPause(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) > 0 && arguments[0].Type != TypeUndefined {
+		number, ok := arguments[0].Value.(*Number)
+		if arguments[0].Type != TypeNumber || !ok || number.NaN || math.IsInf(number.Value, 0) || math.Trunc(number.Value) != number.Value {
+			return NewThrowCompletion(NewTypeError(runtime, "Atomics.pause argument must be an integral Number"))
+		}
+	}
+
+	// The pause is only a hint for spin-wait loops, so there is nothing to do.
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+func AtomicsWait(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 4 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return DoWait(runtime, AtomicsWaitModeSync, arguments[0], arguments[1], arguments[2], arguments[3])
+}
+
+func AtomicsWaitAsync(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 4 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return DoWait(runtime, AtomicsWaitModeAsync, arguments[0], arguments[1], arguments[2], arguments[3])
+}
+
+func DoWait(
+	runtime *Runtime,
+	mode AtomicsWaitMode,
+	typedArray *JavaScriptValue,
+	index *JavaScriptValue,
+	value *JavaScriptValue,
+	timeout *JavaScriptValue,
+) *Completion {
+	taRecord, errCompletion := ValidateIntegerTypedArray(runtime, typedArray, true)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	typedArrayObj := taRecord.Object
+	buffer := typedArrayObj.ViewedArrayBuffer
+	if !IsSharedArrayBuffer(buffer) {
+		return NewThrowCompletion(NewTypeError(runtime, "Atomics wait requires a shared typed array"))
+	}
+
+	completion := ValidateAtomicAccess(runtime, taRecord, index)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	byteIndexInBuffer := uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+
+	if typedArrayObj.TypedArrayName == TypedArrayNameBigInt64 {
+		completion = ToBigInt64(runtime, value)
+	} else {
+		completion = ToInt32(runtime, value)
+	}
+	if completion.Type != Normal {
+		return completion
+	}
+
+	v := completion.Value.(*JavaScriptValue)
+
+	completion = ToNumber(runtime, timeout)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	q := completion.Value.(*JavaScriptValue).Value.(*Number)
+	t := math.Inf(1)
+	if !q.NaN && !math.IsInf(q.Value, 1) {
+		t = math.Max(q.Value, 0)
+	}
+
+	if mode == AtomicsWaitModeSync && !runtime.CanBlock {
+		return NewThrowCompletion(NewTypeError(runtime, "Atomics.wait cannot be called in this context"))
+	}
+
+	block := buffer.ArrayBufferSharedDataBlock
+	elementSize := TypedArrayElementSize(typedArrayObj)
+	expected := elementBits(NumericToRawBytes(runtime, typedArrayObj.TypedArrayName, v, runtime.IsLittleEndian()))
+
+	var capability *PromiseCapability
+	if mode == AtomicsWaitModeAsync {
+		capability = NewPromiseCapabilityFromIntrinsic(runtime)
+	}
+
+	block.waitersMutex.Lock()
+
+	if block.loadBits(byteIndexInBuffer, elementSize) != expected {
+		block.waitersMutex.Unlock()
+		return atomicsWaitResult(runtime, mode, NewStringValue("not-equal"))
+	}
+
+	if t == 0 && mode == AtomicsWaitModeAsync {
+		block.waitersMutex.Unlock()
+		return atomicsWaitResult(runtime, mode, NewStringValue("timed-out"))
+	}
+
+	waiter := &atomicsWaiter{
+		realm:      runtime.GetRunningRealm(),
+		capability: capability,
+	}
+	if mode == AtomicsWaitModeSync {
+		waiter.notified = make(chan struct{})
+	}
+	block.waiters[byteIndexInBuffer] = append(block.waiters[byteIndexInBuffer], waiter)
+
+	if mode == AtomicsWaitModeSync {
+		block.waitersMutex.Unlock()
+		return block.suspendAgent(runtime, byteIndexInBuffer, waiter, t)
+	}
+
+	// The agent has to keep running jobs until the waiter is notified or times out. If the script is terminated
+	// first, the waiter is dropped.
+	waiter.operation = runtime.BeginHostOperation(func() {
+		block.waitersMutex.Lock()
+		block.removeWaiter(byteIndexInBuffer, waiter)
+		if waiter.timer != nil {
+			waiter.timer.Stop()
+		}
+		block.waitersMutex.Unlock()
+	})
+
+	if !math.IsInf(t, 1) {
+		waiter.timer = time.AfterFunc(time.Duration(t*float64(time.Millisecond)), func() {
+			block.waitersMutex.Lock()
+			removed := block.removeWaiter(byteIndexInBuffer, waiter)
+			block.waitersMutex.Unlock()
+
+			if removed {
+				waiter.resolveAsync("timed-out")
+			}
+		})
+	}
+
+	block.waitersMutex.Unlock()
+
+	resultObject := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+	CreateDataProperty(runtime, resultObject, NewStringValue("async"), NewBooleanValue(true))
+	CreateDataProperty(runtime, resultObject, NewStringValue("value"), capability.Promise)
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, resultObject))
+}
+
+func atomicsWaitResult(runtime *Runtime, mode AtomicsWaitMode, value *JavaScriptValue) *Completion {
+	if mode == AtomicsWaitModeSync {
+		return NewNormalCompletion(value)
+	}
+
+	resultObject := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+	CreateDataProperty(runtime, resultObject, NewStringValue("async"), NewBooleanValue(false))
+	CreateDataProperty(runtime, resultObject, NewStringValue("value"), value)
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, resultObject))
+}
+
+// suspendAgent blocks the calling goroutine until the waiter is notified or the timeout (in milliseconds) elapses,
+// returning "ok" or "timed-out". If the runtime is interrupted first, the waiter is removed and it returns a Terminate
+// completion.
+func (b *SharedDataBlock) suspendAgent(runtime *Runtime, byteIndex uint, waiter *atomicsWaiter, timeout float64) *Completion {
+	var expired <-chan time.Time
+	if !math.IsInf(timeout, 1) {
+		timer := time.NewTimer(time.Duration(timeout * float64(time.Millisecond)))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		if interrupted := runtime.CheckInterrupt(); interrupted != nil {
+			b.waitersMutex.Lock()
+			removed := b.removeWaiter(byteIndex, waiter)
+			b.waitersMutex.Unlock()
+
+			// The waiter may have been notified just before the interrupt.
+			if !removed {
+				return NewNormalCompletion(NewStringValue("ok"))
+			}
+			return interrupted
+		}
+
+		select {
+		case <-waiter.notified:
+			return NewNormalCompletion(NewStringValue("ok"))
+		case <-expired:
+			b.waitersMutex.Lock()
+			removed := b.removeWaiter(byteIndex, waiter)
+			b.waitersMutex.Unlock()
+
+			// The waiter may have been notified between the timeout firing and taking the lock.
+			if !removed {
+				return NewNormalCompletion(NewStringValue("ok"))
+			}
+			return NewNormalCompletion(NewStringValue("timed-out"))
+		case <-runtime.interruptWake:
+			// An interrupt that was cleared in the meantime leaves a stale wake-up behind, so check again.
+		}
+	}
+}
+
+// removeWaiter must be called while holding waitersMutex.
+func (b *SharedDataBlock) removeWaiter(byteIndex uint, waiter *atomicsWaiter) bool {
+	waiters := b.waiters[byteIndex]
+	for idx, w := range waiters {
+		if w == waiter {
+			b.waiters[byteIndex] = append(waiters[:idx:idx], waiters[idx+1:]...)
+			if len(b.waiters[byteIndex]) == 0 {
+				delete(b.waiters, byteIndex)
+			}
+			return true
+		}
+	}
+
+	return false
+}
+
+// NotifyWaiters wakes up to count waiters on byteIndex, in FIFO order, and returns how many were woken.
+func (b *SharedDataBlock) NotifyWaiters(byteIndex uint, count float64) int {
+	b.waitersMutex.Lock()
+
+	waiters := b.waiters[byteIndex]
+	n := len(waiters)
+	if count < float64(n) {
+		n = int(count)
+	}
+
+	woken := waiters[:n:n]
+	if n == len(waiters) {
+		delete(b.waiters, byteIndex)
+	} else {
+		b.waiters[byteIndex] = waiters[n:]
+	}
+
+	b.waitersMutex.Unlock()
+
+	for _, waiter := range woken {
+		if waiter.capability == nil {
+			close(waiter.notified)
+			continue
+		}
+
+		if waiter.timer != nil {
+			waiter.timer.Stop()
+		}
+		waiter.resolveAsync("ok")
+	}
+
+	return n
+}
+
+// resolveAsync settles the promise of an async waiter from any goroutine, by queueing a job on its agent.
+func (w *atomicsWaiter) resolveAsync(result string) {
+	w.operation.EnqueueJob(func(runtime *Runtime) *Completion {
+		return Call(runtime, w.capability.Resolve, NewUndefinedValue(), []*JavaScriptValue{NewStringValue(result)})
+	}, w.realm)
+	w.operation.End()
+}
+
+func AtomicsNotify(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	typedArrayObj, byteIndexInBuffer, errCompletion := ValidateAtomicAccessOnIntegerTypedArray(runtime, arguments[0], arguments[1], true)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	count := math.Inf(1)
+	if arguments[2].Type != TypeUndefined {
+		completion := ToIntegerOrInfinity(runtime, arguments[2])
+		if completion.Type != Normal {
+			return completion
+		}
+		count = math.Max(completion.Value.(*JavaScriptValue).Value.(*Number).Value, 0)
+	}
+
+	buffer := typedArrayObj.ViewedArrayBuffer
+	if !IsSharedArrayBuffer(buffer) {
+		return NewNormalCompletion(NewNumberValue(0, false))
+	}
+
+	woken := buffer.ArrayBufferSharedDataBlock.NotifyWaiters(byteIndexInBuffer, count)
+	return NewNormalCompletion(NewNumberValue(float64(woken), false))
+}
@@ -0,0 +1,113 @@
+package runtime
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// btoa(data)
+func Btoa(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		return NewThrowCompletion(NewTypeError(runtime, "btoa requires 1 argument."))
+	}
+
+	completion := ToString(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+	data := completion.Value.(*JavaScriptValue).Value.(*String).Value
+
+	// Each character is a byte, so characters above U+00FF can't be encoded.
+	bytes := make([]byte, 0, len(data))
+	for _, char := range data {
+		if char > 0xFF {
+			return NewThrowCompletion(NewNamedError(runtime, "InvalidCharacterError", "Invalid character"))
+		}
+		bytes = append(bytes, byte(char))
+	}
+
+	if completion := runtime.CheckStringLength(base64.StdEncoding.EncodedLen(len(bytes))); completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeString, runtime.trackString(&String{Value: base64.StdEncoding.EncodeToString(bytes)})))
+}
+
+// atob(data)
+func Atob(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		return NewThrowCompletion(NewTypeError(runtime, "atob requires 1 argument."))
+	}
+
+	completion := ToString(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	bytes, ok := ForgivingBase64Decode(completion.Value.(*JavaScriptValue).Value.(*String).Value)
+	if !ok {
+		return NewThrowCompletion(NewNamedError(runtime, "InvalidCharacterError", "The string to be decoded is not correctly encoded."))
+	}
+
+	// Each byte becomes the character with the same code, which takes two bytes from U+0080.
+	length := len(bytes)
+	for _, b := range bytes {
+		if b >= 0x80 {
+			length++
+		}
+	}
+	if completion := runtime.CheckStringLength(length); completion != nil {
+		return completion
+	}
+
+	var builder strings.Builder
+	builder.Grow(length)
+	for _, b := range bytes {
+		builder.WriteRune(rune(b))
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeString, runtime.trackString(&String{Value: builder.String()})))
+}
+
+// ForgivingBase64Decode decodes base64 the way the Infra Standard does: ASCII whitespace is ignored, padding is
+// optional and the bits left over at the end are discarded. It reports false if data isn't base64.
+func ForgivingBase64Decode(data string) ([]byte, bool) {
+	data = strings.Map(func(char rune) rune {
+		switch char {
+		case '\t', '\n', '\f', '\r', ' ':
+			return -1
+		}
+		return char
+	}, data)
+
+	if len(data)%4 == 0 {
+		if strings.HasSuffix(data, "==") {
+			data = data[:len(data)-2]
+		} else if strings.HasSuffix(data, "=") {
+			data = data[:len(data)-1]
+		}
+	}
+
+	if len(data)%4 == 1 {
+		return nil, false
+	}
+
+	bytes, err := base64.RawStdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, false
+	}
+
+	return bytes, true
+}
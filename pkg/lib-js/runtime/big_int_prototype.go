@@ -7,5 +7,52 @@ func NewBigIntPrototype(runtime *Runtime) ObjectInterface {
 }
 
 func DefineBigIntPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// BigInt.prototype.toLocaleString
+	DefineBuiltinFunction(runtime, prototype, "toLocaleString", BigIntPrototypeToLocaleString, 0)
+
 	// TODO: Define other properties.
 }
+
+func thisBigIntValue(runtime *Runtime, value *JavaScriptValue, method string) (*JavaScriptValue, *Completion) {
+	if value.Type == TypeBigInt {
+		return value, nil
+	}
+
+	if value.Type == TypeObject {
+		if object, ok := value.Value.(*Object); ok && object.BigIntData != nil {
+			return object.BigIntData, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "BigInt.prototype."+method+" requires that 'this' be a BigInt"))
+}
+
+// BigInt.prototype.toLocaleString(locales, options)
+func BigIntPrototypeToLocaleString(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	bigInt, completion := thisBigIntValue(runtime, thisArg, "toLocaleString")
+	if completion != nil {
+		return completion
+	}
+
+	requested, options, completion := intlConstructorArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	numberFormat, completion := InitializeNumberFormat(runtime, requested, options)
+	if completion != nil {
+		return completion
+	}
+
+	x, completion := ToIntlMathematicalValue(runtime, bigInt)
+	if completion != nil {
+		return completion
+	}
+	return NewNormalCompletion(NewStringValue(FormatNumeric(numberFormat, x)))
+}
@@ -1,11 +1,10 @@
 package runtime
 
 type BoundFunction struct {
-	Prototype        ObjectInterface
-	Properties       map[string]PropertyDescriptor
-	SymbolProperties map[*Symbol]PropertyDescriptor
-	Extensible       bool
-	PrivateElements  []*PrivateElement
+	Prototype       ObjectInterface
+	Properties      *PropertyStore
+	Extensible      bool
+	PrivateElements []*PrivateElement
 
 	BoundTargetFunction *JavaScriptValue
 	BoundThis           *JavaScriptValue
@@ -34,8 +33,7 @@ func BoundFunctionCreate(
 
 	boundFunc := &BoundFunction{
 		Prototype:           prototype,
-		Properties:          make(map[string]PropertyDescriptor),
-		SymbolProperties:    make(map[*Symbol]PropertyDescriptor),
+		Properties:          NewPropertyStore(),
 		Extensible:          true,
 		PrivateElements:     make([]*PrivateElement, 0),
 		BoundTargetFunction: NewJavaScriptValue(TypeObject, targetFunction),
@@ -85,22 +83,10 @@ func (o *BoundFunction) SetPrototype(prototype ObjectInterface) {
 	o.Prototype = prototype
 }
 
-func (o *BoundFunction) GetProperties() map[string]PropertyDescriptor {
+func (o *BoundFunction) GetProperties() *PropertyStore {
 	return o.Properties
 }
 
-func (o *BoundFunction) SetProperties(properties map[string]PropertyDescriptor) {
-	o.Properties = properties
-}
-
-func (o *BoundFunction) GetSymbolProperties() map[*Symbol]PropertyDescriptor {
-	return o.SymbolProperties
-}
-
-func (o *BoundFunction) SetSymbolProperties(symbolProperties map[*Symbol]PropertyDescriptor) {
-	o.SymbolProperties = symbolProperties
-}
-
 func (o *BoundFunction) IsExtensible(runtime *Runtime) *Completion {
 	return NewNormalCompletion(NewBooleanValue(o.Extensible))
 }
@@ -0,0 +1,226 @@
+package runtime
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// CommonJSLoader loads CommonJS modules from a file system, such as an embed.FS of bundled code or an os.DirFS, with
+// the root of the file system as "/". Each realm has its own module cache, exposed to scripts as require.cache.
+type CommonJSLoader struct {
+	FS fs.FS
+
+	caches map[*Realm]*Object
+}
+
+func NewCommonJSLoader(fsys fs.FS) *CommonJSLoader {
+	return &CommonJSLoader{FS: fsys, caches: map[*Realm]*Object{}}
+}
+
+// Cache returns the module cache of the running realm, an object of module objects keyed by filename.
+func (l *CommonJSLoader) Cache(runtime *Runtime) *Object {
+	realm := runtime.GetRunningRealm()
+	cache, ok := l.caches[realm]
+	if !ok {
+		cache = OrdinaryObjectCreate(nil).(*Object)
+		l.caches[realm] = cache
+	}
+	return cache
+}
+
+// Require loads the module specifier resolves to from a module in dir, in the running realm, and returns its
+// module.exports. A module is only run the first time it is required: later calls return the exports it has, even if
+// it is still running because the modules require each other.
+func (l *CommonJSLoader) Require(runtime *Runtime, specifier string, dir string) *Completion {
+	filename, err := ResolveCommonJS(l.FS, specifier, dir)
+	if err != nil {
+		return NewThrowCompletion(newRequireErrorValue(runtime, err))
+	}
+
+	cache := l.Cache(runtime)
+	cacheVal := NewJavaScriptValue(TypeObject, cache)
+	filenameVal := NewStringValue(filename)
+
+	completion := cache.Get(runtime, filenameVal, cacheVal)
+	if completion.Type != Normal {
+		return completion
+	}
+	if cached := completion.Value.(*JavaScriptValue); cached.Type == TypeObject {
+		return cached.Value.(ObjectInterface).Get(runtime, NewStringValue("exports"), cached)
+	}
+
+	module := l.newModule(runtime, filename)
+	moduleVal := NewJavaScriptValue(TypeObject, module)
+	CreateDataProperty(runtime, cache, filenameVal, moduleVal)
+
+	completion = l.load(runtime, module, filename)
+	if completion.Type != Normal {
+		// A module that threw is loaded again the next time it is required.
+		cache.Delete(runtime, filenameVal)
+		return completion
+	}
+	CreateDataProperty(runtime, module, NewStringValue("loaded"), NewBooleanValue(true))
+
+	return module.Get(runtime, NewStringValue("exports"), moduleVal)
+}
+
+// ImportModule loads specifier from the root of the file system with Require, so the loader can be used as
+// Runtime.ImportModule, with module.exports as the namespace of the module.
+func (l *CommonJSLoader) ImportModule(runtime *Runtime, realm *Realm, specifier string) *Completion {
+	return l.Require(runtime, specifier, "/")
+}
+
+// NewRequireFunction creates the require function of code in dir, with require.resolve and require.cache.
+func (l *CommonJSLoader) NewRequireFunction(runtime *Runtime, dir string) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+
+	require := CreateBuiltinFunction(
+		runtime,
+		func(runtime *Runtime, function *FunctionObject, thisArg *JavaScriptValue, arguments []*JavaScriptValue, newTarget *JavaScriptValue) *Completion {
+			specifier, completion := requireSpecifier(runtime, arguments)
+			if completion != nil {
+				return completion
+			}
+			return l.Require(runtime, specifier, dir)
+		},
+		1,
+		NewStringValue("require"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+
+	// require.resolve(request)
+	DefineBuiltinFunction(runtime, require, "resolve", func(runtime *Runtime, function *FunctionObject, thisArg *JavaScriptValue, arguments []*JavaScriptValue, newTarget *JavaScriptValue) *Completion {
+		specifier, completion := requireSpecifier(runtime, arguments)
+		if completion != nil {
+			return completion
+		}
+
+		filename, err := ResolveCommonJS(l.FS, specifier, dir)
+		if err != nil {
+			return NewThrowCompletion(newRequireErrorValue(runtime, err))
+		}
+		return NewNormalCompletion(NewStringValue(filename))
+	}, 1)
+
+	// require.cache
+	require.DefineOwnProperty(runtime, NewStringValue("cache"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, l.Cache(runtime)),
+		Writable:     true,
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	return require
+}
+
+// newModule creates the module object of a file, with empty exports.
+func (l *CommonJSLoader) newModule(runtime *Runtime, filename string) *Object {
+	module := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype)).(*Object)
+	exports := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+
+	CreateDataProperty(runtime, module, NewStringValue("id"), NewStringValue(filename))
+	CreateDataProperty(runtime, module, NewStringValue("path"), NewStringValue(path.Dir(filename)))
+	CreateDataProperty(runtime, module, NewStringValue("exports"), NewJavaScriptValue(TypeObject, exports))
+	CreateDataProperty(runtime, module, NewStringValue("filename"), NewStringValue(filename))
+	CreateDataProperty(runtime, module, NewStringValue("loaded"), NewBooleanValue(false))
+	return module
+}
+
+// load runs a module's file: JSON files become its exports, and other files are run as scripts wrapped in a function
+// of exports, require, module, __filename and __dirname.
+func (l *CommonJSLoader) load(runtime *Runtime, module *Object, filename string) *Completion {
+	source, err := fs.ReadFile(l.FS, fsPath(filename))
+	if err != nil {
+		return NewThrowCompletion(NewNativeError(runtime, IntrinsicErrorConstructor, "Cannot read module '"+filename+"': "+err.Error()))
+	}
+
+	if strings.HasSuffix(filename, ".json") {
+		value, err := parseJSONText(runtime, string(source))
+		if err != nil {
+			return NewThrowCompletion(NewSyntaxError(runtime, filename+": "+err.Error()))
+		}
+		return CreateDataProperty(runtime, module, NewStringValue("exports"), value)
+	}
+
+	// The source starts on the first line of the wrapper, so line numbers stay the same. A hashbang line is kept out
+	// of the wrapper by turning it into a comment.
+	text := string(source)
+	if strings.HasPrefix(text, "#!") {
+		text = "//" + text
+	}
+	wrapper := "(function (exports, require, module, __filename, __dirname) {" + text + "\n})"
+
+	script, err := ParseScript(wrapper, runtime.GetRunningRealm())
+	if err != nil {
+		return NewThrowCompletion(NewSyntaxError(runtime, filename+": "+err.Error()))
+	}
+
+	completion := script.Evaluate(runtime)
+	if completion.Type != Normal {
+		return completion
+	}
+	wrapperFunction := completion.Value.(*JavaScriptValue)
+
+	moduleVal := NewJavaScriptValue(TypeObject, module)
+	completion = module.Get(runtime, NewStringValue("exports"), moduleVal)
+	if completion.Type != Normal {
+		return completion
+	}
+	exports := completion.Value.(*JavaScriptValue)
+
+	dir := path.Dir(filename)
+	require := NewJavaScriptValue(TypeObject, l.NewRequireFunction(runtime, dir))
+	CreateDataProperty(runtime, module, NewStringValue("require"), require)
+
+	return Call(runtime, wrapperFunction, exports, []*JavaScriptValue{
+		exports,
+		require,
+		moduleVal,
+		NewStringValue(filename),
+		NewStringValue(dir),
+	})
+}
+
+// DefineRequire defines the global require of realm, which loads modules relative to dir, for scripts that aren't
+// modules themselves, such as the file run by go-js run.
+func DefineRequire(runtime *Runtime, realm *Realm, loader *CommonJSLoader, dir string) {
+	runtime.PushExecutionContext(&ExecutionContext{Realm: realm})
+	defer runtime.PopExecutionContext()
+
+	globalObject := realm.GlobalObject
+
+	// "require" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("require"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, loader.NewRequireFunction(runtime, dir)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+}
+
+// requireSpecifier returns the specifier passed to require, which must be a string.
+func requireSpecifier(runtime *Runtime, arguments []*JavaScriptValue) (string, *Completion) {
+	if len(arguments) == 0 || arguments[0].Type != TypeString {
+		return "", NewThrowCompletion(NewTypeError(runtime, "The \"id\" argument must be of type string"))
+	}
+	return arguments[0].Value.(*String).Value, nil
+}
+
+// newRequireErrorValue converts an error resolving a module to an Error, with the code of a *RequireError.
+func newRequireErrorValue(runtime *Runtime, err error) *JavaScriptValue {
+	errorVal := NewNativeError(runtime, IntrinsicErrorConstructor, err.Error())
+
+	var requireErr *RequireError
+	if errors.As(err, &requireErr) {
+		errorVal.Value.(*Object).DefineOwnProperty(runtime, NewStringValue("code"), &DataPropertyDescriptor{
+			Value:        NewStringValue(requireErr.Code),
+			Writable:     true,
+			Enumerable:   true,
+			Configurable: true,
+		})
+	}
+	return errorVal
+}
@@ -0,0 +1,413 @@
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// The conditions the "exports" of a package are matched against, besides "default".
+var commonJSConditions = []string{"require", "node"}
+
+// RequireError is why a module couldn't be resolved, with the code Node gives the error, such as MODULE_NOT_FOUND.
+type RequireError struct {
+	Code    string
+	Message string
+}
+
+func (e *RequireError) Error() string {
+	return e.Message
+}
+
+func newModuleNotFoundError(specifier string, dir string) *RequireError {
+	return &RequireError{
+		Code:    "MODULE_NOT_FOUND",
+		Message: "Cannot find module '" + specifier + "' from '" + dir + "'",
+	}
+}
+
+// ResolveCommonJS resolves specifier to the path of a module, the way Node's require.resolve does from a module in
+// dir. Paths are absolute, with the root of fsys as "/". Relative specifiers are files or directories, and bare
+// specifiers are packages in the node_modules directories of dir and its parents, whose package.json "exports" or
+// "main" pick the file.
+func ResolveCommonJS(fsys fs.FS, specifier string, dir string) (string, error) {
+	switch {
+	case specifier == "":
+		return "", &RequireError{Code: "ERR_INVALID_ARG_VALUE", Message: "The argument 'id' must be a non-empty string"}
+	case strings.HasPrefix(specifier, "node:"):
+		return "", &RequireError{Code: "ERR_UNKNOWN_BUILTIN_MODULE", Message: "No such built-in module: " + specifier}
+	case strings.HasPrefix(specifier, "/"), specifier == ".", specifier == "..",
+		strings.HasPrefix(specifier, "./"), strings.HasPrefix(specifier, "../"):
+		target := path.Join(dir, specifier)
+		if strings.HasPrefix(specifier, "/") {
+			target = path.Clean(specifier)
+		}
+
+		if file, ok := loadAsFile(fsys, target); ok {
+			return file, nil
+		}
+		if file, ok, err := loadAsDirectory(fsys, target); ok || err != nil {
+			return file, err
+		}
+		return "", newModuleNotFoundError(specifier, dir)
+	}
+
+	file, ok, err := loadNodeModules(fsys, specifier, dir)
+	if ok || err != nil {
+		return file, err
+	}
+	return "", newModuleNotFoundError(specifier, dir)
+}
+
+// loadAsFile finds the file a path names, trying the .js and .json extensions if it doesn't exist.
+func loadAsFile(fsys fs.FS, file string) (string, bool) {
+	for _, candidate := range []string{file, file + ".js", file + ".json"} {
+		if isFile(fsys, candidate) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// loadIndex finds the index file of a directory.
+func loadIndex(fsys fs.FS, dir string) (string, bool) {
+	for _, candidate := range []string{path.Join(dir, "index.js"), path.Join(dir, "index.json")} {
+		if isFile(fsys, candidate) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// loadAsDirectory finds the file a directory stands for: the "main" of its package.json, or its index file.
+func loadAsDirectory(fsys fs.FS, dir string) (string, bool, error) {
+	pkg, err := readPackageJSON(fsys, dir)
+	if err != nil {
+		return "", false, err
+	}
+
+	if pkg != nil {
+		if mainValue, ok := pkg.Get("main"); ok {
+			if main, ok := mainValue.(string); ok && main != "" {
+				target := path.Join(dir, main)
+				if file, ok := loadAsFile(fsys, target); ok {
+					return file, true, nil
+				}
+				if file, ok := loadIndex(fsys, target); ok {
+					return file, true, nil
+				}
+			}
+		}
+	}
+
+	file, ok := loadIndex(fsys, dir)
+	return file, ok, nil
+}
+
+// loadNodeModules finds a package in the node_modules directories of dir and its parents.
+func loadNodeModules(fsys fs.FS, specifier string, dir string) (string, bool, error) {
+	name, subpath := splitPackageSpecifier(specifier)
+	if name == "" {
+		return "", false, &RequireError{Code: "ERR_INVALID_MODULE_SPECIFIER", Message: "Invalid module specifier '" + specifier + "'"}
+	}
+
+	for current := path.Clean(dir); ; current = path.Dir(current) {
+		if path.Base(current) != "node_modules" {
+			modules := path.Join(current, "node_modules")
+
+			if file, ok, err := loadPackageExports(fsys, path.Join(modules, name), subpath); ok || err != nil {
+				return file, ok, err
+			}
+
+			target := path.Join(modules, specifier)
+			if file, ok := loadAsFile(fsys, target); ok {
+				return file, true, nil
+			}
+			if file, ok, err := loadAsDirectory(fsys, target); ok || err != nil {
+				return file, ok, err
+			}
+		}
+
+		if current == "/" {
+			return "", false, nil
+		}
+	}
+}
+
+// splitPackageSpecifier splits a bare specifier into the package name, which is scoped if it starts with "@", and the
+// subpath within the package, such as "./lib/util" or ".".
+func splitPackageSpecifier(specifier string) (string, string) {
+	parts := strings.SplitN(specifier, "/", 3)
+	count := 1
+	if strings.HasPrefix(specifier, "@") {
+		if len(parts) < 2 || parts[1] == "" {
+			return "", ""
+		}
+		count = 2
+	}
+
+	name := strings.Join(parts[:min(count, len(parts))], "/")
+	subpath := "." + strings.TrimPrefix(specifier, name)
+	return name, subpath
+}
+
+// loadPackageExports resolves a subpath of the package in dir through the "exports" of its package.json. It reports
+// false without an error if the package has no "exports".
+func loadPackageExports(fsys fs.FS, dir string, subpath string) (string, bool, error) {
+	pkg, err := readPackageJSON(fsys, dir)
+	if err != nil || pkg == nil {
+		return "", false, err
+	}
+
+	exports, ok := pkg.Get("exports")
+	if !ok || exports == nil {
+		return "", false, nil
+	}
+
+	notExported := &RequireError{
+		Code:    "ERR_PACKAGE_PATH_NOT_EXPORTED",
+		Message: "Package subpath '" + subpath + "' is not defined by \"exports\" in " + path.Join(dir, "package.json"),
+	}
+	if subpath == "." {
+		notExported.Message = "No \"exports\" main defined in " + path.Join(dir, "package.json")
+	}
+
+	target, err := resolvePackageExports(dir, subpath, exports)
+	if err != nil {
+		return "", false, err
+	}
+	if target == "" {
+		return "", false, notExported
+	}
+
+	if !isFile(fsys, target) {
+		return "", false, newModuleNotFoundError(target, dir)
+	}
+	return target, true, nil
+}
+
+// resolvePackageExports follows PACKAGE_EXPORTS_RESOLVE, returning the path subpath is exported as, or an empty
+// string if it isn't exported.
+func resolvePackageExports(dir string, subpath string, exports any) (string, error) {
+	object, isObject := exports.(*orderedJSONObject)
+
+	// An object either maps subpaths, whose keys start with ".", or conditions, whose keys don't, but not both.
+	hasSubpathKeys := false
+	if isObject {
+		for idx, key := range object.Keys {
+			isSubpath := strings.HasPrefix(key, ".")
+			if idx > 0 && isSubpath != hasSubpathKeys {
+				return "", &RequireError{
+					Code:    "ERR_INVALID_PACKAGE_CONFIG",
+					Message: "\"exports\" cannot contain some keys starting with '.' and some not in " + path.Join(dir, "package.json"),
+				}
+			}
+			hasSubpathKeys = isSubpath
+		}
+	}
+
+	if subpath == "." {
+		mainExport := exports
+		if hasSubpathKeys {
+			mainExport, _ = object.Get(".")
+		}
+		return resolvePackageTarget(dir, mainExport, "")
+	}
+
+	if !hasSubpathKeys {
+		return "", nil
+	}
+
+	if target, ok := object.Get(subpath); ok && !strings.Contains(subpath, "*") {
+		return resolvePackageTarget(dir, target, "")
+	}
+
+	// The pattern with the longest prefix before its "*" that matches wins.
+	bestKey := ""
+	bestMatch := ""
+	for _, key := range object.Keys {
+		prefix, suffix, ok := strings.Cut(key, "*")
+		if !ok || strings.Contains(suffix, "*") {
+			continue
+		}
+
+		if strings.HasPrefix(subpath, prefix) && subpath != prefix && strings.HasSuffix(subpath, suffix) &&
+			len(subpath) >= len(key) && comparePatternKeys(bestKey, key) > 0 {
+			bestKey = key
+			bestMatch = subpath[len(prefix) : len(subpath)-len(suffix)]
+		}
+	}
+
+	if bestKey == "" {
+		return "", nil
+	}
+
+	target, _ := object.Get(bestKey)
+	return resolvePackageTarget(dir, target, bestMatch)
+}
+
+// comparePatternKeys orders "exports" patterns from most to least specific, returning a positive number if b is more
+// specific than a.
+func comparePatternKeys(a string, b string) int {
+	if a == "" {
+		return 1
+	}
+
+	baseA := strings.Index(a, "*") + 1
+	baseB := strings.Index(b, "*") + 1
+	if baseA != baseB {
+		return baseB - baseA
+	}
+	return len(b) - len(a)
+}
+
+// resolvePackageTarget follows PACKAGE_TARGET_RESOLVE for a target of "exports", which is a path, an array of
+// fallbacks or an object of conditions. It returns an empty string if the target excludes the subpath.
+func resolvePackageTarget(dir string, target any, patternMatch string) (string, error) {
+	switch target := target.(type) {
+	case string:
+		invalid := &RequireError{
+			Code:    "ERR_INVALID_PACKAGE_TARGET",
+			Message: "Invalid \"exports\" target \"" + target + "\" defined in " + path.Join(dir, "package.json"),
+		}
+		if !strings.HasPrefix(target, "./") {
+			return "", invalid
+		}
+
+		resolved := path.Join(dir, strings.ReplaceAll(target, "*", patternMatch))
+		if resolved != dir && !strings.HasPrefix(resolved, dir+"/") {
+			return "", invalid
+		}
+		return resolved, nil
+	case []any:
+		var lastErr error
+		for _, fallback := range target {
+			resolved, err := resolvePackageTarget(dir, fallback, patternMatch)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if resolved != "" {
+				return resolved, nil
+			}
+		}
+		return "", lastErr
+	case *orderedJSONObject:
+		for _, key := range target.Keys {
+			if key != "default" && !slices.Contains(commonJSConditions, key) {
+				continue
+			}
+
+			value, _ := target.Get(key)
+			resolved, err := resolvePackageTarget(dir, value, patternMatch)
+			if err != nil || resolved != "" {
+				return resolved, err
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// readPackageJSON reads the package.json of a directory, or returns nil if it has none.
+func readPackageJSON(fsys fs.FS, dir string) (*orderedJSONObject, error) {
+	file := path.Join(dir, "package.json")
+	data, err := fs.ReadFile(fsys, fsPath(file))
+	if err != nil {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	value, err := decodeOrderedJSON(decoder)
+	if err == nil {
+		if _, trailingErr := decoder.Token(); trailingErr != io.EOF {
+			err = errors.New("unexpected data after the top-level value")
+		}
+	}
+	if err != nil {
+		return nil, &RequireError{Code: "ERR_INVALID_PACKAGE_CONFIG", Message: "Invalid package config " + file + ": " + err.Error()}
+	}
+
+	object, ok := value.(*orderedJSONObject)
+	if !ok {
+		return nil, &RequireError{Code: "ERR_INVALID_PACKAGE_CONFIG", Message: "Invalid package config " + file}
+	}
+	return object, nil
+}
+
+// orderedJSONObject is a JSON object that keeps the order of its keys, which matters for conditional exports.
+type orderedJSONObject struct {
+	Keys   []string
+	Values map[string]any
+}
+
+func (o *orderedJSONObject) Get(key string) (any, bool) {
+	value, ok := o.Values[key]
+	return value, ok
+}
+
+// decodeOrderedJSON decodes the next JSON value, with objects as *orderedJSONObject and arrays as []any.
+func decodeOrderedJSON(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	if delim == '[' {
+		array := []any{}
+		for decoder.More() {
+			value, err := decodeOrderedJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err := decoder.Token()
+		return array, err
+	}
+
+	object := &orderedJSONObject{Values: map[string]any{}}
+	for decoder.More() {
+		keyToken, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := decodeOrderedJSON(decoder)
+		if err != nil {
+			return nil, err
+		}
+
+		key := keyToken.(string)
+		if _, exists := object.Values[key]; !exists {
+			object.Keys = append(object.Keys, key)
+		}
+		object.Values[key] = value
+	}
+	_, err = decoder.Token()
+	return object, err
+}
+
+// isFile reports whether a path names a file rather than a directory.
+func isFile(fsys fs.FS, file string) bool {
+	info, err := fs.Stat(fsys, fsPath(file))
+	return err == nil && !info.IsDir()
+}
+
+// fsPath converts an absolute module path to the path of the file in an fs.FS, which has no leading slash.
+func fsPath(file string) string {
+	file = strings.TrimPrefix(path.Clean(file), "/")
+	if file == "" {
+		return "."
+	}
+	return file
+}
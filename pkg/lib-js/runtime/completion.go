@@ -8,6 +8,9 @@ const (
 	Continue
 	Return
 	Throw
+	// Terminate stops the running script when the host interrupts it. Unlike Throw, it can't be caught
+	// and finally blocks don't run, its value is the *InterruptedError describing why execution stopped.
+	Terminate
 )
 
 type Completion struct {
@@ -33,6 +36,13 @@ func NewThrowCompletion(value any) *Completion {
 	}
 }
 
+func NewTerminateCompletion(value *InterruptedError) *Completion {
+	return &Completion{
+		Type:  Terminate,
+		Value: value,
+	}
+}
+
 func NewUnusedCompletion() *Completion {
 	return &Completion{
 		Type:   Normal,
@@ -2,9 +2,94 @@ package runtime
 
 import (
 	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 )
 
+// ConsoleLevel is the severity of a console message.
+type ConsoleLevel int
+
+const (
+	ConsoleLevelDebug ConsoleLevel = iota
+	ConsoleLevelLog
+	ConsoleLevelInfo
+	ConsoleLevelWarn
+	ConsoleLevelError
+)
+
+func (l ConsoleLevel) String() string {
+	switch l {
+	case ConsoleLevelDebug:
+		return "debug"
+	case ConsoleLevelLog:
+		return "log"
+	case ConsoleLevelInfo:
+		return "info"
+	case ConsoleLevelWarn:
+		return "warn"
+	case ConsoleLevelError:
+		return "error"
+	}
+	return "unknown"
+}
+
+// ConsoleSink receives the output of the console namespace. Each call is one message, already formatted and indented
+// for the current group, without a trailing newline. Messages may span several lines, e.g. those of console.table.
+type ConsoleSink interface {
+	Print(level ConsoleLevel, message string)
+}
+
+// ConsoleSinkFunc adapts a function to the ConsoleSink interface.
+type ConsoleSinkFunc func(level ConsoleLevel, message string)
+
+func (f ConsoleSinkFunc) Print(level ConsoleLevel, message string) {
+	f(level, message)
+}
+
+// WriterConsoleSink writes warnings and errors to Stderr and everything else to Stdout, one line per message.
+type WriterConsoleSink struct {
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// NewStdioConsoleSink returns the default sink of new runtimes, which writes to os.Stdout and os.Stderr.
+func NewStdioConsoleSink() *WriterConsoleSink {
+	return &WriterConsoleSink{Stdout: os.Stdout, Stderr: os.Stderr}
+}
+
+func (s *WriterConsoleSink) Print(level ConsoleLevel, message string) {
+	writer := s.Stdout
+	if level >= ConsoleLevelWarn {
+		writer = s.Stderr
+	}
+	fmt.Fprintln(writer, message)
+}
+
+// consoleState is what the console namespace remembers between calls: the group depth and the count and timer maps.
+type consoleState struct {
+	groupDepth int
+	counts     map[string]int
+	timers     map[string]time.Time
+}
+
+func (r *Runtime) getConsoleState() *consoleState {
+	if r.consoleState == nil {
+		r.consoleState = &consoleState{
+			counts: make(map[string]int),
+			timers: make(map[string]time.Time),
+		}
+	}
+	return r.consoleState
+}
+
+// The label of the counting and timing functions when none is given.
+const defaultConsoleLabel = "default"
+
 func NewConsoleObject(runtime *Runtime) ObjectInterface {
 	realm := runtime.GetRunningRealm()
 	proto := OrdinaryObjectCreate(realm.GetIntrinsic(IntrinsicObjectPrototype))
@@ -18,55 +103,736 @@ func NewConsoleObject(runtime *Runtime) ObjectInterface {
 		Configurable: false,
 	})
 
-	// console.log
+	// Logging functions.
+	DefineBuiltinFunction(runtime, console, "assert", ConsoleAssert, 0)
+	DefineBuiltinFunction(runtime, console, "debug", ConsoleDebug, 0)
+	DefineBuiltinFunction(runtime, console, "error", ConsoleError, 0)
+	DefineBuiltinFunction(runtime, console, "info", ConsoleInfo, 0)
 	DefineBuiltinFunction(runtime, console, "log", ConsoleLog, 0)
+	DefineBuiltinFunction(runtime, console, "table", ConsoleTable, 0)
+	DefineBuiltinFunction(runtime, console, "trace", ConsoleTrace, 0)
+	DefineBuiltinFunction(runtime, console, "warn", ConsoleWarn, 0)
+	DefineBuiltinFunction(runtime, console, "dir", ConsoleDir, 0)
+
+	// Counting functions.
+	DefineBuiltinFunction(runtime, console, "count", ConsoleCount, 0)
+	DefineBuiltinFunction(runtime, console, "countReset", ConsoleCountReset, 0)
 
-	// TODO: Define properties.
+	// Grouping functions.
+	DefineBuiltinFunction(runtime, console, "group", ConsoleGroup, 0)
+	DefineBuiltinFunction(runtime, console, "groupCollapsed", ConsoleGroup, 0)
+	DefineBuiltinFunction(runtime, console, "groupEnd", ConsoleGroupEnd, 0)
+
+	// Timing functions.
+	DefineBuiltinFunction(runtime, console, "time", ConsoleTime, 0)
+	DefineBuiltinFunction(runtime, console, "timeLog", ConsoleTimeLog, 0)
+	DefineBuiltinFunction(runtime, console, "timeEnd", ConsoleTimeEnd, 0)
 
 	return console
 }
 
-func ConsoleLog(
+// Logger(logLevel, args)
+func ConsoleLogger(runtime *Runtime, level ConsoleLevel, arguments []*JavaScriptValue) *Completion {
+	if len(arguments) > 1 {
+		completion := ConsoleFormatter(runtime, arguments)
+		if completion.Type != Normal {
+			return completion
+		}
+		arguments = completion.Value.([]*JavaScriptValue)
+	}
+
+	return ConsolePrinter(runtime, level, arguments)
+}
+
+// Formatter(args) substitutes the format specifiers of the first argument, if it is a string, with the arguments that
+// follow it. It returns the substituted string followed by the arguments that weren't used.
+func ConsoleFormatter(runtime *Runtime, arguments []*JavaScriptValue) *Completion {
+	if len(arguments) < 2 || arguments[0].Type != TypeString {
+		return NewNormalCompletion(arguments)
+	}
+
+	target := arguments[0].Value.(*String).Value
+	rest := arguments[1:]
+
+	var builder strings.Builder
+	for idx := 0; idx < len(target); idx++ {
+		if target[idx] != '%' || idx+1 >= len(target) {
+			builder.WriteByte(target[idx])
+			continue
+		}
+
+		specifier := target[idx+1]
+		if specifier == '%' {
+			builder.WriteByte('%')
+			idx++
+			continue
+		}
+
+		if len(rest) == 0 || !strings.ContainsRune("sdifoOc", rune(specifier)) {
+			builder.WriteByte(target[idx])
+			continue
+		}
+
+		current := rest[0]
+		rest = rest[1:]
+		idx++
+
+		var converted string
+		switch specifier {
+		case 's':
+			completion := consoleFormatString(runtime, current)
+			if completion.Type != Normal {
+				return completion
+			}
+			converted = completion.Value.(string)
+		case 'd', 'i':
+			completion := consoleFormatInteger(runtime, current)
+			if completion.Type != Normal {
+				return completion
+			}
+			converted = completion.Value.(string)
+		case 'f':
+			completion := consoleFormatFloat(runtime, current)
+			if completion.Type != Normal {
+				return completion
+			}
+			converted = completion.Value.(string)
+		case 'o':
+			// Like Node, %o shows more of the object than %O.
+			options := DefaultInspectOptions()
+			options.Depth = 4
+			converted = Inspect(runtime, current, options)
+		case 'O':
+			converted = Inspect(runtime, current, nil)
+		case 'c':
+			// CSS styling doesn't apply to this console, the argument is consumed and ignored.
+		}
+
+		builder.WriteString(converted)
+	}
+
+	return NewNormalCompletion(append([]*JavaScriptValue{NewStringValue(builder.String())}, rest...))
+}
+
+// Printer(logLevel, args) writes the arguments separated by spaces to the runtime's console sink, indented for the
+// current group. Strings are printed as they are and other values are described with Inspect.
+func ConsolePrinter(runtime *Runtime, level ConsoleLevel, arguments []*JavaScriptValue) *Completion {
+	messages := make([]string, 0, len(arguments))
+	for _, argument := range arguments {
+		if argument.Type == TypeString {
+			messages = append(messages, argument.Value.(*String).Value)
+			continue
+		}
+		messages = append(messages, Inspect(runtime, argument, nil))
+	}
+
+	consolePrint(runtime, level, strings.Join(messages, " "))
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+func consolePrint(runtime *Runtime, level ConsoleLevel, message string) {
+	if runtime.Console == nil {
+		return
+	}
+
+	if depth := runtime.getConsoleState().groupDepth; depth > 0 {
+		indent := strings.Repeat("  ", depth)
+		message = indent + strings.ReplaceAll(message, "\n", "\n"+indent)
+	}
+
+	runtime.Console.Print(level, message)
+}
+
+// consoleFormatString converts a value for %s and for printing: strings are printed as they are, and other values
+// with ToString, except for Symbols and BigInts which are described.
+func consoleFormatString(runtime *Runtime, value *JavaScriptValue) *Completion {
+	switch value.Type {
+	case TypeSymbol:
+		return NewNormalCompletion(SymbolDescriptiveString(value.Value.(*Symbol)).Value.(*String).Value)
+	case TypeBigInt:
+		return NewNormalCompletion(value.Value.(*BigInt).Value.String() + "n")
+	}
+
+	completion := ToString(runtime, value)
+	if completion.Type != Normal {
+		return completion
+	}
+	return NewNormalCompletion(completion.Value.(*JavaScriptValue).Value.(*String).Value)
+}
+
+// consoleFormatInteger converts a value for %d and %i, like %parseInt%(current, 10).
+func consoleFormatInteger(runtime *Runtime, value *JavaScriptValue) *Completion {
+	switch value.Type {
+	case TypeSymbol:
+		return NewNormalCompletion("NaN")
+	case TypeBigInt:
+		return NewNormalCompletion(value.Value.(*BigInt).Value.String() + "n")
+	}
+
+	completion := ToString(runtime, value)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	str := strings.TrimLeft(completion.Value.(*JavaScriptValue).Value.(*String).Value, " \t\n\r\v\f")
+
+	end := 0
+	if end < len(str) && (str[end] == '+' || str[end] == '-') {
+		end++
+	}
+
+	digitsStart := end
+	for end < len(str) && str[end] >= '0' && str[end] <= '9' {
+		end++
+	}
+
+	if end == digitsStart {
+		return NewNormalCompletion("NaN")
+	}
+
+	number, _ := strconv.ParseFloat(str[:end], 64)
+	return NewNormalCompletion(NumberToString(&Number{Value: number}, 10).Value.(*String).Value)
+}
+
+// consoleFormatFloat converts a value for %f, like %parseFloat%(current).
+func consoleFormatFloat(runtime *Runtime, value *JavaScriptValue) *Completion {
+	if value.Type == TypeSymbol {
+		return NewNormalCompletion("NaN")
+	}
+
+	completion := ToString(runtime, value)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	str := strings.TrimLeft(completion.Value.(*JavaScriptValue).Value.(*String).Value, " \t\n\r\v\f")
+
+	// Find the longest prefix that is a decimal literal.
+	for end := len(str); end > 0; end-- {
+		prefix := str[:end]
+		unsigned := strings.TrimLeft(prefix, "+-")
+		if len(prefix)-len(unsigned) > 1 {
+			break
+		}
+
+		if unsigned == "Infinity" {
+			if prefix[0] == '-' {
+				return NewNormalCompletion("-Infinity")
+			}
+			return NewNormalCompletion("Infinity")
+		}
+
+		if unsigned == "" || !strings.ContainsAny(unsigned[:1], "0123456789.") || strings.ContainsAny(unsigned, "xXpP_") {
+			continue
+		}
+
+		if number, err := strconv.ParseFloat(prefix, 64); err == nil {
+			return NewNormalCompletion(NumberToString(&Number{Value: number}, 10).Value.(*String).Value)
+		}
+	}
+
+	return NewNormalCompletion("NaN")
+}
+
+// consoleLabel returns the label argument of the counting and timing functions, which defaults to "default".
+func consoleLabel(runtime *Runtime, arguments []*JavaScriptValue) *Completion {
+	if len(arguments) == 0 || arguments[0].Type == TypeUndefined {
+		return NewNormalCompletion(defaultConsoleLabel)
+	}
+
+	completion := ToString(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+	return NewNormalCompletion(completion.Value.(*JavaScriptValue).Value.(*String).Value)
+}
+
+// console.assert
+func ConsoleAssert(
 	runtime *Runtime,
 	function *FunctionObject,
 	thisArg *JavaScriptValue,
 	arguments []*JavaScriptValue,
 	newTarget *JavaScriptValue,
 ) *Completion {
-	if len(arguments) < 1 {
-		fmt.Println()
+	condition := NewUndefinedValue()
+	if len(arguments) > 0 {
+		condition = arguments[0]
+	}
+
+	if ToBoolean(condition).Value.(*JavaScriptValue).Value.(*Boolean).Value {
 		return NewNormalCompletion(NewUndefinedValue())
 	}
 
-	messageVal := arguments[0]
-	completion := ToString(runtime, messageVal)
-	if completion.Type != Normal {
-		return completion
+	message := "Assertion failed"
+
+	data := slices.Clone(arguments[min(1, len(arguments)):])
+	if len(data) == 0 {
+		data = append(data, NewStringValue(message))
+	} else if data[0].Type != TypeString {
+		data = append([]*JavaScriptValue{NewStringValue(message)}, data...)
+	} else {
+		data[0] = NewStringValue(message + ": " + data[0].Value.(*String).Value)
 	}
 
-	message := completion.Value.(*JavaScriptValue).Value.(*String).Value
+	return ConsoleLogger(runtime, ConsoleLevelError, data)
+}
+
+// console.debug
+func ConsoleDebug(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return ConsoleLogger(runtime, ConsoleLevelDebug, arguments)
+}
+
+// console.error
+func ConsoleError(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return ConsoleLogger(runtime, ConsoleLevelError, arguments)
+}
+
+// console.info
+func ConsoleInfo(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return ConsoleLogger(runtime, ConsoleLevelInfo, arguments)
+}
 
-	// Check if % is in the message.
-	if strings.Contains(message, "%") {
-		// TODO: Implement % formatting.
-		panic("TODO: Implement % formatting.")
+// console.log
+func ConsoleLog(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return ConsoleLogger(runtime, ConsoleLevelLog, arguments)
+}
+
+// console.warn
+func ConsoleWarn(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return ConsoleLogger(runtime, ConsoleLevelWarn, arguments)
+}
+
+// console.trace
+func ConsoleTrace(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	message := "Trace"
+	if len(arguments) > 0 {
+		completion := ConsoleFormatter(runtime, arguments)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		messages := []string{}
+		for _, argument := range completion.Value.([]*JavaScriptValue) {
+			completion := consoleFormatString(runtime, argument)
+			if completion.Type != Normal {
+				return completion
+			}
+			messages = append(messages, completion.Value.(string))
+		}
+		message += ": " + strings.Join(messages, " ")
 	}
 
-	messages := []string{message}
+	// The running context is console.trace itself.
+	message += runtime.StackTrace()
 
-	if len(arguments) > 1 {
-		for i := 1; i < len(arguments); i++ {
-			argument := arguments[i]
-			completion := ToString(runtime, argument)
+	consolePrint(runtime, ConsoleLevelDebug, message)
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// Table cells describe nested objects briefly, on a single line.
+var consoleTableCellOptions = &InspectOptions{Depth: 0, MaxArrayLength: 3}
+
+// console.table
+func ConsoleTable(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 || arguments[0].Type != TypeObject {
+		return ConsoleLogger(runtime, ConsoleLevelLog, arguments)
+	}
+
+	// Only show the given columns, if any.
+	var filter []string
+	if len(arguments) > 1 && arguments[1].Type == TypeObject {
+		_, values, completion := consoleEntries(runtime, arguments[1].Value.(ObjectInterface))
+		if completion != nil {
+			return completion
+		}
+
+		filter = []string{}
+		for _, value := range values {
+			completion := ToString(runtime, value)
 			if completion.Type != Normal {
 				return completion
 			}
+			filter = append(filter, completion.Value.(*JavaScriptValue).Value.(*String).Value)
+		}
+	}
+
+	indices, values, completion := consoleEntries(runtime, arguments[0].Value.(ObjectInterface))
+	if completion != nil {
+		return completion
+	}
+
+	columns := slices.Clone(filter)
+	hasValues := false
+	rows := make([]map[string]string, len(values))
+
+	for idx, value := range values {
+		rows[idx] = map[string]string{"(index)": indices[idx]}
+
+		// Primitives and functions go in the Values column, objects are split into a column per property.
+		if value.Type != TypeObject || IsCallable(value) {
+			rows[idx]["Values"] = Inspect(runtime, value, consoleTableCellOptions)
+			hasValues = true
+			continue
+		}
+
+		names, properties, completion := consoleEntries(runtime, value.Value.(ObjectInterface))
+		if completion != nil {
+			return completion
+		}
+
+		for propertyIdx, name := range names {
+			if filter != nil && !slices.Contains(filter, name) {
+				continue
+			}
+			if !slices.Contains(columns, name) {
+				columns = append(columns, name)
+			}
+
+			rows[idx][name] = Inspect(runtime, properties[propertyIdx], consoleTableCellOptions)
+		}
+	}
+
+	header := append([]string{"(index)"}, columns...)
+	if hasValues {
+		header = append(header, "Values")
+	}
+
+	table := make([][]string, len(rows))
+	for idx, row := range rows {
+		table[idx] = make([]string, len(header))
+		for column, name := range header {
+			table[idx][column] = row[name]
+		}
+	}
+
+	consolePrint(runtime, ConsoleLevelLog, renderConsoleTable(header, table))
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// consoleEntries returns the keys and values of the enumerable own string-keyed properties of an object.
+func consoleEntries(runtime *Runtime, object ObjectInterface) ([]string, []*JavaScriptValue, *Completion) {
+	completion := EnumerableOwnProperties(runtime, object, EnumerableOwnPropertiesKindKey)
+	if completion.Type != Normal {
+		return nil, nil, completion
+	}
+
+	keys := completion.Value.([]*JavaScriptValue)
+	names := make([]string, len(keys))
+	values := make([]*JavaScriptValue, len(keys))
+
+	for idx, key := range keys {
+		completion := object.Get(runtime, key, NewJavaScriptValue(TypeObject, object))
+		if completion.Type != Normal {
+			return nil, nil, completion
+		}
+
+		names[idx] = key.Value.(*String).Value
+		values[idx] = completion.Value.(*JavaScriptValue)
+	}
+
+	return names, values, nil
+}
+
+func renderConsoleTable(header []string, rows [][]string) string {
+	widths := make([]int, len(header))
+	for idx, name := range header {
+		widths[idx] = len([]rune(name)) + 2
+	}
+	for _, row := range rows {
+		for idx, cell := range row {
+			widths[idx] = max(widths[idx], len([]rune(cell))+2)
+		}
+	}
+
+	border := func(left, middle, right string) string {
+		parts := make([]string, len(widths))
+		for idx, width := range widths {
+			parts[idx] = strings.Repeat("─", width)
+		}
+		return left + strings.Join(parts, middle) + right
+	}
+
+	line := func(cells []string) string {
+		parts := make([]string, len(widths))
+		for idx, width := range widths {
+			parts[idx] = " " + cells[idx] + strings.Repeat(" ", width-len([]rune(cells[idx]))-1)
+		}
+		return "│" + strings.Join(parts, "│") + "│"
+	}
+
+	lines := []string{border("┌", "┬", "┐"), line(header), border("├", "┼", "┤")}
+	for _, row := range rows {
+		lines = append(lines, line(row))
+	}
+	lines = append(lines, border("└", "┴", "┘"))
+
+	return strings.Join(lines, "\n")
+}
+
+// console.dir
+func ConsoleDir(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	item := NewUndefinedValue()
+	if len(arguments) > 0 {
+		item = arguments[0]
+	}
+
+	options := DefaultInspectOptions()
+	if len(arguments) > 1 && arguments[1].Type == TypeObject {
+		if completion := consoleDirOptions(runtime, arguments[1], options); completion != nil {
+			return completion
+		}
+	}
+
+	consolePrint(runtime, ConsoleLevelLog, Inspect(runtime, item, options))
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// consoleDirOptions reads the depth, colors and showHidden options of console.dir into options, the way Node's
+// util.inspect does. A depth of null or Infinity describes every level.
+func consoleDirOptions(runtime *Runtime, optionsObject *JavaScriptValue, options *InspectOptions) *Completion {
+	get := func(name string) (*JavaScriptValue, *Completion) {
+		completion := optionsObject.Value.(ObjectInterface).Get(runtime, NewStringValue(name), optionsObject)
+		if completion.Type != Normal {
+			return nil, completion
+		}
+		return completion.Value.(*JavaScriptValue), nil
+	}
+
+	depth, completion := get("depth")
+	if completion != nil {
+		return completion
+	}
+	switch depth.Type {
+	case TypeUndefined:
+	case TypeNull:
+		options.Depth = -1
+	default:
+		completion := ToIntegerOrInfinity(runtime, depth)
+		if completion.Type != Normal {
+			return completion
+		}
+		number := completion.Value.(*JavaScriptValue).Value.(*Number).Value
+		if math.IsInf(number, 1) || number > math.MaxInt32 {
+			options.Depth = -1
+		} else {
+			options.Depth = int(max(number, 0))
+		}
+	}
+
+	for _, option := range []struct {
+		name  string
+		value *bool
+	}{{"colors", &options.Colors}, {"showHidden", &options.ShowHidden}} {
+		value, completion := get(option.name)
+		if completion != nil {
+			return completion
+		}
+		if value.Type != TypeUndefined {
+			*option.value = ToBoolean(value).Value.(*JavaScriptValue).Value.(*Boolean).Value
+		}
+	}
+	return nil
+}
+
+// console.count
+func ConsoleCount(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	completion := consoleLabel(runtime, arguments)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	label := completion.Value.(string)
+	state := runtime.getConsoleState()
+	state.counts[label]++
+
+	consolePrint(runtime, ConsoleLevelInfo, fmt.Sprintf("%s: %d", label, state.counts[label]))
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// console.countReset
+func ConsoleCountReset(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	completion := consoleLabel(runtime, arguments)
+	if completion.Type != Normal {
+		return completion
+	}
 
-			argumentStr := completion.Value.(*JavaScriptValue).Value.(*String).Value
-			messages = append(messages, argumentStr)
+	label := completion.Value.(string)
+	state := runtime.getConsoleState()
+	if _, ok := state.counts[label]; !ok {
+		consolePrint(runtime, ConsoleLevelWarn, fmt.Sprintf("Count for '%s' does not exist", label))
+	} else {
+		state.counts[label] = 0
+	}
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// console.group and console.groupCollapsed
+func ConsoleGroup(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) > 0 {
+		completion := ConsoleLogger(runtime, ConsoleLevelLog, arguments)
+		if completion.Type != Normal {
+			return completion
 		}
 	}
 
-	fmt.Println(strings.Join(messages, " "))
+	runtime.getConsoleState().groupDepth++
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// console.groupEnd
+func ConsoleGroupEnd(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	state := runtime.getConsoleState()
+	if state.groupDepth > 0 {
+		state.groupDepth--
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// console.time
+func ConsoleTime(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	completion := consoleLabel(runtime, arguments)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	label := completion.Value.(string)
+	state := runtime.getConsoleState()
+	if _, ok := state.timers[label]; ok {
+		consolePrint(runtime, ConsoleLevelWarn, fmt.Sprintf("Timer '%s' already exists", label))
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	state.timers[label] = runtime.Now()
 	return NewNormalCompletion(NewUndefinedValue())
 }
+
+// console.timeLog
+func ConsoleTimeLog(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return consoleTimerReport(runtime, arguments, false)
+}
+
+// console.timeEnd
+func ConsoleTimeEnd(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return consoleTimerReport(runtime, arguments, true)
+}
+
+func consoleTimerReport(runtime *Runtime, arguments []*JavaScriptValue, end bool) *Completion {
+	completion := consoleLabel(runtime, arguments)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	label := completion.Value.(string)
+	state := runtime.getConsoleState()
+	start, ok := state.timers[label]
+	if !ok {
+		consolePrint(runtime, ConsoleLevelWarn, fmt.Sprintf("Timer '%s' does not exist", label))
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	if end {
+		delete(state.timers, label)
+	}
+
+	elapsed := float64(runtime.Now().Sub(start)) / float64(time.Millisecond)
+	data := []*JavaScriptValue{NewStringValue(fmt.Sprintf("%s: %.3fms", label, elapsed))}
+
+	// timeLog prints its extra arguments after the duration.
+	if !end && len(arguments) > 1 {
+		data = append(data, arguments[1:]...)
+	}
+
+	return ConsolePrinter(runtime, ConsoleLevelInfo, data)
+}
@@ -0,0 +1,178 @@
+package runtime
+
+import "fmt"
+
+type DataViewWithBufferWitness struct {
+	Object                         *Object
+	CachedBufferByteLength         uint
+	CachedBufferByteLengthDetached bool
+}
+
+func MakeDataViewWithBufferWitnessRecord(view *Object, unordered bool) *DataViewWithBufferWitness {
+	buffer := view.DataViewViewedArrayBuffer
+	if IsDetachedArrayBuffer(buffer) {
+		return &DataViewWithBufferWitness{
+			Object:                         view,
+			CachedBufferByteLength:         0,
+			CachedBufferByteLengthDetached: true,
+		}
+	}
+
+	return &DataViewWithBufferWitness{
+		Object:                         view,
+		CachedBufferByteLength:         ArrayBufferByteLength(buffer, unordered),
+		CachedBufferByteLengthDetached: false,
+	}
+}
+
+func (record *DataViewWithBufferWitness) IsViewOutOfBounds() bool {
+	view := record.Object
+
+	if record.CachedBufferByteLengthDetached {
+		return true
+	}
+
+	byteOffsetStart := view.DataViewByteOffset
+
+	var byteOffsetEnd uint
+	if view.DataViewByteLengthAuto {
+		byteOffsetEnd = record.CachedBufferByteLength
+	} else {
+		byteOffsetEnd = byteOffsetStart + view.DataViewByteLength
+	}
+
+	if byteOffsetStart > record.CachedBufferByteLength || byteOffsetEnd > record.CachedBufferByteLength {
+		return true
+	}
+
+	return false
+}
+
+func (record *DataViewWithBufferWitness) GetViewByteLength() uint {
+	view := record.Object
+
+	if !view.DataViewByteLengthAuto {
+		return view.DataViewByteLength
+	}
+
+	return record.CachedBufferByteLength - view.DataViewByteOffset
+}
+
+func thisDataViewValue(runtime *Runtime, value *JavaScriptValue, methodName string) (*Object, *Completion) {
+	if value.Type == TypeObject {
+		if object, ok := value.Value.(*Object); ok && object.IsDataView {
+			return object, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, fmt.Sprintf("DataView.prototype.%s called on incompatible receiver", methodName)))
+}
+
+func GetViewValue(
+	runtime *Runtime,
+	view *JavaScriptValue,
+	requestIndex *JavaScriptValue,
+	isLittleEndian *JavaScriptValue,
+	dataType TypedArrayName,
+) *Completion {
+	viewObj, errCompletion := thisDataViewValue(runtime, view, "get"+typedArrayElementTypeName(dataType))
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	completion := ToIndex(runtime, requestIndex)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	getIndex := uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+	littleEndian := ToBoolean(isLittleEndian).Value.(*JavaScriptValue).Value.(*Boolean).Value
+
+	viewOffset := viewObj.DataViewByteOffset
+	viewRecord := MakeDataViewWithBufferWitnessRecord(viewObj, true)
+	if viewRecord.IsViewOutOfBounds() {
+		return NewThrowCompletion(NewTypeError(runtime, "DataView is out of bounds"))
+	}
+
+	viewSize := viewRecord.GetViewByteLength()
+	elementSize := TypedArrayElementSizes[dataType]
+
+	if getIndex+elementSize > viewSize {
+		return NewThrowCompletion(NewRangeError(runtime, "Offset is outside the bounds of the DataView"))
+	}
+
+	bufferIndex := getIndex + viewOffset
+	return NewNormalCompletion(GetValueFromBufferWithEndianness(
+		runtime,
+		viewObj.DataViewViewedArrayBuffer,
+		bufferIndex,
+		dataType,
+		false, /* isTypedArray */
+		true,  /* unordered */
+		littleEndian,
+	))
+}
+
+func SetViewValue(
+	runtime *Runtime,
+	view *JavaScriptValue,
+	requestIndex *JavaScriptValue,
+	isLittleEndian *JavaScriptValue,
+	dataType TypedArrayName,
+	value *JavaScriptValue,
+) *Completion {
+	viewObj, errCompletion := thisDataViewValue(runtime, view, "set"+typedArrayElementTypeName(dataType))
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	completion := ToIndex(runtime, requestIndex)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	getIndex := uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+
+	if dataType == TypedArrayNameBigInt64 || dataType == TypedArrayNameBigUint64 {
+		completion = ToBigInt(runtime, value)
+	} else {
+		completion = ToNumber(runtime, value)
+	}
+	if completion.Type != Normal {
+		return completion
+	}
+
+	numberValue := completion.Value.(*JavaScriptValue)
+	littleEndian := ToBoolean(isLittleEndian).Value.(*JavaScriptValue).Value.(*Boolean).Value
+
+	viewOffset := viewObj.DataViewByteOffset
+	viewRecord := MakeDataViewWithBufferWitnessRecord(viewObj, true)
+	if viewRecord.IsViewOutOfBounds() {
+		return NewThrowCompletion(NewTypeError(runtime, "DataView is out of bounds"))
+	}
+
+	viewSize := viewRecord.GetViewByteLength()
+	elementSize := TypedArrayElementSizes[dataType]
+
+	if getIndex+elementSize > viewSize {
+		return NewThrowCompletion(NewRangeError(runtime, "Offset is outside the bounds of the DataView"))
+	}
+
+	bufferIndex := getIndex + viewOffset
+	SetValueInBufferWithEndianness(
+		runtime,
+		viewObj.DataViewViewedArrayBuffer,
+		bufferIndex,
+		dataType,
+		numberValue,
+		littleEndian,
+	)
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// typedArrayElementTypeName strips the "Array" suffix from a TypedArrayName, e.g. "Int8Array" -> "Int8".
+func typedArrayElementTypeName(dataType TypedArrayName) string {
+	name := string(dataType)
+	return name[:len(name)-len("Array")]
+}
@@ -0,0 +1,126 @@
+package runtime
+
+func NewDataViewConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		DataViewConstructor,
+		1,
+		NewStringValue("DataView"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// DataView.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicDataViewPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+func DataViewConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "DataView constructor requires 'new'"))
+	}
+
+	newTargetObj := newTarget.Value.(FunctionInterface)
+
+	buffer := arguments[0]
+	byteOffset := arguments[1]
+	byteLength := arguments[2]
+
+	var bufferObj *Object
+	if buffer.Type == TypeObject {
+		bufferObj, _ = buffer.Value.(*Object)
+	}
+
+	if bufferObj == nil || !bufferObj.IsArrayBuffer {
+		return NewThrowCompletion(NewTypeError(runtime, "First argument to DataView constructor must be an ArrayBuffer"))
+	}
+
+	completion := ToIndex(runtime, byteOffset)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	offset := uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+
+	if IsDetachedArrayBuffer(bufferObj) {
+		return NewThrowCompletion(NewTypeError(runtime, "ArrayBuffer is detached"))
+	}
+
+	bufferByteLength := ArrayBufferByteLength(bufferObj, false)
+	if offset > bufferByteLength {
+		return NewThrowCompletion(NewRangeError(runtime, "Start offset is outside the bounds of the buffer"))
+	}
+
+	bufferIsFixedLength := IsFixedLengthArrayBuffer(bufferObj)
+
+	var viewByteLength uint
+	viewByteLengthAuto := false
+
+	if byteLength.Type == TypeUndefined {
+		if bufferIsFixedLength {
+			viewByteLength = bufferByteLength - offset
+		} else {
+			viewByteLengthAuto = true
+		}
+	} else {
+		completion = ToIndex(runtime, byteLength)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		viewByteLength = uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+		if offset+viewByteLength > bufferByteLength {
+			return NewThrowCompletion(NewRangeError(runtime, "Invalid DataView length"))
+		}
+	}
+
+	completion = OrdinaryCreateFromConstructor(runtime, newTargetObj, IntrinsicDataViewPrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	objectVal := completion.Value.(*JavaScriptValue)
+	object := objectVal.Value.(*Object)
+
+	// Getting the prototype may have run user code, so the buffer needs to be validated again.
+	if IsDetachedArrayBuffer(bufferObj) {
+		return NewThrowCompletion(NewTypeError(runtime, "ArrayBuffer is detached"))
+	}
+
+	bufferByteLength = ArrayBufferByteLength(bufferObj, false)
+	if offset > bufferByteLength {
+		return NewThrowCompletion(NewRangeError(runtime, "Start offset is outside the bounds of the buffer"))
+	}
+
+	if byteLength.Type != TypeUndefined && offset+viewByteLength > bufferByteLength {
+		return NewThrowCompletion(NewRangeError(runtime, "Invalid DataView length"))
+	}
+
+	object.IsDataView = true
+	object.DataViewViewedArrayBuffer = bufferObj
+	object.DataViewByteLength = viewByteLength
+	object.DataViewByteLengthAuto = viewByteLengthAuto
+	object.DataViewByteOffset = offset
+
+	return NewNormalCompletion(objectVal)
+}
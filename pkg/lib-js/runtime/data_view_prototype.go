@@ -0,0 +1,506 @@
+package runtime
+
+func NewDataViewPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineDataViewPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// DataView.prototype.buffer
+	DefineBuiltinAccessorFunction(runtime, prototype, "buffer", DataViewPrototypeBufferGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// DataView.prototype.byteLength
+	DefineBuiltinAccessorFunction(runtime, prototype, "byteLength", DataViewPrototypeByteLengthGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// DataView.prototype.byteOffset
+	DefineBuiltinAccessorFunction(runtime, prototype, "byteOffset", DataViewPrototypeByteOffsetGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// DataView.prototype.getInt8
+	DefineBuiltinFunction(runtime, prototype, "getInt8", DataViewPrototypeGetInt8, 1)
+
+	// DataView.prototype.getUint8
+	DefineBuiltinFunction(runtime, prototype, "getUint8", DataViewPrototypeGetUint8, 1)
+
+	// DataView.prototype.getInt16
+	DefineBuiltinFunction(runtime, prototype, "getInt16", DataViewPrototypeGetInt16, 1)
+
+	// DataView.prototype.getUint16
+	DefineBuiltinFunction(runtime, prototype, "getUint16", DataViewPrototypeGetUint16, 1)
+
+	// DataView.prototype.getInt32
+	DefineBuiltinFunction(runtime, prototype, "getInt32", DataViewPrototypeGetInt32, 1)
+
+	// DataView.prototype.getUint32
+	DefineBuiltinFunction(runtime, prototype, "getUint32", DataViewPrototypeGetUint32, 1)
+
+	// DataView.prototype.getBigInt64
+	DefineBuiltinFunction(runtime, prototype, "getBigInt64", DataViewPrototypeGetBigInt64, 1)
+
+	// DataView.prototype.getBigUint64
+	DefineBuiltinFunction(runtime, prototype, "getBigUint64", DataViewPrototypeGetBigUint64, 1)
+
+	// DataView.prototype.getFloat16
+	DefineBuiltinFunction(runtime, prototype, "getFloat16", DataViewPrototypeGetFloat16, 1)
+
+	// DataView.prototype.getFloat32
+	DefineBuiltinFunction(runtime, prototype, "getFloat32", DataViewPrototypeGetFloat32, 1)
+
+	// DataView.prototype.getFloat64
+	DefineBuiltinFunction(runtime, prototype, "getFloat64", DataViewPrototypeGetFloat64, 1)
+
+	// DataView.prototype.setInt8
+	DefineBuiltinFunction(runtime, prototype, "setInt8", DataViewPrototypeSetInt8, 2)
+
+	// DataView.prototype.setUint8
+	DefineBuiltinFunction(runtime, prototype, "setUint8", DataViewPrototypeSetUint8, 2)
+
+	// DataView.prototype.setInt16
+	DefineBuiltinFunction(runtime, prototype, "setInt16", DataViewPrototypeSetInt16, 2)
+
+	// DataView.prototype.setUint16
+	DefineBuiltinFunction(runtime, prototype, "setUint16", DataViewPrototypeSetUint16, 2)
+
+	// DataView.prototype.setInt32
+	DefineBuiltinFunction(runtime, prototype, "setInt32", DataViewPrototypeSetInt32, 2)
+
+	// DataView.prototype.setUint32
+	DefineBuiltinFunction(runtime, prototype, "setUint32", DataViewPrototypeSetUint32, 2)
+
+	// DataView.prototype.setBigInt64
+	DefineBuiltinFunction(runtime, prototype, "setBigInt64", DataViewPrototypeSetBigInt64, 2)
+
+	// DataView.prototype.setBigUint64
+	DefineBuiltinFunction(runtime, prototype, "setBigUint64", DataViewPrototypeSetBigUint64, 2)
+
+	// DataView.prototype.setFloat16
+	DefineBuiltinFunction(runtime, prototype, "setFloat16", DataViewPrototypeSetFloat16, 2)
+
+	// DataView.prototype.setFloat32
+	DefineBuiltinFunction(runtime, prototype, "setFloat32", DataViewPrototypeSetFloat32, 2)
+
+	// DataView.prototype.setFloat64
+	DefineBuiltinFunction(runtime, prototype, "setFloat64", DataViewPrototypeSetFloat64, 2)
+
+	// DataView.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("DataView"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func DataViewPrototypeBufferGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	view, errCompletion := thisDataViewValue(runtime, thisArg, "buffer")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, view.DataViewViewedArrayBuffer))
+}
+
+func DataViewPrototypeByteLengthGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	view, errCompletion := thisDataViewValue(runtime, thisArg, "byteLength")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	viewRecord := MakeDataViewWithBufferWitnessRecord(view, false)
+	if viewRecord.IsViewOutOfBounds() {
+		return NewThrowCompletion(NewTypeError(runtime, "DataView is out of bounds"))
+	}
+
+	return NewNormalCompletion(NewNumberValue(float64(viewRecord.GetViewByteLength()), false))
+}
+
+func DataViewPrototypeByteOffsetGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	view, errCompletion := thisDataViewValue(runtime, thisArg, "byteOffset")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	viewRecord := MakeDataViewWithBufferWitnessRecord(view, false)
+	if viewRecord.IsViewOutOfBounds() {
+		return NewThrowCompletion(NewTypeError(runtime, "DataView is out of bounds"))
+	}
+
+	return NewNormalCompletion(NewNumberValue(float64(view.DataViewByteOffset), false))
+}
+
+func DataViewPrototypeGetInt8(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 1 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], NewBooleanValue(true), TypedArrayNameInt8)
+}
+
+func DataViewPrototypeSetInt8(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], NewBooleanValue(true), TypedArrayNameInt8, arguments[1])
+}
+
+func DataViewPrototypeGetUint8(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 1 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], NewBooleanValue(true), TypedArrayNameUint8)
+}
+
+func DataViewPrototypeSetUint8(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], NewBooleanValue(true), TypedArrayNameUint8, arguments[1])
+}
+
+func DataViewPrototypeGetInt16(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], arguments[1], TypedArrayNameInt16)
+}
+
+func DataViewPrototypeSetInt16(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], arguments[2], TypedArrayNameInt16, arguments[1])
+}
+
+func DataViewPrototypeGetUint16(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], arguments[1], TypedArrayNameUint16)
+}
+
+func DataViewPrototypeSetUint16(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], arguments[2], TypedArrayNameUint16, arguments[1])
+}
+
+func DataViewPrototypeGetInt32(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], arguments[1], TypedArrayNameInt32)
+}
+
+func DataViewPrototypeSetInt32(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], arguments[2], TypedArrayNameInt32, arguments[1])
+}
+
+func DataViewPrototypeGetUint32(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], arguments[1], TypedArrayNameUint32)
+}
+
+func DataViewPrototypeSetUint32(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], arguments[2], TypedArrayNameUint32, arguments[1])
+}
+
+func DataViewPrototypeGetBigInt64(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], arguments[1], TypedArrayNameBigInt64)
+}
+
+func DataViewPrototypeSetBigInt64(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], arguments[2], TypedArrayNameBigInt64, arguments[1])
+}
+
+func DataViewPrototypeGetBigUint64(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], arguments[1], TypedArrayNameBigUint64)
+}
+
+func DataViewPrototypeSetBigUint64(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], arguments[2], TypedArrayNameBigUint64, arguments[1])
+}
+
+func DataViewPrototypeGetFloat16(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], arguments[1], TypedArrayNameFloat16)
+}
+
+func DataViewPrototypeSetFloat16(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], arguments[2], TypedArrayNameFloat16, arguments[1])
+}
+
+func DataViewPrototypeGetFloat32(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], arguments[1], TypedArrayNameFloat32)
+}
+
+func DataViewPrototypeSetFloat32(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], arguments[2], TypedArrayNameFloat32, arguments[1])
+}
+
+func DataViewPrototypeGetFloat64(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return GetViewValue(runtime, thisArg, arguments[0], arguments[1], TypedArrayNameFloat64)
+}
+
+func DataViewPrototypeSetFloat64(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return SetViewValue(runtime, thisArg, arguments[0], arguments[2], TypedArrayNameFloat64, arguments[1])
+}
@@ -1,7 +1,8 @@
 package runtime
 
 var (
-	causeStr = NewStringValue("cause")
+	causeStr           = NewStringValue("cause")
+	stackTraceLimitStr = NewStringValue("stackTraceLimit")
 )
 
 func NewErrorConstructor(runtime *Runtime) *FunctionObject {
@@ -24,6 +25,20 @@ func NewErrorConstructor(runtime *Runtime) *FunctionObject {
 		Configurable: false,
 	})
 
+	// Error.isError
+	DefineBuiltinFunction(runtime, constructor, "isError", ErrorIsError, 1)
+
+	// Error.captureStackTrace (non-standard, from V8)
+	DefineBuiltinFunction(runtime, constructor, "captureStackTrace", ErrorCaptureStackTrace, 2)
+
+	// Error.stackTraceLimit (non-standard, from V8)
+	constructor.DefineOwnProperty(runtime, stackTraceLimitStr, &DataPropertyDescriptor{
+		Value:        NewNumberValue(defaultStackTraceLimit, false),
+		Writable:     true,
+		Enumerable:   true,
+		Configurable: true,
+	})
+
 	return constructor
 }
 
@@ -87,6 +102,8 @@ func ErrorConstructor(
 		return completion
 	}
 
+	InstallErrorStack(runtime, object)
+
 	return NewNormalCompletion(objectVal)
 }
 
@@ -127,3 +144,73 @@ func InstallErrorCause(runtime *Runtime, object *Object, options *JavaScriptValu
 
 	return NewUnusedCompletion()
 }
+
+// InstallErrorStack defines the non-standard "stack" property of an error: its name and message, followed by the
+// functions that were being called when it was created. Like V8, errors have no stack when Error.stackTraceLimit
+// isn't a number.
+func InstallErrorStack(runtime *Runtime, object *Object) {
+	if _, ok := runtime.StackTraceLimit(); !ok {
+		return
+	}
+
+	stack := ErrorDescription(object) + runtime.StackTrace()
+	object.DefineOwnProperty(runtime, stackStr, &DataPropertyDescriptor{
+		Value:        NewStringValue(stack),
+		Writable:     true,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+// Error.isError
+func ErrorIsError(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 || arguments[0].Type != TypeObject {
+		return NewNormalCompletion(NewBooleanValue(false))
+	}
+
+	object, ok := arguments[0].Value.(*Object)
+	return NewNormalCompletion(NewBooleanValue(ok && object.IsError))
+}
+
+// Error.captureStackTrace defines a "stack" property on any object, describing the functions being called. When a
+// function is given, it and the calls above it are left out, so helpers that create errors don't show up.
+func ErrorCaptureStackTrace(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 || arguments[0].Type != TypeObject {
+		return NewThrowCompletion(NewTypeError(runtime, "Invalid argument"))
+	}
+
+	var above *FunctionObject
+	if len(arguments) > 1 && arguments[1].Type == TypeObject {
+		above, _ = arguments[1].Value.(*FunctionObject)
+	}
+
+	object := arguments[0].Value.(ObjectInterface)
+	if _, ok := runtime.StackTraceLimit(); !ok {
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	stack := ErrorDescription(object) + runtime.stackTraceAbove(above)
+	completion := DefinePropertyOrThrow(runtime, object, stackStr, &DataPropertyDescriptor{
+		Value:        NewStringValue(stack),
+		Writable:     true,
+		Enumerable:   false,
+		Configurable: true,
+	})
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
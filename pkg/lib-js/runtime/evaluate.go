@@ -12,6 +12,18 @@ func Evaluate(runtime *Runtime, node ast.Node) *Completion {
 		panic("Assert failed: Node is nil.")
 	}
 
+	if runtime.evaluationDepth >= maxEvaluationDepth {
+		return NewThrowCompletion(NewRangeError(runtime, "Maximum call stack size exceeded"))
+	}
+
+	runtime.evaluationDepth++
+	completion := evaluateNode(runtime, node)
+	runtime.evaluationDepth--
+
+	return completion
+}
+
+func evaluateNode(runtime *Runtime, node ast.Node) *Completion {
 	switch node.GetNodeType() {
 	case ast.Script:
 		return EvaluateScript(runtime, node.(*ast.ScriptNode))
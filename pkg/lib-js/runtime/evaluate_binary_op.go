@@ -74,7 +74,11 @@ func ApplyStringOrNumericBinaryOperation(
 			leftString := leftStringCompletion.Value.(*JavaScriptValue).Value.(*String)
 			rightString := rightStringCompletion.Value.(*JavaScriptValue).Value.(*String)
 
-			return NewNormalCompletion(NewJavaScriptValue(TypeString, StringAdd(leftString, rightString)))
+			if completion := runtime.CheckStringLength(len(leftString.Value) + len(rightString.Value)); completion != nil {
+				return completion
+			}
+
+			return NewNormalCompletion(NewJavaScriptValue(TypeString, runtime.trackString(StringAdd(leftString, rightString))))
 		}
 
 		leftRef = leftPrimitive
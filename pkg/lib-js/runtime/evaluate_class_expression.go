@@ -227,6 +227,7 @@ func ClassDefinitionEvaluation(
 			runtime.GetRunningRealm(),
 			constructorParent,
 		)
+		constructorObj.IsClassConstructor = true
 	} else {
 		completion := DefineMethod(runtime, constructor.(*ast.MethodDefinitionNode), proto, constructorParent)
 		if completion.Type != Normal {
@@ -257,6 +258,11 @@ func ClassDefinitionEvaluation(
 	staticElements := make([]any, 0)
 
 	for _, classElement := range classDeclaration.GetElements() {
+		// Only the NonConstructorElements, the constructor was defined above.
+		if constructor != nil && classElement == constructor {
+			continue
+		}
+
 		isStatic := IsClassElementStatic(classElement)
 		if !isStatic {
 			completion = ClassElementEvaluation(runtime, classElement, proto)
@@ -436,7 +442,7 @@ func ClassFieldDefinitionEvaluation(
 	classFieldDefinition *ast.PropertyDefinitionNode,
 	object ObjectInterface,
 ) *Completion {
-	completion := Evaluate(runtime, classFieldDefinition.GetKey())
+	completion := EvaluatePropertyName(runtime, classFieldDefinition.GetKey())
 	if completion.Type != Normal {
 		return completion
 	}
@@ -5,6 +5,10 @@ import "zbrannelly.dev/go-js/pkg/lib-js/parser/ast"
 func EvaluateDoWhileStatement(runtime *Runtime, doWhileStatement *ast.DoWhileStatementNode) *Completion {
 	var value *JavaScriptValue = NewUndefinedValue()
 	for {
+		if completion := runtime.Step(); completion != nil {
+			return completion
+		}
+
 		statementCompletion := Evaluate(runtime, doWhileStatement.GetStatement())
 		if !LoopContinues(runtime, statementCompletion) {
 			if statementCompletion.Type != Normal {
@@ -96,6 +96,10 @@ func ForInBodyEvaluation(
 	}
 
 	for {
+		if completion := runtime.Step(); completion != nil {
+			return completion
+		}
+
 		completion := iterator.Next(runtime)
 		if completion.Type != Normal {
 			return completion
@@ -102,6 +102,10 @@ func ForOfBodyEvaluation(
 	}
 
 	for {
+		if completion := runtime.Step(); completion != nil {
+			return completion
+		}
+
 		completion := Call(runtime, iterator.Next, iterator.Iterator, []*JavaScriptValue{})
 		if completion.Type != Normal {
 			return completion
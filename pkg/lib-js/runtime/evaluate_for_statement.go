@@ -90,6 +90,10 @@ func ForBodyEvaluation(runtime *Runtime, test ast.Node, increment ast.Node, body
 	}
 
 	for {
+		if completion := runtime.Step(); completion != nil {
+			return completion
+		}
+
 		// Evaluate the test expression.
 		if test != nil {
 			testCompletion := Evaluate(runtime, test)
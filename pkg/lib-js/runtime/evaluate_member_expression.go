@@ -27,7 +27,11 @@ func EvaluateMemberExpression(runtime *Runtime, memberExpression *ast.MemberExpr
 	strict := analyzer.IsStrictMode(memberExpression)
 
 	if memberExpression.PropertyIdentifier != "" {
-		return EvaluatePropertyAccessorWithIdentifierKey(baseVal, memberExpression.PropertyIdentifier, strict)
+		completion := EvaluatePropertyAccessorWithIdentifierKey(baseVal, memberExpression.PropertyIdentifier, strict)
+		if IsCacheableSite(memberExpression) {
+			completion.Value.(*JavaScriptValue).Value.(*Reference).CacheSite = memberExpression
+		}
+		return completion
 	}
 
 	return EvaluatePropertyAccessorWithComputedKey(runtime, baseVal, memberExpression.GetProperty(), strict)
@@ -8,6 +8,7 @@ import (
 
 func EvaluateObjectLiteral(runtime *Runtime, objectLiteral *ast.ObjectLiteralNode) *Completion {
 	object := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+	runtime.trackObject(object)
 
 	if len(objectLiteral.GetProperties()) == 0 {
 		return NewNormalCompletion(NewJavaScriptValue(TypeObject, object))
@@ -56,20 +57,11 @@ func PropertyDefinitionEvaluation(
 			if identifierName, ok := propertyDefinition.GetKey().(*ast.IdentifierNameNode); ok {
 				propKey = NewStringValue(identifierName.Identifier)
 			} else {
-				propKeyEvalCompletion := Evaluate(runtime, propertyDefinition.GetKey())
+				propKeyEvalCompletion := EvaluatePropertyName(runtime, propertyDefinition.GetKey())
 				if propKeyEvalCompletion.Type != Normal {
 					return propKeyEvalCompletion
 				}
 				propKey = propKeyEvalCompletion.Value.(*JavaScriptValue)
-
-				// LiteralPropertyName : NumericLiteral
-				if propKey.Type == TypeNumber {
-					completion := ToString(runtime, propKey)
-					if completion.Type != Normal {
-						panic("Assert failed: ToString threw an unexpected error in PropertyDefinitionEvaluation.")
-					}
-					propKey = completion.Value.(*JavaScriptValue)
-				}
 			}
 
 			isProtoSetter := false
@@ -181,23 +173,13 @@ func MethodDefinitionEvaluation(
 	}
 
 	if methodDefinition.Getter || methodDefinition.Setter {
-		completion := Evaluate(runtime, methodDefinition.GetName())
+		completion := EvaluatePropertyName(runtime, methodDefinition.GetName())
 		if completion.Type != Normal {
 			return completion
 		}
 
 		propKey := completion.Value.(*JavaScriptValue)
 
-		// TODO: Make this happen during Evaluation of the PropertyName.
-		// LiteralPropertyName : NumericLiteral
-		if propKey.Type == TypeNumber {
-			completion := ToString(runtime, propKey)
-			if completion.Type != Normal {
-				panic("Assert failed: ToString threw an unexpected error in PropertyDefinitionEvaluation.")
-			}
-			propKey = completion.Value.(*JavaScriptValue)
-		}
-
 		env := runtime.GetRunningExecutionContext().LexicalEnvironment
 		privateEnv := runtime.GetRunningExecutionContext().PrivateEnvironment
 
@@ -296,7 +278,7 @@ func DefineMethod(
 	object ObjectInterface,
 	functionPrototype ObjectInterface,
 ) *Completion {
-	completion := Evaluate(runtime, methodDefinition.GetName())
+	completion := EvaluatePropertyName(runtime, methodDefinition.GetName())
 	if completion.Type != Normal {
 		return completion
 	}
@@ -340,3 +322,30 @@ func IsComputedPropertyKey(node ast.Node) bool {
 		return true
 	}
 }
+
+// EvaluatePropertyName evaluates a PropertyName (or ClassElementName) to a property key.
+func EvaluatePropertyName(runtime *Runtime, node ast.Node) *Completion {
+	completion := Evaluate(runtime, node)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	// ComputedPropertyName : [ AssignmentExpression ]
+	if IsComputedPropertyKey(node) {
+		completion = GetValue(runtime, completion.Value.(*JavaScriptValue))
+		if completion.Type != Normal {
+			return completion
+		}
+
+		return ToPropertyKey(runtime, completion.Value.(*JavaScriptValue))
+	}
+
+	propKey := completion.Value.(*JavaScriptValue)
+
+	// LiteralPropertyName : NumericLiteral
+	if propKey.Type == TypeNumber {
+		return ToString(runtime, propKey)
+	}
+
+	return completion
+}
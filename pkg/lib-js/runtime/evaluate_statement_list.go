@@ -17,8 +17,17 @@ func EvaluateStatementList(runtime *Runtime, statementList *ast.StatementListNod
 
 	var lastValue any
 	for _, statement := range statementList.GetChildren() {
+		if completion := runtime.Step(); completion != nil {
+			return completion
+		}
+
 		completion = Evaluate(runtime, statement)
 
+		// Expression statements produce the value of the expression, not a reference to it.
+		if value, ok := completion.Value.(*JavaScriptValue); ok && completion.Type == Normal && value.Type == TypeReference {
+			completion = GetValue(runtime, value)
+		}
+
 		if completion.Value != nil {
 			lastValue = completion.Value
 		}
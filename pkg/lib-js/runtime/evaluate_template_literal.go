@@ -25,8 +25,12 @@ func EvaluateTemplateLiteral(runtime *Runtime, templateLiteral *ast.TemplateLite
 		}
 
 		stringValue := completion.Value.(*JavaScriptValue).Value.(*String).Value
+		if completion := runtime.CheckStringLength(len(result) + len(stringValue)); completion != nil {
+			return completion
+		}
+
 		result += stringValue
 	}
 
-	return NewNormalCompletion(NewStringValue(result))
+	return NewNormalCompletion(NewJavaScriptValue(TypeString, runtime.trackString(&String{Value: result})))
 }
@@ -12,8 +12,8 @@ func EvaluateTryStatement(runtime *Runtime, tryStatement *ast.TryStatementNode)
 		completion = CatchClauseEvaluation(runtime, tryStatement.GetCatch().(*ast.CatchNode), blockValue)
 	}
 
-	// Evaluate the finally block.
-	if tryStatement.GetFinally() != nil {
+	// Evaluate the finally block, unless the host is terminating the script.
+	if tryStatement.GetFinally() != nil && completion.Type != Terminate {
 		finallyCompletion := Evaluate(runtime, tryStatement.GetFinally())
 		if finallyCompletion.Type != Normal {
 			completion = finallyCompletion
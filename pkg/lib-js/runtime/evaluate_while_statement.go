@@ -5,6 +5,10 @@ import "zbrannelly.dev/go-js/pkg/lib-js/parser/ast"
 func EvaluateWhileStatement(runtime *Runtime, whileStatement *ast.WhileStatementNode) *Completion {
 	var value *JavaScriptValue = NewUndefinedValue()
 	for {
+		if completion := runtime.Step(); completion != nil {
+			return completion
+		}
+
 		// Evaluate the condition.
 		expressionCompletion := Evaluate(runtime, whileStatement.GetCondition())
 		if expressionCompletion.Type != Normal {
@@ -0,0 +1,334 @@
+package runtime
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is the time source of the event loop, which decides when timers are due.
+type Clock interface {
+	Now() time.Time
+
+	// After returns a channel that receives the time once d has passed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock follows the wall clock. It is the Clock of new runtimes.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// VirtualClock is a Clock that only moves when it is told to. When the event loop would wait for a timer, the clock
+// jumps ahead to it instead, so scripts with long timeouts finish right away, in the same order as on a real clock.
+// It is safe for concurrent use.
+type VirtualClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{now: start}
+}
+
+func (c *VirtualClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d.
+func (c *VirtualClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if d > 0 {
+		c.now = c.now.Add(d)
+	}
+}
+
+// After advances the clock by d and returns a channel that has already received the new time.
+func (c *VirtualClock) After(d time.Duration) <-chan time.Time {
+	c.Advance(d)
+
+	channel := make(chan time.Time, 1)
+	channel <- c.Now()
+	return channel
+}
+
+type timer struct {
+	id       int
+	when     time.Time
+	sequence uint64
+
+	callback  *JavaScriptValue
+	arguments []*JavaScriptValue
+	realm     *Realm
+
+	// How often the timer repeats, for setInterval.
+	repeat   bool
+	interval time.Duration
+
+	// The index of the timer in the queue, or -1 once it was removed.
+	index int
+}
+
+// timerQueue orders timers by when they are due, and those due at the same time by when they were scheduled.
+type timerQueue []*timer
+
+func (q timerQueue) Len() int {
+	return len(q)
+}
+
+func (q timerQueue) Less(i, j int) bool {
+	if !q[i].when.Equal(q[j].when) {
+		return q[i].when.Before(q[j].when)
+	}
+	return q[i].sequence < q[j].sequence
+}
+
+func (q timerQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *timerQueue) Push(x any) {
+	t := x.(*timer)
+	t.index = len(*q)
+	*q = append(*q, t)
+}
+
+func (q *timerQueue) Pop() any {
+	old := *q
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	t.index = -1
+	*q = old[:len(old)-1]
+	return t
+}
+
+type immediate struct {
+	id        int
+	callback  *JavaScriptValue
+	arguments []*JavaScriptValue
+	realm     *Realm
+	cleared   bool
+}
+
+// timerState holds the timers and immediates of a runtime, which the event loop runs.
+type timerState struct {
+	queue    timerQueue
+	timers   map[int]*timer
+	sequence uint64
+
+	immediates     []*immediate
+	immediatesByID map[int]*immediate
+
+	// The last id handed out, ids are shared by timers and immediates.
+	lastID int
+}
+
+func (r *Runtime) getTimerState() *timerState {
+	if r.timerState == nil {
+		r.timerState = &timerState{
+			timers:         make(map[int]*timer),
+			immediatesByID: make(map[int]*immediate),
+		}
+	}
+	return r.timerState
+}
+
+// clearTimers discards the timers and immediates, e.g. after the script that scheduled them was terminated.
+func (r *Runtime) clearTimers() {
+	r.timerState = nil
+}
+
+func (r *Runtime) addTimer(t *timer) int {
+	state := r.getTimerState()
+	state.lastID++
+	state.sequence++
+
+	t.id = state.lastID
+	t.sequence = state.sequence
+	state.timers[t.id] = t
+	heap.Push(&state.queue, t)
+
+	return t.id
+}
+
+func (r *Runtime) removeTimer(id int) {
+	state := r.getTimerState()
+	t, ok := state.timers[id]
+	if !ok {
+		return
+	}
+
+	delete(state.timers, id)
+	if t.index >= 0 {
+		heap.Remove(&state.queue, t.index)
+	}
+}
+
+func (r *Runtime) addImmediate(i *immediate) int {
+	state := r.getTimerState()
+	state.lastID++
+
+	i.id = state.lastID
+	state.immediates = append(state.immediates, i)
+	state.immediatesByID[i.id] = i
+
+	return i.id
+}
+
+func (r *Runtime) removeImmediate(id int) {
+	state := r.getTimerState()
+	if i, ok := state.immediatesByID[id]; ok {
+		i.cleared = true
+		delete(state.immediatesByID, id)
+	}
+}
+
+// HasPendingTimers reports whether timers or immediates are waiting to run.
+func (r *Runtime) HasPendingTimers() bool {
+	state := r.timerState
+	return state != nil && (len(state.queue) > 0 || len(state.immediates) > 0)
+}
+
+// RunEventLoop runs queued jobs, timers and immediates until none are left and no host operation is outstanding,
+// waiting for timers to become due on the runtime's Clock. Jobs run after every timer and immediate, like microtasks.
+// It returns the first abrupt completion, such as an exception thrown by a timer's callback.
+func (r *Runtime) RunEventLoop() *Completion {
+	return r.Execute(r.runEventLoop)
+}
+
+// RunEventLoopContext is like RunEventLoop, but stops with a Terminate completion when ctx is done.
+func (r *Runtime) RunEventLoopContext(ctx context.Context) *Completion {
+	return r.ExecuteContext(ctx, r.runEventLoop)
+}
+
+func (r *Runtime) runEventLoop() *Completion {
+	for {
+		if completion := r.runQueuedJobs(); completion != nil {
+			return completion
+		}
+
+		ran, completion := r.runDueTimers()
+		if completion != nil {
+			return completion
+		}
+
+		// Only the immediates queued before this point run now, those they queue wait for the next turn.
+		state := r.getTimerState()
+		immediates := state.immediates
+		state.immediates = nil
+		for _, i := range immediates {
+			if i.cleared {
+				continue
+			}
+			delete(state.immediatesByID, i.id)
+
+			completion := r.runTimerCallback(i.callback, i.arguments, i.realm)
+			if completion != nil {
+				return completion
+			}
+		}
+
+		if ran || len(immediates) > 0 || r.Jobs.hasJobs() {
+			continue
+		}
+
+		if !r.HasPendingTimers() && !r.Jobs.hasPendingOperations() {
+			return NewNormalCompletion(nil)
+		}
+
+		// Wait for the next timer, or for another goroutine to enqueue a job, finish an operation or interrupt.
+		var due <-chan time.Time
+		if len(state.queue) > 0 {
+			due = r.Clock.After(state.queue[0].when.Sub(r.Clock.Now()))
+		}
+
+		select {
+		case <-r.Jobs.wake:
+		case <-due:
+		}
+
+		if completion := r.CheckInterrupt(); completion != nil {
+			return completion
+		}
+	}
+}
+
+// runDueTimers runs the timers that are due, in order. Those scheduled by the callbacks wait for the next turn, even if
+// they are already due. It reports whether any ran.
+func (r *Runtime) runDueTimers() (bool, *Completion) {
+	state := r.getTimerState()
+	now := r.Clock.Now()
+	last := state.sequence
+	ran := false
+
+	for len(state.queue) > 0 && !state.queue[0].when.After(now) && state.queue[0].sequence <= last {
+		t := heap.Pop(&state.queue).(*timer)
+		if !t.repeat {
+			delete(state.timers, t.id)
+		}
+		ran = true
+
+		if completion := r.runTimerCallback(t.callback, t.arguments, t.realm); completion != nil {
+			return ran, completion
+		}
+
+		// Reschedule intervals, unless the callback cleared them. The state may have been replaced if the callback
+		// was terminated, but then the loop has already stopped.
+		if t.repeat && state.timers[t.id] == t {
+			state.sequence++
+			t.sequence = state.sequence
+			t.when = r.Clock.Now().Add(t.interval)
+			heap.Push(&state.queue, t)
+		}
+	}
+
+	return ran, nil
+}
+
+// runTimerCallback calls the callback of a timer or immediate and then runs the jobs it queued. It returns nil if
+// both completed normally.
+func (r *Runtime) runTimerCallback(callback *JavaScriptValue, arguments []*JavaScriptValue, realm *Realm) *Completion {
+	completion := r.runJob(&Job{
+		Callback: func(runtime *Runtime) *Completion {
+			return Call(runtime, callback, NewUndefinedValue(), arguments)
+		},
+		Realm: realm,
+	})
+	if completion.Type == Throw || completion.Type == Terminate {
+		return completion
+	}
+
+	return r.runQueuedJobs()
+}
+
+// runQueuedJobs runs jobs until the queue is empty, without waiting for host operations. It returns nil unless a job
+// threw or was terminated.
+func (r *Runtime) runQueuedJobs() *Completion {
+	for {
+		if completion := r.CheckInterrupt(); completion != nil {
+			return completion
+		}
+
+		job := r.Jobs.dequeue()
+		if job == nil {
+			return nil
+		}
+
+		completion := r.runJob(job)
+		if completion.Type == Throw || completion.Type == Terminate {
+			return completion
+		}
+	}
+}
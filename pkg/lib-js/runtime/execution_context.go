@@ -1,5 +1,10 @@
 package runtime
 
+import (
+	"math"
+	"strings"
+)
+
 type ExecutionContext struct {
 	Realm     *Realm
 	Function  *FunctionObject
@@ -24,3 +29,82 @@ func ResolveBindingFromCurrentContext(name string, runtime *Runtime, strict bool
 	env := executionContext.LexicalEnvironment
 	return ResolveBinding(runtime, name, env, strict)
 }
+
+// The most frames StackTrace describes, unless Error.stackTraceLimit says otherwise.
+const defaultStackTraceLimit = 10
+
+// StackTrace describes the functions being called, innermost first, as "\n    at name" lines. The running context is
+// left out, as it is usually the native function asking, e.g. the Error constructor.
+func (r *Runtime) StackTrace() string {
+	return r.stackTraceAbove(nil)
+}
+
+// stackTraceAbove is StackTrace without the innermost call to function and the calls it made, for
+// Error.captureStackTrace.
+func (r *Runtime) stackTraceAbove(function *FunctionObject) string {
+	var builder strings.Builder
+
+	stack := r.ExecutionContextStack[:max(len(r.ExecutionContextStack)-1, 0)]
+	if function != nil {
+		for idx := len(stack) - 1; idx >= 0; idx-- {
+			if stack[idx].Function == function {
+				stack = stack[:idx]
+				break
+			}
+		}
+	}
+
+	limit, _ := r.StackTraceLimit()
+	frames := 0
+	for idx := len(stack) - 1; idx >= 0 && frames < limit; idx-- {
+		// Skip the contexts of realms, which don't run code.
+		if stack[idx].Function == nil && stack[idx].Script == nil {
+			continue
+		}
+
+		builder.WriteString("\n    at ")
+		builder.WriteString(executionContextName(stack[idx]))
+		frames++
+	}
+
+	return builder.String()
+}
+
+// StackTraceLimit is the most frames a stack trace describes, read from Error.stackTraceLimit without running any
+// code. It reports false when the limit isn't a number, in which case errors get no stack at all.
+func (r *Runtime) StackTraceLimit() (int, bool) {
+	constructor, ok := r.GetRunningRealm().Intrinsics[IntrinsicErrorConstructor]
+	if !ok {
+		return defaultStackTraceLimit, true
+	}
+
+	descriptor, ok := constructor.GetProperties().Get(stackTraceLimitStr)
+	data, isData := descriptor.(*DataPropertyDescriptor)
+	if !ok || !isData || data.Value.Type != TypeNumber {
+		return 0, false
+	}
+
+	limit := data.Value.Value.(*Number)
+	switch {
+	case limit.NaN || limit.Value <= 0:
+		return 0, true
+	case limit.Value >= math.MaxInt32:
+		return math.MaxInt32, true
+	}
+	return int(limit.Value), true
+}
+
+func executionContextName(context *ExecutionContext) string {
+	if context.Function == nil {
+		return "<anonymous>"
+	}
+
+	descriptor, ok := context.Function.GetProperties().Get(nameStr)
+	if data, isData := descriptor.(*DataPropertyDescriptor); ok && isData && data.Value.Type == TypeString {
+		if name := data.Value.Value.(*String).Value; name != "" {
+			return name
+		}
+	}
+
+	return "<anonymous>"
+}
@@ -167,6 +167,10 @@ func Compile(runtime *Runtime, node ast.Node) []Instruction {
 
 func ExecuteVM(runtime *Runtime, vm *ExecutionVM) *Completion {
 	for vm.InstructionPointer < len(vm.Instructions) {
+		if completion := runtime.Step(); completion != nil {
+			return completion
+		}
+
 		instruction := vm.Instructions[vm.InstructionPointer]
 		vm.InstructionPointer++
 
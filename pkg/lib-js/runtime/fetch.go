@@ -0,0 +1,372 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// RequestState is the request of a Request object.
+type RequestState struct {
+	Method  string
+	URL     *URLRecord
+	Headers *Object // The Headers object of the request.
+	Body    *FetchBody
+
+	// The signal that aborts fetching the request.
+	Signal *Object
+
+	// How redirects are handled: "follow", "error" or "manual".
+	Redirect string
+}
+
+// ResponseState is the response of a Response object.
+type ResponseState struct {
+	// "basic" for fetched responses, "error" for network errors and "default" for responses created by scripts.
+	Type string
+
+	URL        *URLRecord // The URL the response was fetched from, nil for responses created by scripts.
+	Redirected bool
+	Status     int
+	StatusText string
+	Headers    *Object // The Headers object of the response.
+	Body       *FetchBody
+}
+
+// The methods whose names are normalized to uppercase.
+var normalizedRequestMethods = []string{"DELETE", "GET", "HEAD", "OPTIONS", "POST", "PUT"}
+
+// The methods fetch refuses to send.
+var forbiddenRequestMethods = []string{"CONNECT", "TRACE", "TRACK"}
+
+// The most redirects a fetch follows.
+const maxFetchRedirects = 20
+
+// IsNullBodyStatus reports whether responses with the status have no body.
+func IsNullBodyStatus(status int) bool {
+	return status == 101 || status == 103 || status == 204 || status == 205 || status == 304
+}
+
+// IsRedirectStatus reports whether the status is a redirect that has a Location.
+func IsRedirectStatus(status int) bool {
+	return status == 301 || status == 302 || status == 303 || status == 307 || status == 308
+}
+
+// fetch(input, init)
+func Fetch(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	capability := NewPromiseCapabilityFromIntrinsic(runtime)
+	reject := func(reason *JavaScriptValue) *Completion {
+		Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{reason})
+		return NewNormalCompletion(capability.Promise)
+	}
+
+	request, completion := newRequestState(runtime, arguments[0], arguments[1])
+	if completion != nil {
+		if completion.Type != Throw {
+			return completion
+		}
+		return reject(completion.Value.(*JavaScriptValue))
+	}
+
+	signal := request.Signal.AbortSignal
+	if signal.Aborted {
+		return reject(signal.Reason)
+	}
+
+	if runtime.HTTPTransport == nil {
+		return reject(NewTypeError(runtime, "fetch failed: no HTTP transport is configured"))
+	}
+
+	if request.URL.Scheme != "http" && request.URL.Scheme != "https" {
+		return reject(NewTypeError(runtime, "fetch failed: the URL scheme \""+request.URL.Scheme+"\" is not supported"))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	httpRequest, err := http.NewRequestWithContext(ctx, request.Method, request.URL.serialize(true), nil)
+	if err != nil {
+		cancel()
+		return reject(NewTypeError(runtime, "fetch failed: "+err.Error()))
+	}
+	httpRequest.Header = request.Headers.Headers.HTTPHeader()
+
+	if request.Body != nil {
+		request.Body.Used = true
+		if reader := request.Body.takeReader(); reader != nil {
+			httpRequest.Body = reader
+			httpRequest.ContentLength = -1
+		} else {
+			source := request.Body.Source
+			httpRequest.Body = io.NopCloser(bytes.NewReader(source))
+			httpRequest.ContentLength = int64(len(source))
+			httpRequest.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(source)), nil
+			}
+		}
+	}
+
+	redirects := 0
+	client := &http.Client{
+		Transport: runtime.HTTPTransport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			switch request.Redirect {
+			case "error":
+				return errors.New("unexpected redirect")
+			case "manual":
+				return http.ErrUseLastResponse
+			}
+
+			if len(via) > maxFetchRedirects {
+				return errors.New("redirect count exceeded")
+			}
+			redirects++
+			return nil
+		},
+	}
+
+	// Aborting the signal rejects the promise straight away, and cancels the request or the reading of its body.
+	settled := false
+	signal.AddAlgorithm(func() {
+		cancel()
+		if !settled {
+			settled = true
+			Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{signal.Reason})
+		}
+	})
+
+	// The request is made on another goroutine, and the promise settled by a job once the response has arrived.
+	method := request.Method
+	realm := runtime.GetRunningRealm()
+	operation := runtime.BeginHostOperation(cancel)
+
+	go func() {
+		httpResponse, err := client.Do(httpRequest)
+
+		queued := operation.EnqueueJob(func(runtime *Runtime) *Completion {
+			if settled {
+				if err == nil {
+					httpResponse.Body.Close()
+				}
+				return NewNormalCompletion(NewUndefinedValue())
+			}
+			settled = true
+
+			if err != nil {
+				cancel()
+				return Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{
+					NewTypeError(runtime, "fetch failed: "+err.Error()),
+				})
+			}
+
+			response := newFetchedResponseState(runtime, httpResponse, method, redirects > 0, signal, cancel)
+			responseVal := NewJavaScriptValue(TypeObject, NewResponseObject(runtime, response))
+			return Call(runtime, capability.Resolve, NewUndefinedValue(), []*JavaScriptValue{responseVal})
+		}, realm)
+
+		// The script was terminated while the request was being made.
+		if !queued && err == nil {
+			httpResponse.Body.Close()
+		}
+		operation.End()
+	}()
+
+	return NewNormalCompletion(capability.Promise)
+}
+
+// newFetchedResponseState creates the response of a fetch from the response received by net/http. Its headers can't
+// be changed, and its body is read as it is consumed.
+func newFetchedResponseState(
+	runtime *Runtime,
+	httpResponse *http.Response,
+	method string,
+	redirected bool,
+	signal *AbortSignalState,
+	cancel context.CancelFunc,
+) *ResponseState {
+	headers := NewHeadersListFromHTTP(httpResponse.Header)
+	headers.Immutable = true
+
+	response := &ResponseState{
+		Type:       "basic",
+		Redirected: redirected,
+		Status:     httpResponse.StatusCode,
+		StatusText: strings.TrimPrefix(httpResponse.Status, strconv.Itoa(httpResponse.StatusCode)+" "),
+		Headers:    NewHeadersObject(runtime, headers),
+	}
+
+	if url, ok := ParseURL(httpResponse.Request.URL.String(), nil); ok {
+		response.URL = url
+	}
+
+	body := &cancelOnCloseReader{ReadCloser: httpResponse.Body, cancel: cancel}
+	if IsNullBodyStatus(response.Status) || method == "HEAD" {
+		body.Close()
+	} else {
+		response.Body = NewFetchBodyFromReader(body, signal)
+		response.Body.cancel = cancel
+	}
+
+	return response
+}
+
+// cancelOnCloseReader is the body of a fetched response, which cancels the request's context once it is closed.
+type cancelOnCloseReader struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r *cancelOnCloseReader) Close() error {
+	err := r.ReadCloser.Close()
+	r.cancel()
+	return err
+}
+
+// newRequestState creates the request of the Request constructor, from an input that is either a Request or a URL, and
+// an init dictionary. Nothing is changed unless it succeeds, so that a request whose body is moved from input keeps it
+// if the constructor throws.
+func newRequestState(runtime *Runtime, input *JavaScriptValue, init *JavaScriptValue) (*RequestState, *Completion) {
+	request := &RequestState{Method: "GET", Redirect: "follow"}
+
+	var inputRequest *RequestState
+	var parentSignal *Object
+	if object, ok := input.Value.(*Object); ok && input.Type == TypeObject && object.Request != nil {
+		inputRequest = object.Request
+		request.Method = inputRequest.Method
+		request.URL = inputRequest.URL.Clone()
+		request.Redirect = inputRequest.Redirect
+		parentSignal = inputRequest.Signal
+	} else {
+		urlString, completion := toUSVString(runtime, input)
+		if completion != nil {
+			return nil, completion
+		}
+
+		url, ok := ParseURL(urlString, nil)
+		if !ok {
+			return nil, NewThrowCompletion(NewTypeError(runtime, "Failed to parse URL from "+urlString))
+		}
+		if url.IncludesCredentials() {
+			return nil, NewThrowCompletion(NewTypeError(runtime, "Request cannot be constructed from a URL that includes credentials: "+urlString))
+		}
+		request.URL = url
+	}
+
+	var initBody *JavaScriptValue
+	var initHeaders *JavaScriptValue
+	if init.Type == TypeObject {
+		members := map[string]*JavaScriptValue{}
+		for _, name := range []string{"body", "headers", "method", "redirect", "signal"} {
+			completion := init.Value.(ObjectInterface).Get(runtime, NewStringValue(name), init)
+			if completion.Type != Normal {
+				return nil, completion
+			}
+			members[name] = completion.Value.(*JavaScriptValue)
+		}
+
+		if method := members["method"]; method.Type != TypeUndefined {
+			methodString, completion := toByteString(runtime, method)
+			if completion != nil {
+				return nil, completion
+			}
+
+			if !IsValidHeaderName(methodString) {
+				return nil, NewThrowCompletion(NewTypeError(runtime, "'"+methodString+"' is not a valid HTTP method."))
+			}
+			if slices.Contains(forbiddenRequestMethods, strings.ToUpper(methodString)) {
+				return nil, NewThrowCompletion(NewTypeError(runtime, "'"+methodString+"' HTTP method is unsupported."))
+			}
+			if slices.Contains(normalizedRequestMethods, strings.ToUpper(methodString)) {
+				methodString = strings.ToUpper(methodString)
+			}
+			request.Method = methodString
+		}
+
+		if redirect := members["redirect"]; redirect.Type != TypeUndefined {
+			completion := ToString(runtime, redirect)
+			if completion.Type != Normal {
+				return nil, completion
+			}
+
+			redirectString := completion.Value.(*JavaScriptValue).Value.(*String).Value
+			if !slices.Contains([]string{"follow", "error", "manual"}, redirectString) {
+				return nil, NewThrowCompletion(NewTypeError(runtime, "'"+redirectString+"' is not a valid value for redirect."))
+			}
+			request.Redirect = redirectString
+		}
+
+		if signal := members["signal"]; signal.Type != TypeUndefined {
+			parentSignal = nil
+			if signal.Type != TypeNull {
+				object, ok := signal.Value.(*Object)
+				if signal.Type != TypeObject || !ok || object.AbortSignal == nil {
+					return nil, NewThrowCompletion(NewTypeError(runtime, "Request signal must be an AbortSignal."))
+				}
+				parentSignal = object
+			}
+		}
+
+		if headers := members["headers"]; headers.Type != TypeUndefined {
+			initHeaders = headers
+		}
+
+		if body := members["body"]; body.Type != TypeUndefined && body.Type != TypeNull {
+			initBody = body
+		}
+	}
+
+	// The headers are copied from input, unless init replaces them.
+	headers := &HeadersList{}
+	if initHeaders != nil {
+		if completion := fillHeaders(runtime, headers, initHeaders); completion != nil {
+			return nil, completion
+		}
+	} else if inputRequest != nil {
+		headers = inputRequest.Headers.Headers.Clone()
+	}
+
+	hasInputBody := inputRequest != nil && inputRequest.Body != nil
+	if (initBody != nil || hasInputBody) && (request.Method == "GET" || request.Method == "HEAD") {
+		return nil, NewThrowCompletion(NewTypeError(runtime, "Request with GET/HEAD method cannot have body."))
+	}
+
+	if initBody != nil {
+		body, contentType, completion := ExtractBody(runtime, initBody)
+		if completion != nil {
+			return nil, completion
+		}
+
+		if contentType != "" && !headers.Has("content-type") {
+			headers.Append("content-type", contentType)
+		}
+		request.Body = body
+	} else if hasInputBody {
+		if inputRequest.Body.Used {
+			return nil, NewThrowCompletion(NewTypeError(runtime, "Cannot construct a Request with a Request object that has already been used."))
+		}
+		request.Body = inputRequest.Body.take()
+	}
+
+	request.Headers = NewHeadersObject(runtime, headers)
+	request.Signal = NewAbortSignal(runtime)
+	if parentSignal != nil {
+		FollowAbortSignal(runtime, request.Signal, parentSignal)
+	}
+
+	return request, nil
+}
@@ -0,0 +1,294 @@
+package runtime
+
+import (
+	"bytes"
+	"io"
+	"math"
+	goruntime "runtime"
+	"slices"
+	"sync"
+)
+
+// FetchBody is the body of a Request or a Response.
+type FetchBody struct {
+	// The bytes of a body given by a script, nil when the body is read from a reader.
+	Source []byte
+
+	// A body that is read as it is consumed, such as the body of a fetched response.
+	reader *fetchBodyReader
+
+	// The signal of the fetch the body was received by, whose reason reading the body fails with once it is aborted.
+	Signal *AbortSignalState
+
+	// Cancels the fetch the body is read from, making a read in progress fail. It is nil for other bodies.
+	cancel func()
+
+	// Set once the body has been consumed, since it can only be read once.
+	Used bool
+}
+
+// fetchBodyReader holds the reader of a body until the body is read, so it can be closed if the body is garbage
+// collected first.
+type fetchBodyReader struct {
+	io.ReadCloser
+}
+
+// NewFetchBodyFromReader creates a body that is read from reader as it is consumed. The reader is closed once the body
+// has been read, or when the body is garbage collected without having been read.
+func NewFetchBodyFromReader(reader io.ReadCloser, signal *AbortSignalState) *FetchBody {
+	body := &FetchBody{reader: &fetchBodyReader{reader}, Signal: signal}
+	goruntime.AddCleanup(body, func(reader *fetchBodyReader) {
+		if reader.ReadCloser != nil {
+			reader.Close()
+		}
+	}, body.reader)
+	return body
+}
+
+// Clone returns a copy of the body that can be read separately. A body read from a reader is split in two, both
+// reading the bytes of the original reader once it is first read from.
+func (b *FetchBody) Clone() *FetchBody {
+	if b.reader == nil || b.reader.ReadCloser == nil {
+		return &FetchBody{Source: b.Source, Signal: b.Signal}
+	}
+
+	shared := &sharedBody{reader: b.reader.ReadCloser, open: 2}
+	b.reader.ReadCloser = &sharedBodyReader{shared: shared}
+	clone := NewFetchBodyFromReader(&sharedBodyReader{shared: shared}, b.Signal)
+	clone.cancel = b.cancel
+	return clone
+}
+
+// take marks the body as used and returns a copy of it that owns its bytes or its reader, for moving the body of one
+// request into another.
+func (b *FetchBody) take() *FetchBody {
+	b.Used = true
+
+	reader := b.takeReader()
+	if reader == nil {
+		return &FetchBody{Source: b.Source, Signal: b.Signal}
+	}
+
+	taken := NewFetchBodyFromReader(reader, b.Signal)
+	taken.cancel = b.cancel
+	return taken
+}
+
+// takeReader returns the reader of the body, which the caller must close, or nil if the body has no reader.
+func (b *FetchBody) takeReader() io.ReadCloser {
+	if b.reader == nil {
+		return nil
+	}
+
+	reader := b.reader.ReadCloser
+	b.reader.ReadCloser = nil
+	return reader
+}
+
+// sharedBody is a reader that is read by the clones of a body. It is read into memory on the first read of a clone,
+// and closed once every clone has been closed.
+type sharedBody struct {
+	reader io.ReadCloser
+	once   sync.Once
+	data   []byte
+	err    error
+
+	mutex sync.Mutex
+	open  int
+}
+
+func (s *sharedBody) load() ([]byte, error) {
+	s.once.Do(func() {
+		s.data, s.err = io.ReadAll(s.reader)
+	})
+	return s.data, s.err
+}
+
+type sharedBodyReader struct {
+	shared    *sharedBody
+	remaining *bytes.Reader
+	closeOnce sync.Once
+}
+
+func (r *sharedBodyReader) Read(p []byte) (int, error) {
+	if r.remaining == nil {
+		data, err := r.shared.load()
+		if err != nil {
+			return 0, err
+		}
+		r.remaining = bytes.NewReader(data)
+	}
+	return r.remaining.Read(p)
+}
+
+func (r *sharedBodyReader) Close() error {
+	r.closeOnce.Do(func() {
+		r.shared.mutex.Lock()
+		defer r.shared.mutex.Unlock()
+
+		r.shared.open--
+		if r.shared.open == 0 {
+			r.shared.reader.Close()
+		}
+	})
+	return nil
+}
+
+// ExtractBody converts the body passed to the Request or Response constructor to bytes, returning the type of its
+// content, or an empty string if it has none.
+func ExtractBody(runtime *Runtime, value *JavaScriptValue) (*FetchBody, string, *Completion) {
+	if data, ok := BufferSourceBytes(value); ok {
+		return &FetchBody{Source: slices.Clone(data)}, "", nil
+	}
+
+	if object, ok := value.Value.(*Object); ok && value.Type == TypeObject {
+		if object.URLSearchParams != nil {
+			data := []byte(SerializeURLEncoded(object.URLSearchParams.Entries))
+			return &FetchBody{Source: data}, "application/x-www-form-urlencoded;charset=UTF-8", nil
+		}
+	}
+
+	text, completion := toUSVString(runtime, value)
+	if completion != nil {
+		return nil, "", completion
+	}
+	return &FetchBody{Source: []byte(text)}, "text/plain;charset=UTF-8", nil
+}
+
+type fetchBodyKind int
+
+const (
+	fetchBodyKindArrayBuffer fetchBodyKind = iota
+	fetchBodyKindBytes
+	fetchBodyKindText
+	fetchBodyKindJSON
+)
+
+// consumeFetchBody reads a body, returning a promise for its bytes converted to kind. The promise is rejected if the
+// body has already been read. A nil body has no bytes.
+func consumeFetchBody(runtime *Runtime, body *FetchBody, kind fetchBodyKind) *Completion {
+	capability := NewPromiseCapabilityFromIntrinsic(runtime)
+
+	if body == nil {
+		if completion := settleFetchBody(runtime, capability, []byte{}, kind); completion.Type == Terminate {
+			return completion
+		}
+		return NewNormalCompletion(capability.Promise)
+	}
+
+	if body.Used {
+		Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{
+			NewTypeError(runtime, "Body is unusable: Body has already been read"),
+		})
+		return NewNormalCompletion(capability.Promise)
+	}
+	body.Used = true
+
+	reader := body.takeReader()
+	if reader == nil {
+		if completion := settleFetchBody(runtime, capability, slices.Clone(body.Source), kind); completion.Type == Terminate {
+			return completion
+		}
+		return NewNormalCompletion(capability.Promise)
+	}
+
+	// The body is read on another goroutine, and the promise settled by a job once it has been read. Reading stops
+	// just past the longest result the script may hold, so a large body can't exhaust memory before it is checked.
+	realm := runtime.GetRunningRealm()
+	operation := runtime.BeginHostOperation(body.cancel)
+	limit := runtime.fetchBodyLimit(kind)
+
+	go func() {
+		data, err := io.ReadAll(io.LimitReader(reader, limit+1))
+		reader.Close()
+
+		operation.EnqueueJob(func(runtime *Runtime) *Completion {
+			if err == nil && int64(len(data)) > limit {
+				return Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{fetchBodyTooLarge(runtime, kind)})
+			}
+
+			if err != nil {
+				reason := NewTypeError(runtime, "Failed to read the body: "+err.Error())
+				if body.Signal != nil && body.Signal.Aborted {
+					reason = body.Signal.Reason
+				}
+				return Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{reason})
+			}
+
+			return settleFetchBody(runtime, capability, data, kind)
+		}, realm)
+		operation.End()
+	}()
+
+	return NewNormalCompletion(capability.Promise)
+}
+
+// fetchBodyLimit returns the most bytes of a body that may be read as kind: the largest buffer scripts may allocate, or
+// the longest string they may create plus a byte order mark, as decoding only ever makes text shorter by removing one.
+func (r *Runtime) fetchBodyLimit(kind fetchBodyKind) int64 {
+	switch kind {
+	case fetchBodyKindArrayBuffer, fetchBodyKindBytes:
+		if r.resources != nil && r.resources.limits.MaxArrayBufferByteLength > 0 {
+			return int64(r.resources.limits.MaxArrayBufferByteLength)
+		}
+		return math.MaxInt64 - 1
+	}
+
+	limit := maxStringLength
+	if r.resources != nil && r.resources.limits.MaxStringLength > 0 {
+		limit = min(r.resources.limits.MaxStringLength, maxStringLength)
+	}
+	return int64(limit) + 3
+}
+
+// fetchBodyTooLarge returns the RangeError a body is rejected with when it is longer than its fetchBodyLimit.
+func fetchBodyTooLarge(runtime *Runtime, kind fetchBodyKind) *JavaScriptValue {
+	switch kind {
+	case fetchBodyKindArrayBuffer, fetchBodyKindBytes:
+		return NewRangeError(runtime, "Array buffer allocation failed")
+	}
+	return NewRangeError(runtime, "Invalid string length")
+}
+
+// settleFetchBody resolves the promise of a body with its bytes converted to kind, or rejects it if they can't be. The
+// result is charged to the runtime like any other buffer or string, so going over the total of the ResourceLimits
+// returns a Terminate completion instead.
+func settleFetchBody(runtime *Runtime, capability *PromiseCapability, data []byte, kind fetchBodyKind) *Completion {
+	reject := func(completion *Completion) *Completion {
+		if completion.Type != Throw {
+			return completion
+		}
+		return Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{completion.Value.(*JavaScriptValue)})
+	}
+
+	var result *JavaScriptValue
+	switch kind {
+	case fetchBodyKindArrayBuffer, fetchBodyKindBytes:
+		if completion := runtime.CheckArrayBufferLength(uint(len(data))); completion != nil {
+			return reject(completion)
+		}
+		runtime.trackArrayBuffer(data)
+
+		if kind == fetchBodyKindArrayBuffer {
+			result = NewArrayBufferFromBytes(runtime, data)
+		} else {
+			result = NewUint8ArrayFromBytes(runtime, data)
+		}
+	case fetchBodyKindText, fetchBodyKindJSON:
+		text, _ := NewTextDecoderState(TextEncodingUTF8, false, false).Decode(data, false)
+		if completion := runtime.CheckStringLength(len(text)); completion != nil {
+			return reject(completion)
+		}
+		result = NewJavaScriptValue(TypeString, runtime.trackString(&String{Value: text}))
+
+		if kind == fetchBodyKindJSON {
+			completion := ParseJSON(runtime, text)
+			if completion.Type != Normal {
+				return Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{completion.Value.(*JavaScriptValue)})
+			}
+			result = completion.Value.(*JavaScriptValue)
+		}
+	}
+
+	return Call(runtime, capability.Resolve, NewUndefinedValue(), []*JavaScriptValue{result})
+}
@@ -0,0 +1,60 @@
+package runtime
+
+func NewFinalizationRegistryConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		FinalizationRegistryConstructor,
+		1,
+		NewStringValue("FinalizationRegistry"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// FinalizationRegistry.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicFinalizationRegistryPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+func FinalizationRegistryConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "FinalizationRegistry constructor requires 'new'"))
+	}
+
+	cleanupCallback := NewUndefinedValue()
+	if len(arguments) > 0 {
+		cleanupCallback = arguments[0]
+	}
+
+	if !IsCallable(cleanupCallback) {
+		return NewThrowCompletion(NewTypeError(runtime, "FinalizationRegistry cleanup callback is not a function"))
+	}
+
+	completion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicFinalizationRegistryPrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	registryVal := completion.Value.(*JavaScriptValue)
+	registry := registryVal.Value.(*Object)
+
+	registry.IsFinalizationRegistry = true
+	registry.FinalizationRegistryRealm = runtime.GetRunningRealm()
+	registry.FinalizationRegistryCleanupCallback = cleanupCallback
+	registry.FinalizationRegistryCells = make([]*FinalizationRegistryCell, 0)
+
+	return NewNormalCompletion(registryVal)
+}
@@ -0,0 +1,119 @@
+package runtime
+
+func NewFinalizationRegistryPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineFinalizationRegistryPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// FinalizationRegistry.prototype.register
+	DefineBuiltinFunction(runtime, prototype, "register", FinalizationRegistryPrototypeRegister, 2)
+
+	// FinalizationRegistry.prototype.unregister
+	DefineBuiltinFunction(runtime, prototype, "unregister", FinalizationRegistryPrototypeUnregister, 1)
+
+	// FinalizationRegistry.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("FinalizationRegistry"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func FinalizationRegistryPrototypeRegister(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	target := arguments[0]
+	heldValue := arguments[1]
+	unregisterToken := arguments[2]
+
+	registry, ok := thisFinalizationRegistry(thisArg)
+	if !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "FinalizationRegistry.prototype.register called on incompatible receiver"))
+	}
+
+	if !CanBeHeldWeakly(runtime, target) {
+		return NewThrowCompletion(NewTypeError(runtime, "FinalizationRegistry target must be an object or a non-registered symbol"))
+	}
+
+	if SameValue(target, heldValue).Value.(*JavaScriptValue).Value.(*Boolean).Value {
+		return NewThrowCompletion(NewTypeError(runtime, "FinalizationRegistry target and held value must not be the same"))
+	}
+
+	cell := &FinalizationRegistryCell{
+		Target:    NewWeakReference(target),
+		HeldValue: heldValue,
+	}
+
+	if !CanBeHeldWeakly(runtime, unregisterToken) {
+		if unregisterToken.Type != TypeUndefined {
+			return NewThrowCompletion(NewTypeError(runtime, "FinalizationRegistry unregister token must be an object or a non-registered symbol"))
+		}
+	} else {
+		cell.UnregisterToken = NewWeakReference(unregisterToken)
+	}
+
+	registry.FinalizationRegistryCells = append(registry.FinalizationRegistryCells, cell)
+	runtime.registerFinalization(registry, target)
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+func FinalizationRegistryPrototypeUnregister(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	unregisterToken := NewUndefinedValue()
+	if len(arguments) > 0 {
+		unregisterToken = arguments[0]
+	}
+
+	registry, ok := thisFinalizationRegistry(thisArg)
+	if !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "FinalizationRegistry.prototype.unregister called on incompatible receiver"))
+	}
+
+	if !CanBeHeldWeakly(runtime, unregisterToken) {
+		return NewThrowCompletion(NewTypeError(runtime, "FinalizationRegistry unregister token must be an object or a non-registered symbol"))
+	}
+
+	removed := false
+	cells := registry.FinalizationRegistryCells[:0]
+	for _, cell := range registry.FinalizationRegistryCells {
+		if cell.UnregisterToken.Refers(unregisterToken) {
+			removed = true
+			continue
+		}
+		cells = append(cells, cell)
+	}
+	clear(registry.FinalizationRegistryCells[len(cells):])
+	registry.FinalizationRegistryCells = cells
+
+	return NewNormalCompletion(NewBooleanValue(removed))
+}
+
+func thisFinalizationRegistry(thisArg *JavaScriptValue) (*Object, bool) {
+	if thisArg.Type != TypeObject {
+		return nil, false
+	}
+
+	object, ok := thisArg.Value.(*Object)
+	if !ok || !object.IsFinalizationRegistry {
+		return nil, false
+	}
+
+	return object, true
+}
@@ -63,11 +63,10 @@ type PrivateElement struct {
 }
 
 type FunctionObject struct {
-	Prototype        ObjectInterface
-	Properties       map[string]PropertyDescriptor
-	SymbolProperties map[*Symbol]PropertyDescriptor
-	Extensible       bool
-	PrivateElements  []*PrivateElement
+	Prototype       ObjectInterface
+	Properties      *PropertyStore
+	Extensible      bool
+	PrivateElements []*PrivateElement
 
 	Environment               Environment
 	PrivateEnvironment        *PrivateEnvironment
@@ -119,8 +118,7 @@ func OrdinaryFunctionCreate(
 
 	functionObject := &FunctionObject{
 		Prototype:                 proto,
-		Properties:                make(map[string]PropertyDescriptor),
-		SymbolProperties:          make(map[*Symbol]PropertyDescriptor),
+		Properties:                NewPropertyStore(),
 		Extensible:                true,
 		PrivateElements:           make([]*PrivateElement, 0),
 		SourceText:                sourceText,
@@ -163,8 +161,7 @@ func CreateBuiltinFunction(
 	}
 
 	functionObject := &FunctionObject{
-		Properties:             make(map[string]PropertyDescriptor),
-		SymbolProperties:       make(map[*Symbol]PropertyDescriptor),
+		Properties:             NewPropertyStore(),
 		Extensible:             true,
 		PrivateElements:        make([]*PrivateElement, 0),
 		IsNativeFunction:       true,
@@ -363,14 +360,14 @@ func SetFunctionName(runtime *Runtime, function *FunctionObject, name *JavaScrip
 		panic("Assert failed: SetFunctionName called on a non-extensible function object.")
 	}
 
-	if function.Properties["name"] != nil {
+	if _, ok := function.Properties.GetString("name"); ok {
 		panic("Assert failed: SetFunctionName called on a function object with a 'name' property.")
 	}
 
 	switch name.Type {
 	case TypeSymbol:
 		symbol := name.Value.(*Symbol)
-		if symbol.Description == "" {
+		if symbol.DescriptionIsUndefined {
 			name = NewStringValue("")
 		} else {
 			name = NewStringValue(fmt.Sprintf("[%s]", symbol.Description))
@@ -408,14 +405,14 @@ func SetFunctionNameWithPrefix(runtime *Runtime, function ObjectInterface, name
 		panic("Assert failed: SetFunctionName called on a non-extensible function object.")
 	}
 
-	if function.GetProperties()["name"] != nil {
+	if _, ok := function.GetProperties().GetString("name"); ok {
 		panic("Assert failed: SetFunctionName called on a function object with a 'name' property.")
 	}
 
 	switch name.Type {
 	case TypeSymbol:
 		symbol := name.Value.(*Symbol)
-		if symbol.Description == "" {
+		if symbol.DescriptionIsUndefined {
 			name = NewStringValue("")
 		} else {
 			name = NewStringValue(fmt.Sprintf("[%s]", symbol.Description))
@@ -428,7 +425,9 @@ func SetFunctionNameWithPrefix(runtime *Runtime, function ObjectInterface, name
 		functionObj.InitialName = name
 	}
 
-	name = NewStringValue(prefix + " " + name.Value.(*String).Value)
+	if prefix != "" {
+		name = NewStringValue(prefix + " " + name.Value.(*String).Value)
+	}
 
 	completion = DefinePropertyOrThrow(runtime, function, NewStringValue("name"), &DataPropertyDescriptor{
 		Value:        name,
@@ -491,6 +490,14 @@ func (o *FunctionObject) Call(
 		return BuiltinCallOrConstruct(runtime, o, thisArg, arguments, NewUndefinedValue())
 	}
 
+	if completion := runtime.Step(); completion != nil {
+		return completion
+	}
+
+	if completion := runtime.CheckCallDepth(); completion != nil {
+		return completion
+	}
+
 	calleeContext := PrepareForOrdinaryCall(runtime, o, NewUndefinedValue())
 
 	if calleeContext != runtime.GetRunningExecutionContext() {
@@ -516,7 +523,7 @@ func (o *FunctionObject) Call(
 		return NewNormalCompletion(resultCompletion.Value)
 	}
 
-	if resultCompletion.Type != Throw {
+	if resultCompletion.Type != Throw && resultCompletion.Type != Terminate {
 		panic("Assert failed: function result completion is not a return or throw completion.")
 	}
 
@@ -546,9 +553,22 @@ func (o *FunctionObject) Construct(
 		return completion
 	}
 
+	if completion := runtime.Step(); completion != nil {
+		return completion
+	}
+
+	if completion := runtime.CheckCallDepth(); completion != nil {
+		return completion
+	}
+
 	var thisArgument *JavaScriptValue = nil
 	if o.ConstructorKind == ConstructorKindBase {
-		completion := OrdinaryCreateFromConstructor(runtime, o, IntrinsicObjectPrototype)
+		var constructor FunctionInterface = o
+		if newTarget != nil && newTarget.Type == TypeObject {
+			constructor = newTarget.Value.(FunctionInterface)
+		}
+
+		completion := OrdinaryCreateFromConstructor(runtime, constructor, IntrinsicObjectPrototype)
 		if completion.Type != Normal {
 			return completion
 		}
@@ -570,7 +590,7 @@ func (o *FunctionObject) Construct(
 	completion := OrdinaryCallEvaluateBody(runtime, o, arguments)
 	runtime.PopExecutionContext()
 
-	if completion.Type == Throw {
+	if completion.Type == Throw || completion.Type == Terminate {
 		return completion
 	}
 
@@ -629,6 +649,10 @@ func BuiltinCallOrConstruct(
 		panic("Assert failed: BuiltinCallOrConstruct called on a non-native function.")
 	}
 
+	if completion := runtime.CheckCallDepth(); completion != nil {
+		return completion
+	}
+
 	calleeContext := &ExecutionContext{
 		Function: function,
 		Realm:    function.Realm,
@@ -741,22 +765,10 @@ func (o *FunctionObject) SetPrototype(prototype ObjectInterface) {
 	o.Prototype = prototype
 }
 
-func (o *FunctionObject) GetProperties() map[string]PropertyDescriptor {
+func (o *FunctionObject) GetProperties() *PropertyStore {
 	return o.Properties
 }
 
-func (o *FunctionObject) SetProperties(properties map[string]PropertyDescriptor) {
-	o.Properties = properties
-}
-
-func (o *FunctionObject) GetSymbolProperties() map[*Symbol]PropertyDescriptor {
-	return o.SymbolProperties
-}
-
-func (o *FunctionObject) SetSymbolProperties(symbolProperties map[*Symbol]PropertyDescriptor) {
-	o.SymbolProperties = symbolProperties
-}
-
 func (o *FunctionObject) IsExtensible(runtime *Runtime) *Completion {
 	return NewNormalCompletion(NewBooleanValue(o.Extensible))
 }
@@ -827,6 +839,10 @@ func GetFunctionRealm(runtime *Runtime, function FunctionInterface) *Completion
 		return GetFunctionRealm(runtime, boundTargetFunction)
 	}
 
+	if wrappedFunc, ok := function.(*WrappedFunction); ok {
+		return NewNormalCompletion(wrappedFunc.Realm)
+	}
+
 	if proxy, ok := function.(*ProxyObject); ok {
 		completion := ValidateNonRevokedProxy(runtime, proxy)
 		if completion.Type != Normal {
@@ -34,7 +34,7 @@ func GeneratorStartWithClosure(runtime *Runtime, generator *Object, closureInstr
 			resultValue = NewUndefinedValue()
 		case Return:
 			resultValue = result.Value.(*JavaScriptValue)
-		case Throw:
+		case Throw, Terminate:
 			return result
 		default:
 			panic("Assert failed: Invalid result type in GeneratorStart closure.")
@@ -64,6 +64,10 @@ func GeneratorResume(runtime *Runtime, generator *Object, value *JavaScriptValue
 		panic("Assert failed: Generator is not in suspended state.")
 	}
 
+	if completion := runtime.CheckCallDepth(); completion != nil {
+		return completion
+	}
+
 	methodContext := runtime.GetRunningExecutionContext()
 
 	// Resume the generator.
@@ -75,6 +79,12 @@ func GeneratorResume(runtime *Runtime, generator *Object, value *JavaScriptValue
 	// Run the generator VM (until it suspends or completes).
 	completion = ExecuteVM(runtime, generator.GeneratorContext.VM)
 
+	// An abrupt completion stops the VM before the closure's epilogue runs, so complete the generator here.
+	if (completion.Type == Throw || completion.Type == Terminate) && generator.GeneratorState == GeneratorStateExecuting {
+		runtime.PopExecutionContext()
+		generator.GeneratorState = GeneratorStateCompleted
+	}
+
 	if methodContext != runtime.GetRunningExecutionContext() {
 		panic("Assert failed: GeneratorResume returned to the wrong execution context.")
 	}
@@ -0,0 +1,201 @@
+package runtime
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// HeaderField is a header of a Headers object. Names are stored lowercase. Names and values only contain code points
+// up to U+00FF, each standing for one byte on the wire.
+type HeaderField struct {
+	Name  string
+	Value string
+}
+
+// HeadersList is the header list of a Headers object.
+type HeadersList struct {
+	Fields []HeaderField
+
+	// Set for the headers of responses returned by fetch, which can't be changed.
+	Immutable bool
+}
+
+// Append adds a header, after the headers that are already in the list.
+func (h *HeadersList) Append(name string, value string) {
+	h.Fields = append(h.Fields, HeaderField{Name: strings.ToLower(name), Value: value})
+}
+
+// Delete removes every header with the name.
+func (h *HeadersList) Delete(name string) {
+	name = strings.ToLower(name)
+	h.Fields = slices.DeleteFunc(h.Fields, func(field HeaderField) bool {
+		return field.Name == name
+	})
+}
+
+// Get returns the values of the headers with the name, combined with ", ".
+func (h *HeadersList) Get(name string) (string, bool) {
+	name = strings.ToLower(name)
+
+	values := []string{}
+	for _, field := range h.Fields {
+		if field.Name == name {
+			values = append(values, field.Value)
+		}
+	}
+
+	if len(values) == 0 {
+		return "", false
+	}
+	return strings.Join(values, ", "), true
+}
+
+// GetSetCookie returns the values of the Set-Cookie headers, which can't be combined.
+func (h *HeadersList) GetSetCookie() []string {
+	values := []string{}
+	for _, field := range h.Fields {
+		if field.Name == "set-cookie" {
+			values = append(values, field.Value)
+		}
+	}
+	return values
+}
+
+// Has reports whether there is a header with the name.
+func (h *HeadersList) Has(name string) bool {
+	name = strings.ToLower(name)
+	return slices.ContainsFunc(h.Fields, func(field HeaderField) bool {
+		return field.Name == name
+	})
+}
+
+// Set replaces the first header with the name and removes the others, or appends the header if there isn't one.
+func (h *HeadersList) Set(name string, value string) {
+	name = strings.ToLower(name)
+
+	idx := slices.IndexFunc(h.Fields, func(field HeaderField) bool {
+		return field.Name == name
+	})
+	if idx == -1 {
+		h.Fields = append(h.Fields, HeaderField{Name: name, Value: value})
+		return
+	}
+
+	h.Fields[idx].Value = value
+	h.Fields = slices.Concat(h.Fields[:idx+1], slices.DeleteFunc(h.Fields[idx+1:], func(field HeaderField) bool {
+		return field.Name == name
+	}))
+}
+
+// SortAndCombine returns the headers sorted by name, with the values of headers that share a name combined, except
+// for Set-Cookie. This is what a Headers object iterates over.
+func (h *HeadersList) SortAndCombine() []HeaderField {
+	names := []string{}
+	for _, field := range h.Fields {
+		if !slices.Contains(names, field.Name) {
+			names = append(names, field.Name)
+		}
+	}
+	slices.Sort(names)
+
+	result := []HeaderField{}
+	for _, name := range names {
+		if name == "set-cookie" {
+			for _, value := range h.GetSetCookie() {
+				result = append(result, HeaderField{Name: name, Value: value})
+			}
+			continue
+		}
+
+		value, _ := h.Get(name)
+		result = append(result, HeaderField{Name: name, Value: value})
+	}
+	return result
+}
+
+// Clone returns a copy of the list that can be changed.
+func (h *HeadersList) Clone() *HeadersList {
+	return &HeadersList{Fields: slices.Clone(h.Fields)}
+}
+
+// HTTPHeader returns the headers as they are sent by net/http.
+func (h *HeadersList) HTTPHeader() http.Header {
+	header := http.Header{}
+	for _, field := range h.Fields {
+		header.Add(latin1ToBytes(field.Name), latin1ToBytes(field.Value))
+	}
+	return header
+}
+
+// NewHeadersListFromHTTP returns the headers received by net/http, ordered by name since http.Header doesn't keep the
+// order they were received in.
+func NewHeadersListFromHTTP(header http.Header) *HeadersList {
+	names := []string{}
+	for name := range header {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	list := &HeadersList{}
+	for _, name := range names {
+		for _, value := range header[name] {
+			list.Append(bytesToLatin1(name), bytesToLatin1(normalizeHeaderValue(value)))
+		}
+	}
+	return list
+}
+
+// IsValidHeaderName reports whether name is a token, as header names must be.
+func IsValidHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, char := range name {
+		if char >= 0x80 || !isHeaderTokenChar(byte(char)) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidHeaderValue reports whether value, once normalized, can be sent as a header value.
+func IsValidHeaderValue(value string) bool {
+	return !strings.ContainsAny(value, "\x00\r\n")
+}
+
+func isHeaderTokenChar(char byte) bool {
+	if char >= '0' && char <= '9' || char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' {
+		return true
+	}
+	return strings.IndexByte("!#$%&'*+-.^_`|~", char) != -1
+}
+
+// normalizeHeaderValue removes the leading and trailing HTTP whitespace from a header value.
+func normalizeHeaderValue(value string) string {
+	return strings.Trim(value, "\t\n\r ")
+}
+
+// latin1ToBytes returns the bytes a string of code points up to U+00FF stands for.
+func latin1ToBytes(value string) string {
+	bytes := make([]byte, 0, len(value))
+	for _, char := range value {
+		bytes = append(bytes, byte(char))
+	}
+	return string(bytes)
+}
+
+// bytesToLatin1 returns a string with a code point for each byte of value.
+func bytesToLatin1(value string) string {
+	var builder strings.Builder
+	for idx := 0; idx < len(value); idx++ {
+		if value[idx] < utf8.RuneSelf {
+			builder.WriteByte(value[idx])
+		} else {
+			builder.WriteRune(rune(value[idx]))
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,202 @@
+package runtime
+
+func NewHeadersConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		HeadersConstructor,
+		0,
+		NewStringValue("Headers"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// Headers.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicHeadersPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+// Headers(init)
+func HeadersConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "Headers constructor requires 'new'"))
+	}
+
+	list := &HeadersList{}
+	if len(arguments) > 0 && arguments[0].Type != TypeUndefined {
+		completion := fillHeaders(runtime, list, arguments[0])
+		if completion != nil {
+			return completion
+		}
+	}
+
+	completion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicHeadersPrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	headersVal := completion.Value.(*JavaScriptValue)
+	headersVal.Value.(*Object).Headers = list
+
+	return NewNormalCompletion(headersVal)
+}
+
+// NewHeadersObject creates a Headers object for a header list.
+func NewHeadersObject(runtime *Runtime, list *HeadersList) *Object {
+	headers := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicHeadersPrototype)).(*Object)
+	headers.Headers = list
+	return headers
+}
+
+// fillHeaders appends the headers of init, which is either an iterable of pairs or a record of names to values, such
+// as a plain object. It only returns a completion if init threw or has an invalid header.
+func fillHeaders(runtime *Runtime, list *HeadersList, init *JavaScriptValue) *Completion {
+	if init.Type != TypeObject {
+		return NewThrowCompletion(NewTypeError(runtime, "Headers init must be an object"))
+	}
+
+	completion := GetMethod(runtime, init, runtime.SymbolIterator)
+	if completion.Type != Normal {
+		return completion
+	}
+	method := completion.Value.(*JavaScriptValue)
+
+	// A record, such as a plain object.
+	if method.Type == TypeUndefined {
+		object := init.Value.(ObjectInterface)
+		completion = EnumerableOwnProperties(runtime, object, EnumerableOwnPropertiesKindKey)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		for _, key := range completion.Value.([]*JavaScriptValue) {
+			completion := object.Get(runtime, key, init)
+			if completion.Type != Normal {
+				return completion
+			}
+
+			if completion := appendHeader(runtime, list, key, completion.Value.(*JavaScriptValue)); completion != nil {
+				return completion
+			}
+		}
+		return nil
+	}
+
+	// A sequence of pairs, such as an array or another Headers.
+	completion = GetIteratorFromMethod(runtime, method, init)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = IteratorToList(runtime, completion.Value.(*Iterator))
+	if completion.Type != Normal {
+		return completion
+	}
+
+	for _, item := range completion.Value.([]*JavaScriptValue) {
+		if item.Type != TypeObject {
+			return NewThrowCompletion(NewTypeError(runtime, "Each header pair must be an iterable [name, value] tuple"))
+		}
+
+		completion := GetIterator(runtime, item, IteratorKindSync)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		completion = IteratorToList(runtime, completion.Value.(*Iterator))
+		if completion.Type != Normal {
+			return completion
+		}
+
+		values := completion.Value.([]*JavaScriptValue)
+		if len(values) != 2 {
+			return NewThrowCompletion(NewTypeError(runtime, "Each header pair must be an iterable [name, value] tuple"))
+		}
+
+		if completion := appendHeader(runtime, list, values[0], values[1]); completion != nil {
+			return completion
+		}
+	}
+
+	return nil
+}
+
+// appendHeader converts a name and a value to a header and appends it, throwing if the header is invalid or the list
+// is immutable.
+func appendHeader(runtime *Runtime, list *HeadersList, name *JavaScriptValue, value *JavaScriptValue) *Completion {
+	nameString, valueString, completion := headerArguments(runtime, name, value)
+	if completion != nil {
+		return completion
+	}
+
+	if list.Immutable {
+		return NewThrowCompletion(NewTypeError(runtime, "Headers are immutable"))
+	}
+
+	list.Append(nameString, valueString)
+	return nil
+}
+
+// headerArguments converts a name and a value to a valid header name and a normalized header value.
+func headerArguments(runtime *Runtime, name *JavaScriptValue, value *JavaScriptValue) (string, string, *Completion) {
+	nameString, completion := headerName(runtime, name)
+	if completion != nil {
+		return "", "", completion
+	}
+
+	valueString, completion := toByteString(runtime, value)
+	if completion != nil {
+		return "", "", completion
+	}
+
+	valueString = normalizeHeaderValue(valueString)
+	if !IsValidHeaderValue(valueString) {
+		return "", "", NewThrowCompletion(NewTypeError(runtime, "Invalid header value for \""+nameString+"\""))
+	}
+
+	return nameString, valueString, nil
+}
+
+// headerName converts a value to a header name, throwing if it isn't a valid one.
+func headerName(runtime *Runtime, name *JavaScriptValue) (string, *Completion) {
+	nameString, completion := toByteString(runtime, name)
+	if completion != nil {
+		return "", completion
+	}
+
+	if !IsValidHeaderName(nameString) {
+		return "", NewThrowCompletion(NewTypeError(runtime, "Invalid header name: \""+nameString+"\""))
+	}
+
+	return nameString, nil
+}
+
+// toByteString converts a value to a string, throwing if it has a code point above U+00FF.
+func toByteString(runtime *Runtime, value *JavaScriptValue) (string, *Completion) {
+	completion := ToString(runtime, value)
+	if completion.Type != Normal {
+		return "", completion
+	}
+
+	str := completion.Value.(*JavaScriptValue).Value.(*String).Value
+	for _, char := range str {
+		if char > 0xFF {
+			return "", NewThrowCompletion(NewTypeError(runtime, "Cannot convert a string with code points above U+00FF to a ByteString"))
+		}
+	}
+
+	return str, nil
+}
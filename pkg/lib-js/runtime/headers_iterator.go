@@ -0,0 +1,68 @@
+package runtime
+
+// CreateHeadersIterator creates an iterator over the sorted and combined headers of a Headers object. The headers are
+// sorted and combined again on every step, so it sees the headers added or removed while iterating.
+func CreateHeadersIterator(runtime *Runtime, list *HeadersList, kind ArrayIteratorKind) ObjectInterface {
+	closure := []Instruction{
+		EmitEvaluateNativeCallback(func(runtime *Runtime, vm *ExecutionVM) *Completion {
+			vm.ScratchSpace["index"] = int(0)
+			return nil
+		}),
+		// Loop starts here.
+		EmitEvaluateNativeCallback(func(runtime *Runtime, vm *ExecutionVM) *Completion {
+			// Break the loop if no more headers are left.
+			index := vm.ScratchSpace["index"].(int)
+			fields := list.SortAndCombine()
+			if index >= len(fields) {
+				return NewNormalCompletion(NewBooleanValue(true))
+			}
+
+			field := fields[index]
+
+			var result *JavaScriptValue
+			switch kind {
+			case ArrayIteratorKindKey:
+				result = NewStringValue(field.Name)
+			case ArrayIteratorKindValue:
+				result = NewStringValue(field.Value)
+			default:
+				resultArray := CreateArrayFromList(runtime, []*JavaScriptValue{NewStringValue(field.Name), NewStringValue(field.Value)})
+				result = NewJavaScriptValue(TypeObject, resultArray)
+			}
+
+			vm.ScratchSpace["result"] = CreateIteratorResultObject(runtime, result, false)
+			return NewNormalCompletion(NewBooleanValue(false))
+		}),
+		// If the previous completion was true, break the loop and complete the closure.
+		EmitJumpIfTrue(3),
+		// Yield the result.
+		EmitYield(func(runtime *Runtime, vm *ExecutionVM) *JavaScriptValue {
+			result := vm.ScratchSpace["result"].(*JavaScriptValue)
+			vm.ScratchSpace["result"] = nil
+			return result
+		}),
+		// Increment the index.
+		EmitEvaluateNativeCallback(func(runtime *Runtime, vm *ExecutionVM) *Completion {
+			vm.ScratchSpace["index"] = vm.ScratchSpace["index"].(int) + 1
+			return nil
+		}),
+	}
+
+	// Loop back to just after the initial setup.
+	closure = append(closure, EmitJump(-len(closure)))
+
+	// Clean up the scratch space and return undefined.
+	cleanup := EmitEvaluateNativeCallback(func(runtime *Runtime, vm *ExecutionVM) *Completion {
+		delete(vm.ScratchSpace, "index")
+		delete(vm.ScratchSpace, "result")
+		return NewNormalCompletion(NewUndefinedValue())
+	})
+	closure = append(closure, cleanup)
+
+	return CreateIteratorFromClosure(
+		runtime,
+		closure,
+		"%HeadersIteratorPrototype%",
+		runtime.GetRunningRealm().GetIntrinsic(IntrinsicHeadersIteratorPrototype),
+	)
+}
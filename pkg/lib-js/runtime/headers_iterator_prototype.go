@@ -0,0 +1,33 @@
+package runtime
+
+func NewHeadersIteratorPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicIteratorPrototype))
+}
+
+func DefineHeadersIteratorPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// %HeadersIteratorPrototype%.next
+	DefineBuiltinFunction(runtime, prototype, "next", HeadersIteratorPrototypeNext, 0)
+
+	// %HeadersIteratorPrototype%[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("Headers Iterator"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func HeadersIteratorPrototypeNext(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, ok := thisArg.Value.(*Object)
+	if thisArg.Type != TypeObject || !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "%HeadersIteratorPrototype%.next called on incompatible receiver"))
+	}
+
+	return GeneratorResume(runtime, object, nil, "%HeadersIteratorPrototype%")
+}
@@ -0,0 +1,324 @@
+package runtime
+
+import "strconv"
+
+func NewHeadersPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineHeadersPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Headers.prototype.append
+	DefineBuiltinFunction(runtime, prototype, "append", HeadersPrototypeAppend, 2)
+
+	// Headers.prototype.delete
+	DefineBuiltinFunction(runtime, prototype, "delete", HeadersPrototypeDelete, 1)
+
+	// Headers.prototype.get
+	DefineBuiltinFunction(runtime, prototype, "get", HeadersPrototypeGet, 1)
+
+	// Headers.prototype.getSetCookie
+	DefineBuiltinFunction(runtime, prototype, "getSetCookie", HeadersPrototypeGetSetCookie, 0)
+
+	// Headers.prototype.has
+	DefineBuiltinFunction(runtime, prototype, "has", HeadersPrototypeHas, 1)
+
+	// Headers.prototype.set
+	DefineBuiltinFunction(runtime, prototype, "set", HeadersPrototypeSet, 2)
+
+	// Headers.prototype.forEach
+	DefineBuiltinFunction(runtime, prototype, "forEach", HeadersPrototypeForEach, 1)
+
+	// Headers.prototype.entries
+	DefineBuiltinFunction(runtime, prototype, "entries", HeadersPrototypeEntries, 0)
+
+	// Headers.prototype.keys
+	DefineBuiltinFunction(runtime, prototype, "keys", HeadersPrototypeKeys, 0)
+
+	// Headers.prototype.values
+	DefineBuiltinFunction(runtime, prototype, "values", HeadersPrototypeValues, 0)
+
+	// Headers.prototype[%Symbol.iterator%]
+	DefineBuiltinSymbolFunction(runtime, prototype, runtime.SymbolIterator, HeadersPrototypeEntries, 0)
+
+	// Headers.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("Headers"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func thisHeaders(runtime *Runtime, thisArg *JavaScriptValue, method string) (*HeadersList, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.Headers != nil {
+			return object.Headers, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Headers.prototype."+method+" called on incompatible receiver"))
+}
+
+// requireHeadersArguments throws if fewer than required arguments were passed to a Headers method.
+func requireHeadersArguments(runtime *Runtime, arguments []*JavaScriptValue, method string, required int) *Completion {
+	if len(arguments) >= required {
+		return nil
+	}
+
+	return NewThrowCompletion(NewTypeError(
+		runtime,
+		"Headers.prototype."+method+" requires "+strconv.Itoa(required)+" arguments, but only "+strconv.Itoa(len(arguments))+" present",
+	))
+}
+
+// Headers.prototype.append(name, value)
+func HeadersPrototypeAppend(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisHeaders(runtime, thisArg, "append")
+	if completion != nil {
+		return completion
+	}
+
+	if completion := requireHeadersArguments(runtime, arguments, "append", 2); completion != nil {
+		return completion
+	}
+
+	if completion := appendHeader(runtime, list, arguments[0], arguments[1]); completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// Headers.prototype.delete(name)
+func HeadersPrototypeDelete(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisHeaders(runtime, thisArg, "delete")
+	if completion != nil {
+		return completion
+	}
+
+	if completion := requireHeadersArguments(runtime, arguments, "delete", 1); completion != nil {
+		return completion
+	}
+
+	name, completion := headerName(runtime, arguments[0])
+	if completion != nil {
+		return completion
+	}
+
+	if list.Immutable {
+		return NewThrowCompletion(NewTypeError(runtime, "Headers are immutable"))
+	}
+
+	list.Delete(name)
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// Headers.prototype.get(name)
+func HeadersPrototypeGet(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisHeaders(runtime, thisArg, "get")
+	if completion != nil {
+		return completion
+	}
+
+	if completion := requireHeadersArguments(runtime, arguments, "get", 1); completion != nil {
+		return completion
+	}
+
+	name, completion := headerName(runtime, arguments[0])
+	if completion != nil {
+		return completion
+	}
+
+	value, ok := list.Get(name)
+	if !ok {
+		return NewNormalCompletion(NewNullValue())
+	}
+	return NewNormalCompletion(NewStringValue(value))
+}
+
+// Headers.prototype.getSetCookie()
+func HeadersPrototypeGetSetCookie(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisHeaders(runtime, thisArg, "getSetCookie")
+	if completion != nil {
+		return completion
+	}
+
+	values := []*JavaScriptValue{}
+	for _, value := range list.GetSetCookie() {
+		values = append(values, NewStringValue(value))
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, CreateArrayFromList(runtime, values)))
+}
+
+// Headers.prototype.has(name)
+func HeadersPrototypeHas(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisHeaders(runtime, thisArg, "has")
+	if completion != nil {
+		return completion
+	}
+
+	if completion := requireHeadersArguments(runtime, arguments, "has", 1); completion != nil {
+		return completion
+	}
+
+	name, completion := headerName(runtime, arguments[0])
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(list.Has(name)))
+}
+
+// Headers.prototype.set(name, value)
+func HeadersPrototypeSet(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisHeaders(runtime, thisArg, "set")
+	if completion != nil {
+		return completion
+	}
+
+	if completion := requireHeadersArguments(runtime, arguments, "set", 2); completion != nil {
+		return completion
+	}
+
+	name, value, completion := headerArguments(runtime, arguments[0], arguments[1])
+	if completion != nil {
+		return completion
+	}
+
+	if list.Immutable {
+		return NewThrowCompletion(NewTypeError(runtime, "Headers are immutable"))
+	}
+
+	list.Set(name, value)
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// Headers.prototype.forEach(callbackfn, thisArg)
+func HeadersPrototypeForEach(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisHeaders(runtime, thisArg, "forEach")
+	if completion != nil {
+		return completion
+	}
+
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	callbackFunc, ok := arguments[0].Value.(FunctionInterface)
+	if arguments[0].Type != TypeObject || !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "Callback is not a function."))
+	}
+
+	// The callback may change the headers, so they are sorted and combined again on every iteration.
+	for idx := 0; ; idx++ {
+		fields := list.SortAndCombine()
+		if idx >= len(fields) {
+			break
+		}
+
+		field := fields[idx]
+		completion := callbackFunc.Call(runtime, arguments[1], []*JavaScriptValue{
+			NewStringValue(field.Value),
+			NewStringValue(field.Name),
+			thisArg,
+		})
+		if completion.Type != Normal {
+			return completion
+		}
+	}
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// Headers.prototype.entries()
+func HeadersPrototypeEntries(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisHeaders(runtime, thisArg, "entries")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, CreateHeadersIterator(runtime, list, ArrayIteratorKindEntry)))
+}
+
+// Headers.prototype.keys()
+func HeadersPrototypeKeys(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisHeaders(runtime, thisArg, "keys")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, CreateHeadersIterator(runtime, list, ArrayIteratorKindKey)))
+}
+
+// Headers.prototype.values()
+func HeadersPrototypeValues(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisHeaders(runtime, thisArg, "values")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, CreateHeadersIterator(runtime, list, ArrayIteratorKindValue)))
+}
@@ -0,0 +1,229 @@
+package runtime
+
+import (
+	"strings"
+	"weak"
+
+	"zbrannelly.dev/go-js/pkg/lib-js/parser/ast"
+)
+
+// Sites that have seen more layouts than this stop learning new ones and fall back to the full lookup
+// for anything they don't recognise.
+const maxInlineCacheEntries = 4
+
+// PropertyCache is the inline cache of a member expression site with an identifier name (obj.name).
+// It remembers, for each object layout seen at the site, which slot the property was found in and on
+// which object of the prototype chain.
+//
+// Caches are never modified once stored on a site, a miss stores an extended copy instead. This keeps
+// sites that are shared between runtimes safe, since shapes themselves are shared. Sites outlive the
+// runtimes that run them, so caches only hold objects weakly: a cached prototype that has been
+// collected, or that belongs to another runtime, simply doesn't match.
+type PropertyCache struct {
+	get []propertyCacheEntry
+	set []propertyCacheEntry
+}
+
+type propertyCacheEntry struct {
+	shape *Shape
+	slot  int
+
+	// For properties found on a prototype, the objects from the receiver's prototype up to the holder,
+	// each with the shape it had.
+	prototypes []prototypeGuard
+}
+
+// prototypeGuard identifies a prototype by its property store, which belongs to exactly one object.
+type prototypeGuard struct {
+	store weak.Pointer[PropertyStore]
+	shape *Shape
+}
+
+// cacheableGetStore returns the property store of objects whose [[Get]], [[GetOwnProperty]] and
+// [[GetPrototypeOf]] are the ordinary ones, so that a lookup can be answered from their slots.
+func cacheableGetStore(object ObjectInterface) *PropertyStore {
+	switch o := object.(type) {
+	case *Object:
+		if o != nil {
+			return o.Properties
+		}
+	case *FunctionObject:
+		if o != nil {
+			return o.Properties
+		}
+	case *ArrayObject:
+		if o != nil {
+			return o.Properties
+		}
+	case *ObjectPrototype:
+		if o != nil {
+			return o.Properties
+		}
+	case *BoundFunction:
+		if o != nil {
+			return o.Properties
+		}
+	}
+	return nil
+}
+
+// cacheableSetStore returns the property store of objects that also have the ordinary [[DefineOwnProperty]],
+// so that an assignment to an existing writable property only has to replace its value.
+func cacheableSetStore(object ObjectInterface) *PropertyStore {
+	if _, ok := object.(*ArrayObject); ok {
+		return nil
+	}
+	return cacheableGetStore(object)
+}
+
+func loadPropertyCache(site *ast.MemberExpressionNode) *PropertyCache {
+	cache, _ := site.InlineCache.Load().(*PropertyCache)
+	return cache
+}
+
+// IsCacheableSite reports whether property accesses at a member expression site can use an inline cache.
+func IsCacheableSite(site *ast.MemberExpressionNode) bool {
+	return site.PropertyIdentifier != "" && !site.Super && !strings.HasPrefix(site.PropertyIdentifier, "#")
+}
+
+// match returns the store holding the cached property if object still has the layout described by the entry.
+func (e *propertyCacheEntry) match(object ObjectInterface, store *PropertyStore) *PropertyStore {
+	if store.Shape() != e.shape {
+		return nil
+	}
+
+	for _, guard := range e.prototypes {
+		object = object.GetPrototype()
+		if object == nil {
+			return nil
+		}
+
+		store = object.GetProperties()
+		if store != guard.store.Value() || store.Shape() != guard.shape {
+			return nil
+		}
+	}
+
+	return store
+}
+
+// InlineCacheGet performs [[Get]] of the site's property on object, using and updating the site's cache.
+// It returns nil if the lookup can't be answered from shapes, in which case the caller must use [[Get]].
+func InlineCacheGet(runtime *Runtime, site *ast.MemberExpressionNode, object ObjectInterface, receiver *JavaScriptValue) *Completion {
+	store := cacheableGetStore(object)
+	if store == nil || store.Shape() == nil {
+		return nil
+	}
+
+	cache := loadPropertyCache(site)
+	if cache != nil {
+		for idx := range cache.get {
+			entry := &cache.get[idx]
+			if holder := entry.match(object, store); holder != nil {
+				return getFromDescriptor(runtime, holder.Slot(entry.slot), receiver)
+			}
+		}
+	}
+
+	// Walk the prototype chain the way OrdinaryGet would, remembering the layouts on the way.
+	key := shapeKey{name: site.PropertyIdentifier}
+	entry := propertyCacheEntry{shape: store.Shape()}
+
+	for {
+		if slot, ok := store.Shape().Lookup(key); ok {
+			entry.slot = slot
+			break
+		}
+
+		prototype := object.GetPrototype()
+		if prototype == nil {
+			return nil
+		}
+
+		store = cacheableGetStore(prototype)
+		if store == nil || store.Shape() == nil {
+			return nil
+		}
+
+		entry.prototypes = append(entry.prototypes, prototypeGuard{store: weak.Make(store), shape: store.Shape()})
+		object = prototype
+	}
+
+	if cache == nil || len(cache.get) < maxInlineCacheEntries {
+		updated := &PropertyCache{}
+		if cache != nil {
+			*updated = *cache
+		}
+		updated.get = append(append(make([]propertyCacheEntry, 0, len(updated.get)+1), updated.get...), entry)
+		site.InlineCache.Store(updated)
+	}
+
+	return getFromDescriptor(runtime, store.Slot(entry.slot), receiver)
+}
+
+// InlineCacheSet performs [[Set]] of the site's property on object when it is an existing, writable own data property.
+// It returns nil for every other case, in which case the caller must use [[Set]].
+func InlineCacheSet(runtime *Runtime, site *ast.MemberExpressionNode, object ObjectInterface, value *JavaScriptValue, receiver *JavaScriptValue) *Completion {
+	if receiver.Type != TypeObject || receiver.Value.(ObjectInterface) != object {
+		return nil
+	}
+
+	store := cacheableSetStore(object)
+	if store == nil || store.Shape() == nil {
+		return nil
+	}
+
+	slot := -1
+	cache := loadPropertyCache(site)
+	if cache != nil {
+		for idx := range cache.set {
+			if cache.set[idx].shape == store.Shape() {
+				slot = cache.set[idx].slot
+				break
+			}
+		}
+	}
+
+	cached := slot >= 0
+	if !cached {
+		var ok bool
+		if slot, ok = store.Shape().Lookup(shapeKey{name: site.PropertyIdentifier}); !ok {
+			return nil
+		}
+	}
+
+	descriptor, ok := store.Slot(slot).(*DataPropertyDescriptor)
+	if !ok || !descriptor.Writable {
+		return nil
+	}
+
+	if !cached && (cache == nil || len(cache.set) < maxInlineCacheEntries) {
+		updated := &PropertyCache{}
+		if cache != nil {
+			*updated = *cache
+		}
+		entry := propertyCacheEntry{shape: store.Shape(), slot: slot}
+		updated.set = append(append(make([]propertyCacheEntry, 0, len(updated.set)+1), updated.set...), entry)
+		site.InlineCache.Store(updated)
+	}
+
+	store.SetSlot(slot, &DataPropertyDescriptor{
+		Value:        value,
+		Writable:     descriptor.Writable,
+		Enumerable:   descriptor.Enumerable,
+		Configurable: descriptor.Configurable,
+	})
+	return NewNormalCompletion(NewBooleanValue(true))
+}
+
+func getFromDescriptor(runtime *Runtime, descriptor PropertyDescriptor, receiver *JavaScriptValue) *Completion {
+	if dataDescriptor, ok := descriptor.(*DataPropertyDescriptor); ok {
+		return NewNormalCompletion(dataDescriptor.Value)
+	}
+
+	accessorDescriptor := descriptor.(*AccessorPropertyDescriptor)
+	if accessorDescriptor.Get == nil {
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+	return accessorDescriptor.Get.Call(runtime, receiver, []*JavaScriptValue{})
+}
@@ -0,0 +1,1127 @@
+package runtime
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// InspectOptions controls how Inspect describes values.
+type InspectOptions struct {
+	// How many levels of nested objects to describe, deeper ones are abbreviated, e.g. as [Object]. Negative is
+	// unlimited.
+	Depth int
+
+	// Whether to style the description with ANSI color codes.
+	Colors bool
+
+	// Whether to describe non-enumerable properties too, with their keys in brackets, e.g. [length]: 2.
+	ShowHidden bool
+
+	// The length at which the entries of an object are split over several lines.
+	BreakLength int
+
+	// The most elements of an array, typed array or ArrayBuffer to describe.
+	MaxArrayLength int
+}
+
+// DefaultInspectOptions returns the options Inspect uses when none are given, the same defaults as Node's util.inspect.
+func DefaultInspectOptions() *InspectOptions {
+	return &InspectOptions{
+		Depth:          2,
+		BreakLength:    80,
+		MaxArrayLength: 100,
+	}
+}
+
+// Inspect describes a value for humans, the way Node's util.inspect does. It never runs script code: getters aren't
+// called and proxies are described by their target, so it is safe to use on any value, e.g. in a debugger.
+// Nil options use DefaultInspectOptions.
+func Inspect(runtime *Runtime, value *JavaScriptValue, options *InspectOptions) string {
+	if options == nil {
+		options = DefaultInspectOptions()
+	}
+
+	inspector := &inspector{
+		runtime:  runtime,
+		options:  options,
+		circular: make(map[ObjectInterface]int),
+	}
+	return inspector.formatValue(value, 0)
+}
+
+type inspectStyle int
+
+const (
+	inspectStyleNone inspectStyle = iota
+	inspectStyleSpecial
+	inspectStyleNumber
+	inspectStyleBoolean
+	inspectStyleUndefined
+	inspectStyleNull
+	inspectStyleString
+	inspectStyleSymbol
+)
+
+// The ANSI codes that turn each style on and off.
+var inspectStyleCodes = map[inspectStyle][2]int{
+	inspectStyleSpecial:   {36, 39},
+	inspectStyleNumber:    {33, 39},
+	inspectStyleBoolean:   {33, 39},
+	inspectStyleUndefined: {90, 39},
+	inspectStyleNull:      {1, 22},
+	inspectStyleString:    {32, 39},
+	inspectStyleSymbol:    {32, 39},
+}
+
+var (
+	ansiPattern       = regexp.MustCompile(`\x1b\[\d+m`)
+	identifierPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z_0-9]*$`)
+
+	constructorStr = NewStringValue("constructor")
+	prototypeStr   = NewStringValue("prototype")
+	stackStr       = NewStringValue("stack")
+	errorsStr      = NewStringValue("errors")
+)
+
+type inspectEntries int
+
+const (
+	inspectObjectEntries inspectEntries = iota
+	inspectArrayEntries
+)
+
+type inspector struct {
+	runtime *Runtime
+	options *InspectOptions
+
+	// The objects being described, outermost first, and the reference numbers of those that were found inside
+	// themselves.
+	seen     []ObjectInterface
+	circular map[ObjectInterface]int
+
+	// The indentation of the entry being described, and the depth of the most recently described object.
+	indentation  int
+	currentDepth int
+}
+
+func (i *inspector) stylize(str string, style inspectStyle) string {
+	codes, ok := inspectStyleCodes[style]
+	if !i.options.Colors || !ok {
+		return str
+	}
+	return fmt.Sprintf("\x1b[%dm%s\x1b[%dm", codes[0], str, codes[1])
+}
+
+func (i *inspector) formatValue(value *JavaScriptValue, depth int) string {
+	if value.Type != TypeObject {
+		return i.formatPrimitive(value)
+	}
+
+	object := value.Value.(ObjectInterface)
+
+	// Describe proxies by their target, without running their traps.
+	for {
+		proxy, ok := object.(*ProxyObject)
+		if !ok {
+			break
+		}
+		if proxy.ProxyTarget == nil || proxy.ProxyTarget.Type != TypeObject {
+			return i.stylize("<Revoked Proxy>", inspectStyleSpecial)
+		}
+		object = proxy.ProxyTarget.Value.(ObjectInterface)
+	}
+
+	if slices.Contains(i.seen, object) {
+		index, ok := i.circular[object]
+		if !ok {
+			index = len(i.circular) + 1
+			i.circular[object] = index
+		}
+		return i.stylize(fmt.Sprintf("[Circular *%d]", index), inspectStyleSpecial)
+	}
+
+	return i.formatObject(object, depth)
+}
+
+func (i *inspector) formatPrimitive(value *JavaScriptValue) string {
+	switch value.Type {
+	case TypeUndefined:
+		return i.stylize("undefined", inspectStyleUndefined)
+	case TypeNull:
+		return i.stylize("null", inspectStyleNull)
+	case TypeBoolean:
+		return i.stylize(strconv.FormatBool(value.Value.(*Boolean).Value), inspectStyleBoolean)
+	case TypeNumber:
+		return i.stylize(inspectNumber(value.Value.(*Number)), inspectStyleNumber)
+	case TypeBigInt:
+		return i.stylize(value.Value.(*BigInt).Value.String()+"n", inspectStyleNumber)
+	case TypeString:
+		return i.stylize(quoteString(value.Value.(*String).Value), inspectStyleString)
+	case TypeSymbol:
+		return i.stylize(SymbolDescriptiveString(value.Value.(*Symbol)).Value.(*String).Value, inspectStyleSymbol)
+	}
+	return "unknown"
+}
+
+func inspectNumber(number *Number) string {
+	if number.Value == 0 && math.Signbit(number.Value) && !number.NaN {
+		return "-0"
+	}
+	return NumberToString(number, 10).Value.(*String).Value
+}
+
+// quoteString quotes a string with single quotes, or double quotes or backticks if that avoids escaping, and escapes
+// control characters.
+func quoteString(str string) string {
+	quote := '\''
+	if strings.ContainsRune(str, '\'') {
+		if !strings.ContainsRune(str, '"') {
+			quote = '"'
+		} else if !strings.ContainsRune(str, '`') && !strings.Contains(str, "${") {
+			quote = '`'
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteRune(quote)
+	for _, char := range str {
+		switch {
+		case char == quote || char == '\\':
+			builder.WriteRune('\\')
+			builder.WriteRune(char)
+		case char == '\b':
+			builder.WriteString(`\b`)
+		case char == '\t':
+			builder.WriteString(`\t`)
+		case char == '\n':
+			builder.WriteString(`\n`)
+		case char == '\f':
+			builder.WriteString(`\f`)
+		case char == '\r':
+			builder.WriteString(`\r`)
+		case char < 0x20 || (char >= 0x7f && char <= 0x9f):
+			fmt.Fprintf(&builder, `\x%02X`, char)
+		default:
+			builder.WriteRune(char)
+		}
+	}
+	builder.WriteRune(quote)
+
+	return builder.String()
+}
+
+func (i *inspector) formatObject(object ObjectInterface, depth int) string {
+	constructor, hasConstructor := inspectConstructorName(object)
+	tag := inspectToStringTag(i.runtime, object)
+	if hasConstructor && tag == constructor {
+		tag = ""
+	}
+
+	keys := i.ownKeys(object)
+
+	base := ""
+	braces := [2]string{"{", "}"}
+	entries := inspectObjectEntries
+	var formatter func(depth int) []string
+
+	switch object := object.(type) {
+	case *ArrayObject, *ArgumentsObject:
+		length := i.arrayLikeLength(object)
+		keys = slices.DeleteFunc(keys, isArrayIndexKey)
+
+		prefix := ""
+		if _, ok := object.(*ArgumentsObject); ok {
+			prefix = "[Arguments] "
+		} else if constructor != "Array" || tag != "" {
+			prefix = inspectPrefix(constructor, hasConstructor, tag, "Array", fmt.Sprintf("(%d)", length))
+		}
+
+		braces = [2]string{prefix + "[", "]"}
+		if length == 0 && len(keys) == 0 {
+			return braces[0] + "]"
+		}
+
+		entries = inspectArrayEntries
+		formatter = func(depth int) []string {
+			return i.formatArrayLike(object, length, depth)
+		}
+	case *TypedArrayObject:
+		witness := MakeTypedArrayWithBufferWitness(object, true)
+		length := uint(0)
+		if !witness.IsTypedArrayOutOfBounds() {
+			length = witness.TypedArrayLength()
+		}
+		keys = slices.DeleteFunc(keys, isArrayIndexKey)
+
+		braces = [2]string{inspectPrefix(constructor, hasConstructor, tag, string(object.TypedArrayName), fmt.Sprintf("(%d)", length)) + "[", "]"}
+		if length == 0 && len(keys) == 0 {
+			return braces[0] + "]"
+		}
+
+		entries = inspectArrayEntries
+		formatter = func(depth int) []string {
+			return i.formatTypedArray(object, length)
+		}
+	default:
+		if IsCallable(NewJavaScriptValue(TypeObject, object)) {
+			base = i.functionBase(object, constructor, hasConstructor, tag)
+			if len(keys) == 0 {
+				return i.stylize(base, inspectStyleSpecial)
+			}
+			break
+		}
+
+		if stringObject, ok := object.(*StringObject); ok {
+			keys = slices.DeleteFunc(keys, isArrayIndexKey)
+			base = i.boxedBase("String", stringObject.StringData, constructor, hasConstructor, tag)
+			if len(keys) == 0 {
+				return base
+			}
+			break
+		}
+
+		ordinary, ok := object.(*Object)
+		if !ok {
+			braces[0] = inspectPrefix(constructor, hasConstructor, tag, "Object", "") + "{"
+			break
+		}
+
+		switch {
+		case ordinary.IsError:
+			base = i.errorBase(ordinary, constructor, hasConstructor, tag)
+			keys = i.errorKeys(ordinary, keys)
+			if len(keys) == 0 {
+				return base
+			}
+		case ordinary.NumberData != nil:
+			base = i.boxedBase("Number", ordinary.NumberData, constructor, hasConstructor, tag)
+		case ordinary.BooleanData != nil:
+			base = i.boxedBase("Boolean", ordinary.BooleanData, constructor, hasConstructor, tag)
+		case ordinary.BigIntData != nil:
+			base = i.boxedBase("BigInt", ordinary.BigIntData, constructor, hasConstructor, tag)
+		case ordinary.SymbolData != nil:
+			base = i.boxedBase("Symbol", ordinary.SymbolData, constructor, hasConstructor, tag)
+		case ordinary.IsArrayBuffer:
+			fallback := "ArrayBuffer"
+			if IsSharedArrayBuffer(ordinary) {
+				fallback = "SharedArrayBuffer"
+			}
+			braces[0] = inspectPrefix(constructor, hasConstructor, tag, fallback, "") + "{"
+			formatter = func(depth int) []string {
+				return i.formatArrayBuffer(ordinary)
+			}
+		case ordinary.IsDataView:
+			braces[0] = inspectPrefix(constructor, hasConstructor, tag, "DataView", "") + "{"
+			formatter = func(depth int) []string {
+				return i.formatDataView(ordinary, depth)
+			}
+		case ordinary.IsPromise:
+			braces[0] = inspectPrefix(constructor, hasConstructor, tag, "Promise", "") + "{"
+			formatter = func(depth int) []string {
+				return i.formatPromise(ordinary, depth)
+			}
+		case ordinary.IsWeakRef:
+			braces[0] = inspectPrefix(constructor, hasConstructor, tag, "WeakRef", "") + "{"
+			formatter = func(depth int) []string {
+				return i.formatWeakRef(ordinary, depth)
+			}
+		case ordinary.URL != nil:
+			braces[0] = inspectPrefix(constructor, hasConstructor, tag, "URL", "") + "{"
+			formatter = func(depth int) []string {
+				return i.formatURL(ordinary, depth)
+			}
+		case ordinary.URLSearchParams != nil:
+			braces[0] = inspectPrefix(constructor, hasConstructor, tag, "URLSearchParams", "") + "{"
+			if len(ordinary.URLSearchParams.Entries) == 0 && len(keys) == 0 {
+				return braces[0] + "}"
+			}
+			formatter = func(depth int) []string {
+				return i.formatURLSearchParams(ordinary.URLSearchParams)
+			}
+		case ordinary.Headers != nil:
+			braces[0] = inspectPrefix(constructor, hasConstructor, tag, "Headers", "") + "{"
+			if len(ordinary.Headers.Fields) == 0 && len(keys) == 0 {
+				return braces[0] + "}"
+			}
+			formatter = func(depth int) []string {
+				return i.formatHeaders(ordinary.Headers)
+			}
+		default:
+			if !hasConstructor || constructor != "Object" || tag != "" {
+				braces[0] = inspectPrefix(constructor, hasConstructor, tag, "Object", "") + "{"
+			}
+			if len(keys) == 0 {
+				return braces[0] + "}"
+			}
+		}
+
+		if base != "" && len(keys) == 0 {
+			return base
+		}
+	}
+
+	if i.options.Depth >= 0 && depth > i.options.Depth {
+		name := strings.TrimSuffix(inspectPrefix(constructor, hasConstructor, tag, "Object", ""), " ")
+		if hasConstructor {
+			name = "[" + name + "]"
+		}
+		return i.stylize(name, inspectStyleSpecial)
+	}
+
+	depth++
+	i.seen = append(i.seen, object)
+	i.currentDepth = depth
+
+	var output []string
+	if formatter != nil {
+		output = formatter(depth)
+	}
+	for _, key := range keys {
+		output = append(output, i.formatProperty(object, key, depth, inspectObjectEntries))
+	}
+
+	i.seen = i.seen[:len(i.seen)-1]
+
+	if index, ok := i.circular[object]; ok {
+		reference := i.stylize(fmt.Sprintf("<ref *%d>", index), inspectStyleSpecial)
+		if base == "" {
+			base = reference
+		} else {
+			base = reference + " " + base
+		}
+	}
+
+	return i.reduceToSingleString(output, base, braces, entries, depth)
+}
+
+// ownKeys returns the enumerable own keys of an object, or all of them with ShowHidden, strings first and then symbols.
+func (i *inspector) ownKeys(object ObjectInterface) []*JavaScriptValue {
+	completion := object.OwnPropertyKeys(i.runtime)
+	if completion.Type != Normal {
+		return nil
+	}
+
+	keys := []*JavaScriptValue{}
+	for _, key := range completion.Value.([]*JavaScriptValue) {
+		if descriptor := i.ownProperty(object, key); descriptor != nil && (descriptor.GetEnumerable() || i.options.ShowHidden) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (i *inspector) ownProperty(object ObjectInterface, key *JavaScriptValue) PropertyDescriptor {
+	completion := object.GetOwnProperty(i.runtime, key)
+	if completion.Type != Normal || completion.Value == nil {
+		return nil
+	}
+
+	descriptor, _ := completion.Value.(PropertyDescriptor)
+	return descriptor
+}
+
+func (i *inspector) formatProperty(object ObjectInterface, key *JavaScriptValue, depth int, entries inspectEntries) string {
+	descriptor := i.ownProperty(object, key)
+
+	var str string
+	switch descriptor := descriptor.(type) {
+	case *DataPropertyDescriptor:
+		i.indentation += 2
+		str = i.formatValue(descriptor.Value, depth)
+		i.indentation -= 2
+	case *AccessorPropertyDescriptor:
+		switch {
+		case descriptor.Get != nil && descriptor.Set != nil:
+			str = i.stylize("[Getter/Setter]", inspectStyleSpecial)
+		case descriptor.Get != nil:
+			str = i.stylize("[Getter]", inspectStyleSpecial)
+		case descriptor.Set != nil:
+			str = i.stylize("[Setter]", inspectStyleSpecial)
+		default:
+			str = i.stylize("undefined", inspectStyleUndefined)
+		}
+	default:
+		str = i.stylize("undefined", inspectStyleUndefined)
+	}
+
+	if entries == inspectArrayEntries {
+		return str
+	}
+
+	return i.formatKey(key, descriptor == nil || descriptor.GetEnumerable()) + ": " + str
+}
+
+func (i *inspector) formatKey(key *JavaScriptValue, enumerable bool) string {
+	if key.Type == TypeSymbol {
+		return "[" + i.stylize(SymbolDescriptiveString(key.Value.(*Symbol)).Value.(*String).Value, inspectStyleSymbol) + "]"
+	}
+
+	name := key.Value.(*String).Value
+	switch {
+	case name == "__proto__":
+		return "['__proto__']"
+	case !enumerable:
+		return "[" + name + "]"
+	case identifierPattern.MatchString(name):
+		return name
+	}
+	return i.stylize(quoteString(name), inspectStyleString)
+}
+
+func (i *inspector) arrayLikeLength(object ObjectInterface) uint64 {
+	if array, ok := object.(*ArrayObject); ok {
+		return uint64(array.GetLength())
+	}
+
+	descriptor, ok := i.ownProperty(object, lengthStr).(*DataPropertyDescriptor)
+	if !ok || descriptor.Value.Type != TypeNumber {
+		return 0
+	}
+
+	length := descriptor.Value.Value.(*Number).Value
+	if length != length || length < 0 {
+		return 0
+	}
+	return uint64(min(length, math.MaxUint32))
+}
+
+// formatArrayLike describes the elements of an array, with holes collapsed to <n empty items>.
+func (i *inspector) formatArrayLike(object ObjectInterface, length uint64, depth int) []string {
+	maxLength := max(i.options.MaxArrayLength, 0)
+	output := []string{}
+
+	emptyItems := func(count uint64) string {
+		if count == 1 {
+			return i.stylize("<1 empty item>", inspectStyleUndefined)
+		}
+		return i.stylize(fmt.Sprintf("<%d empty items>", count), inspectStyleUndefined)
+	}
+
+	completion := object.OwnPropertyKeys(i.runtime)
+	keys := []*JavaScriptValue{}
+	if completion.Type == Normal {
+		keys = completion.Value.([]*JavaScriptValue)
+	}
+
+	next := uint64(0)
+	for _, key := range keys {
+		index, ok := arrayIndex(key)
+		if !ok || index >= length {
+			continue
+		}
+		if len(output) >= maxLength {
+			break
+		}
+
+		if index > next {
+			output = append(output, emptyItems(index-next))
+			next = index
+			if len(output) >= maxLength {
+				break
+			}
+		}
+
+		output = append(output, i.formatProperty(object, key, depth, inspectArrayEntries))
+		next = index + 1
+	}
+
+	if next < length && len(output) < maxLength {
+		output = append(output, emptyItems(length-next))
+		next = length
+	}
+
+	if remaining := length - next; remaining > 0 {
+		output = append(output, moreItems(remaining, "item"))
+	}
+
+	return output
+}
+
+func (i *inspector) formatTypedArray(object *TypedArrayObject, length uint) []string {
+	shown := min(length, uint(max(i.options.MaxArrayLength, 0)))
+	output := make([]string, 0, shown+1)
+	for index := range shown {
+		element := TypedArrayGetElement(i.runtime, object, &Number{Value: float64(index)})
+		output = append(output, i.formatPrimitive(element))
+	}
+
+	if remaining := length - shown; remaining > 0 {
+		output = append(output, moreItems(uint64(remaining), "item"))
+	}
+
+	return output
+}
+
+func (i *inspector) formatArrayBuffer(object *Object) []string {
+	byteLength := ArrayBufferByteLength(object, true)
+	lengthEntry := "byteLength: " + i.stylize(strconv.FormatUint(uint64(byteLength), 10), inspectStyleNumber)
+
+	if IsDetachedArrayBuffer(object) {
+		return []string{i.stylize("(detached)", inspectStyleSpecial), lengthEntry}
+	}
+
+	data := object.ArrayBufferData
+	if object.ArrayBufferSharedDataBlock != nil {
+		data = object.ArrayBufferSharedDataBlock.Bytes()
+	}
+	data = data[:min(uint(len(data)), byteLength)]
+
+	shown := data[:min(len(data), max(i.options.MaxArrayLength, 0))]
+	bytes := make([]string, len(shown))
+	for idx, b := range shown {
+		bytes[idx] = fmt.Sprintf("%02x", b)
+	}
+
+	contents := strings.Join(bytes, " ")
+	if remaining := len(data) - len(shown); remaining > 0 {
+		contents += " " + moreItems(uint64(remaining), "byte")
+	}
+
+	return []string{i.stylize("[Uint8Contents]", inspectStyleSpecial) + ": <" + contents + ">", lengthEntry}
+}
+
+func (i *inspector) formatDataView(object *Object, depth int) []string {
+	witness := MakeDataViewWithBufferWitnessRecord(object, true)
+
+	byteLength, byteOffset := uint(0), uint(0)
+	if !witness.IsViewOutOfBounds() {
+		byteLength = witness.GetViewByteLength()
+		byteOffset = object.DataViewByteOffset
+	}
+
+	i.indentation += 2
+	buffer := i.formatValue(NewJavaScriptValue(TypeObject, object.DataViewViewedArrayBuffer), depth)
+	i.indentation -= 2
+
+	return []string{
+		"byteLength: " + i.stylize(strconv.FormatUint(uint64(byteLength), 10), inspectStyleNumber),
+		"byteOffset: " + i.stylize(strconv.FormatUint(uint64(byteOffset), 10), inspectStyleNumber),
+		"buffer: " + buffer,
+	}
+}
+
+func (i *inspector) formatPromise(object *Object, depth int) []string {
+	if object.PromiseState == PromiseStatePending {
+		return []string{i.stylize("<pending>", inspectStyleSpecial)}
+	}
+
+	i.indentation += 2
+	result := i.formatValue(object.PromiseResult, depth)
+	i.indentation -= 2
+
+	if object.PromiseState == PromiseStateRejected {
+		return []string{i.stylize("<rejected>", inspectStyleSpecial) + " " + result}
+	}
+	return []string{result}
+}
+
+func (i *inspector) formatWeakRef(object *Object, depth int) []string {
+	target := object.WeakRefTarget.Deref()
+	if target == nil {
+		return []string{i.stylize("<cleared>", inspectStyleSpecial)}
+	}
+
+	i.indentation += 2
+	defer func() { i.indentation -= 2 }()
+	return []string{i.formatValue(target, depth)}
+}
+
+// formatURL lists the components of a URL the way its getters return them.
+func (i *inspector) formatURL(object *Object, depth int) []string {
+	url := object.URL
+
+	port := ""
+	if url.Port >= 0 {
+		port = strconv.Itoa(url.Port)
+	}
+	search := ""
+	if url.Query != nil && *url.Query != "" {
+		search = "?" + *url.Query
+	}
+	hash := ""
+	if url.Fragment != nil && *url.Fragment != "" {
+		hash = "#" + *url.Fragment
+	}
+
+	components := [][2]string{
+		{"href", url.String()},
+		{"origin", url.Origin()},
+		{"protocol", url.Scheme + ":"},
+		{"username", url.Username},
+		{"password", url.Password},
+		{"host", url.HostString()},
+		{"hostname", url.Host},
+		{"port", port},
+		{"pathname", url.PathString()},
+		{"search", search},
+	}
+
+	output := []string{}
+	for _, component := range components {
+		output = append(output, component[0]+": "+i.formatValue(NewStringValue(component[1]), depth))
+	}
+
+	i.indentation += 2
+	output = append(output, "searchParams: "+i.formatValue(NewJavaScriptValue(TypeObject, object.URLQueryObject), depth))
+	i.indentation -= 2
+
+	return append(output, "hash: "+i.formatValue(NewStringValue(hash), depth))
+}
+
+func (i *inspector) formatURLSearchParams(list *URLSearchParamsList) []string {
+	output := []string{}
+	for _, param := range list.Entries {
+		output = append(output, i.formatValue(NewStringValue(param.Name), 0)+" => "+i.formatValue(NewStringValue(param.Value), 0))
+	}
+	return output
+}
+
+func (i *inspector) formatHeaders(list *HeadersList) []string {
+	output := []string{}
+	for _, field := range list.SortAndCombine() {
+		output = append(output, i.formatKey(NewStringValue(field.Name), true)+": "+i.formatValue(NewStringValue(field.Value), 0))
+	}
+	return output
+}
+
+func (i *inspector) functionBase(object ObjectInterface, constructor string, hasConstructor bool, tag string) string {
+	name := ""
+	if descriptor, ok := i.ownProperty(object, nameStr).(*DataPropertyDescriptor); ok && descriptor.Value.Type == TypeString {
+		name = descriptor.Value.Value.(*String).Value
+	}
+
+	if function, ok := object.(*FunctionObject); ok && function.IsClassConstructor {
+		if name == "" {
+			name = "(anonymous)"
+		}
+
+		base := "class " + name
+		if hasConstructor && constructor != "Function" {
+			base += " [" + constructor + "]"
+		}
+		if tag != "" && constructor != tag {
+			base += " [" + tag + "]"
+		}
+
+		if !hasConstructor {
+			base += " extends [null prototype]"
+		} else if superName, ok := inspectDataProperty(object.GetPrototype(), nameStr); ok && superName.Type == TypeString && superName.Value.(*String).Value != "" {
+			base += " extends " + superName.Value.(*String).Value
+		}
+		return "[" + base + "]"
+	}
+
+	kind := "Function"
+	if strings.HasSuffix(constructor, "Function") && hasConstructor {
+		kind = constructor
+	}
+
+	base := "[" + kind
+	if !hasConstructor {
+		base += " (null prototype)"
+	}
+	if name == "" {
+		base += " (anonymous)"
+	} else {
+		base += ": " + name
+	}
+	base += "]"
+
+	if hasConstructor && constructor != kind && constructor != "Function" {
+		base += " " + constructor
+	}
+	if tag != "" && constructor != tag {
+		base += " [" + tag + "]"
+	}
+	return base
+}
+
+func (i *inspector) boxedBase(kind string, value *JavaScriptValue, constructor string, hasConstructor bool, tag string) string {
+	base := "[" + kind
+	if !hasConstructor {
+		base += " (null prototype)"
+	} else if constructor != kind {
+		base += " (" + constructor + ")"
+	}
+
+	colors := i.options.Colors
+	i.options.Colors = false
+	base += ": " + i.formatPrimitive(value) + "]"
+	i.options.Colors = colors
+
+	if tag != "" && tag != constructor {
+		base += " [" + tag + "]"
+	}
+
+	style := map[string]inspectStyle{
+		"Number":  inspectStyleNumber,
+		"BigInt":  inspectStyleNumber,
+		"Boolean": inspectStyleBoolean,
+		"String":  inspectStyleString,
+		"Symbol":  inspectStyleSymbol,
+	}[kind]
+	return i.stylize(base, style)
+}
+
+// errorBase describes an error by its stack, or by its name and message in brackets when it has no stack.
+func (i *inspector) errorBase(object *Object, constructor string, hasConstructor bool, tag string) string {
+	name := "Error"
+	if value, ok := inspectDataProperty(object, nameStr); ok && value.Type == TypeString {
+		name = value.Value.(*String).Value
+	}
+
+	stack := ""
+	if value, ok := inspectDataProperty(object, stackStr); ok && value.Type == TypeString {
+		stack = value.Value.(*String).Value
+	}
+	if stack == "" {
+		stack = ErrorDescription(object)
+	}
+
+	// Show the class of errors whose name doesn't say it, e.g. "ValidationError [TypeError]: ...".
+	if hasConstructor && strings.HasSuffix(name, "Error") && strings.HasPrefix(stack, name) && constructor != name {
+		rest := stack[len(name):]
+		if rest == "" || rest[0] == ':' || rest[0] == '\n' {
+			if strings.Contains(constructor, name) {
+				stack = constructor + rest
+			} else {
+				stack = constructor + " [" + name + "]" + rest
+			}
+		}
+	}
+	if tag != "" && tag != constructor {
+		stack = strings.Replace(stack, name, name+" ["+tag+"]", 1)
+	}
+
+	if !strings.Contains(stack, "\n    at") {
+		stack = "[" + stack + "]"
+	}
+
+	if i.indentation > 0 {
+		stack = strings.ReplaceAll(stack, "\n", "\n"+strings.Repeat(" ", i.indentation))
+	}
+
+	return stack
+}
+
+// errorKeys adds the cause and the aggregated errors of an error to the keys to describe, which are usually
+// non-enumerable.
+func (i *inspector) errorKeys(object *Object, keys []*JavaScriptValue) []*JavaScriptValue {
+	for _, key := range []*JavaScriptValue{causeStr, errorsStr} {
+		if i.ownProperty(object, key) == nil {
+			continue
+		}
+		if key == errorsStr {
+			if descriptor, ok := i.ownProperty(object, key).(*DataPropertyDescriptor); !ok || descriptor.Value.Type != TypeObject {
+				continue
+			} else if _, isArray := descriptor.Value.Value.(*ArrayObject); !isArray {
+				continue
+			}
+		}
+
+		if !slices.ContainsFunc(keys, func(existing *JavaScriptValue) bool {
+			return existing.Type == TypeString && existing.Value.(*String).Value == key.Value.(*String).Value
+		}) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// reduceToSingleString joins the entries of an object on one line if they are short and not deeply nested, and puts
+// each on its own line otherwise. Long arrays are grouped into columns.
+func (i *inspector) reduceToSingleString(output []string, base string, braces [2]string, entries inspectEntries, depth int) string {
+	count := len(output)
+	if entries == inspectArrayEntries && count > 6 {
+		output = i.groupArrayElements(output)
+	}
+
+	prefix := ""
+	if base != "" {
+		prefix = base + " "
+	}
+
+	if i.currentDepth-depth < 3 && count == len(output) {
+		start := len(output) + i.indentation + visibleLength(braces[0]) + visibleLength(base) + 10
+		if i.isBelowBreakLength(output, start, base) {
+			joined := strings.Join(output, ", ")
+			if !strings.Contains(joined, "\n") {
+				return prefix + braces[0] + " " + joined + " " + braces[1]
+			}
+		}
+	}
+
+	indentation := "\n" + strings.Repeat(" ", i.indentation)
+	return prefix + braces[0] + indentation + "  " + strings.Join(output, ","+indentation+"  ") + indentation + braces[1]
+}
+
+func (i *inspector) isBelowBreakLength(output []string, start int, base string) bool {
+	total := len(output) + start
+	if total+len(output) > i.breakLength() {
+		return false
+	}
+
+	for _, entry := range output {
+		total += visibleLength(entry)
+		if total > i.breakLength() {
+			return false
+		}
+	}
+
+	return base == "" || !strings.Contains(base, "\n")
+}
+
+func (i *inspector) breakLength() int {
+	if i.options.BreakLength <= 0 {
+		return math.MaxInt
+	}
+	return i.options.BreakLength
+}
+
+// groupArrayElements lays out the entries of a long array in columns, e.g. for arrays of numbers.
+func (i *inspector) groupArrayElements(output []string) []string {
+	totalLength := 0
+	maxLength := 0
+	outputLength := len(output)
+
+	// Leave out the "... more items" entry.
+	if last := output[outputLength-1]; strings.HasPrefix(last, "... ") && strings.Contains(last, " more item") {
+		outputLength--
+	}
+
+	const separatorSpace = 2
+	dataLength := make([]int, outputLength)
+	numeric := true
+	for idx := range outputLength {
+		length := visibleLength(output[idx])
+		dataLength[idx] = length
+		totalLength += length + separatorSpace
+		maxLength = max(maxLength, length)
+
+		plain := ansiPattern.ReplaceAllString(output[idx], "")
+		if _, err := strconv.ParseFloat(strings.TrimSuffix(plain, "n"), 64); err != nil && plain != "NaN" {
+			numeric = false
+		}
+	}
+
+	actualMax := maxLength + separatorSpace
+	if actualMax*3+i.indentation >= i.breakLength() || (float64(totalLength)/float64(actualMax) <= 5 && maxLength > 6) {
+		return output
+	}
+
+	averageBias := math.Sqrt(float64(actualMax) - float64(totalLength)/float64(len(output)))
+	biasedMax := math.Max(float64(actualMax)-3-averageBias, 1)
+	columns := min(
+		int(math.Round(math.Sqrt(2.5*biasedMax*float64(outputLength))/biasedMax)),
+		(i.breakLength()-i.indentation)/actualMax,
+		3*4,
+		15,
+	)
+	if columns <= 1 {
+		return output
+	}
+
+	maxLineLength := make([]int, 0, columns)
+	for column := range columns {
+		lineLength := 0
+		for idx := column; idx < outputLength; idx += columns {
+			lineLength = max(lineLength, dataLength[idx])
+		}
+		maxLineLength = append(maxLineLength, lineLength+separatorSpace)
+	}
+
+	pad := func(str string, width int) string {
+		padding := strings.Repeat(" ", max(width-visibleLength(str), 0))
+		if numeric {
+			return padding + str
+		}
+		return str + padding
+	}
+
+	grouped := []string{}
+	for start := 0; start < outputLength; start += columns {
+		end := min(start+columns, outputLength)
+
+		var line strings.Builder
+		for idx := start; idx < end-1; idx++ {
+			line.WriteString(pad(output[idx]+", ", maxLineLength[idx-start]))
+		}
+
+		last := end - 1
+		if numeric {
+			line.WriteString(pad(output[last], maxLineLength[last-start]-separatorSpace))
+		} else {
+			line.WriteString(output[last])
+		}
+		grouped = append(grouped, line.String())
+	}
+
+	if outputLength < len(output) {
+		grouped = append(grouped, output[outputLength])
+	}
+
+	return grouped
+}
+
+func visibleLength(str string) int {
+	return utf8.RuneCountInString(ansiPattern.ReplaceAllString(str, ""))
+}
+
+func moreItems(count uint64, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("... 1 more %s", noun)
+	}
+	return fmt.Sprintf("... %d more %ss", count, noun)
+}
+
+// inspectPrefix describes the class of an object before its entries, e.g. "Foo ", "Uint8Array(2) " or
+// "[Object: null prototype] ".
+func inspectPrefix(constructor string, hasConstructor bool, tag string, fallback string, size string) string {
+	if !hasConstructor {
+		if tag != "" && fallback != tag {
+			return fmt.Sprintf("[%s%s: null prototype] [%s] ", fallback, size, tag)
+		}
+		return fmt.Sprintf("[%s%s: null prototype] ", fallback, size)
+	}
+
+	if tag != "" && constructor != tag {
+		return fmt.Sprintf("%s%s [%s] ", constructor, size, tag)
+	}
+	return fmt.Sprintf("%s%s ", constructor, size)
+}
+
+// inspectConstructorName returns the name of the nearest constructor on the prototype chain of an object whose
+// prototype the object inherits from. It returns false for objects with a null prototype.
+func inspectConstructorName(object ObjectInterface) (string, bool) {
+	for current := object; current != nil; current = current.GetPrototype() {
+		if _, ok := current.(*ProxyObject); ok {
+			break
+		}
+
+		descriptor, ok := current.GetProperties().Get(constructorStr)
+		data, isData := descriptor.(*DataPropertyDescriptor)
+		if !ok || !isData || data.Value.Type != TypeObject {
+			continue
+		}
+
+		constructor, ok := data.Value.Value.(ObjectInterface)
+		if !ok || !IsCallable(data.Value) {
+			continue
+		}
+		if _, ok := constructor.(*ProxyObject); ok {
+			continue
+		}
+
+		name, ok := inspectOwnDataProperty(constructor, nameStr)
+		if !ok || name.Type != TypeString || name.Value.(*String).Value == "" {
+			continue
+		}
+
+		// Only count constructors the object is an instance of.
+		prototype, ok := inspectOwnDataProperty(constructor, prototypeStr)
+		if !ok || prototype.Type != TypeObject {
+			continue
+		}
+		for ancestor := object.GetPrototype(); ancestor != nil; ancestor = ancestor.GetPrototype() {
+			if ancestor == prototype.Value.(ObjectInterface) {
+				return name.Value.(*String).Value, true
+			}
+			if _, ok := ancestor.(*ProxyObject); ok {
+				break
+			}
+		}
+	}
+
+	if object.GetPrototype() == nil {
+		return "", false
+	}
+	return "Object", true
+}
+
+// inspectToStringTag returns the Symbol.toStringTag of an object, if it is a string data property.
+func inspectToStringTag(runtime *Runtime, object ObjectInterface) string {
+	if value, ok := inspectDataProperty(object, runtime.SymbolToStringTag); ok && value.Type == TypeString {
+		return value.Value.(*String).Value
+	}
+	return ""
+}
+
+func inspectOwnDataProperty(object ObjectInterface, key *JavaScriptValue) (*JavaScriptValue, bool) {
+	if _, ok := object.(*ProxyObject); ok {
+		return nil, false
+	}
+
+	descriptor, ok := object.GetProperties().Get(key)
+	if data, isData := descriptor.(*DataPropertyDescriptor); ok && isData {
+		return data.Value, true
+	}
+	return nil, false
+}
+
+// inspectDataProperty looks up a data property on the prototype chain of an object, without calling getters or
+// proxy traps.
+func inspectDataProperty(object ObjectInterface, key *JavaScriptValue) (*JavaScriptValue, bool) {
+	for current := object; current != nil; current = current.GetPrototype() {
+		if _, ok := current.(*ProxyObject); ok {
+			return nil, false
+		}
+
+		descriptor, ok := current.GetProperties().Get(key)
+		if !ok {
+			continue
+		}
+
+		data, isData := descriptor.(*DataPropertyDescriptor)
+		if !isData {
+			return nil, false
+		}
+		return data.Value, true
+	}
+	return nil, false
+}
+
+// ErrorDescription returns "name: message" for an error, like Error.prototype.toString, but only reads data properties
+// so it never runs script code.
+func ErrorDescription(object ObjectInterface) string {
+	name := "Error"
+	if value, ok := inspectDataProperty(object, nameStr); ok && value.Type == TypeString {
+		name = value.Value.(*String).Value
+	}
+
+	message := ""
+	if value, ok := inspectDataProperty(object, messageStr); ok && value.Type == TypeString {
+		message = value.Value.(*String).Value
+	}
+
+	switch {
+	case name == "":
+		return message
+	case message == "":
+		return name
+	}
+	return name + ": " + message
+}
+
+// arrayIndex returns the array index a property key stands for, if any.
+func arrayIndex(key *JavaScriptValue) (uint64, bool) {
+	if key.Type != TypeString {
+		return 0, false
+	}
+
+	str := key.Value.(*String).Value
+	index, err := strconv.ParseUint(str, 10, 32)
+	if err != nil || index == math.MaxUint32 || strconv.FormatUint(index, 10) != str {
+		return 0, false
+	}
+	return index, true
+}
+
+func isArrayIndexKey(key *JavaScriptValue) bool {
+	_, ok := arrayIndex(key)
+	return ok
+}
@@ -0,0 +1,146 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// InterruptedError is the value of a Terminate completion. Reason is the value passed to Runtime.Interrupt,
+// or the cause of the context that was cancelled.
+type InterruptedError struct {
+	Reason any
+}
+
+func (e *InterruptedError) Error() string {
+	if e.Reason == nil {
+		return "execution interrupted"
+	}
+	return fmt.Sprintf("execution interrupted: %v", e.Reason)
+}
+
+// Unwrap returns the reason if it is an error, so errors.Is(err, context.DeadlineExceeded) works for timeouts.
+func (e *InterruptedError) Unwrap() error {
+	err, _ := e.Reason.(error)
+	return err
+}
+
+// PanicError is the reason of the InterruptedError that terminates execution when the engine, or a host function it
+// called, panics. The panic is recovered by Execute so that a bug in the engine can't crash the embedder.
+type PanicError struct {
+	// The value passed to panic.
+	Value any
+
+	// The stack of the goroutine when it panicked.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, such as a Go runtime.Error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Interrupt stops the script running on this runtime at its next loop iteration or function call, with a Terminate
+// completion that script code can't catch. It is safe to call from any goroutine. If nothing is running, the next
+// evaluation is interrupted instead.
+func (r *Runtime) Interrupt(reason any) {
+	r.interrupt(&InterruptedError{Reason: reason})
+}
+
+func (r *Runtime) interrupt(interrupted *InterruptedError) {
+	r.interrupted.Store(interrupted)
+
+	// Wake RunJobs if it is waiting for a host operation, and natives blocked in Atomics.wait.
+	r.Jobs.signal()
+	select {
+	case r.interruptWake <- struct{}{}:
+	default:
+	}
+}
+
+// ClearInterrupt discards a pending interrupt that hasn't stopped execution yet.
+func (r *Runtime) ClearInterrupt() {
+	r.interrupted.Store(nil)
+}
+
+// CheckInterrupt returns a Terminate completion if the runtime was interrupted, and nil otherwise.
+// Long-running native functions should call it periodically.
+func (r *Runtime) CheckInterrupt() *Completion {
+	if interrupted := r.interrupted.Load(); interrupted != nil {
+		return NewTerminateCompletion(interrupted)
+	}
+	return nil
+}
+
+// Execute runs fn as an entry point into the runtime, such as evaluating a script or running jobs. When a Terminate
+// completion leaves the outermost Execute, the interrupt is cleared and the queued jobs and timers are discarded, so the
+// runtime can be used again. The objects kept alive for WeakRefs are released when the outermost Execute returns, and
+// each outermost Execute is a new run for the limits set with SetResourceLimits. A panic in fn terminates execution with a *PanicError as the reason of the interrupt.
+func (r *Runtime) Execute(fn func() *Completion) *Completion {
+	if r.executeDepth == 0 && r.resources != nil {
+		r.resources.startRun()
+	}
+
+	r.executeDepth++
+	completion := r.recoverPanic(fn)
+	r.executeDepth--
+
+	if r.executeDepth == 0 {
+		r.ClearKeptObjects()
+	}
+
+	if r.executeDepth == 0 && completion.Type == Terminate {
+		r.interrupted.CompareAndSwap(completion.Value.(*InterruptedError), nil)
+		r.Jobs.clear()
+		r.clearTimers()
+		r.finalizationCheckQueued.Store(false)
+	}
+
+	return completion
+}
+
+// recoverPanic runs fn, turning a panic into a Terminate completion. The execution contexts fn left on the stack are
+// popped, so the runtime can be used again.
+func (r *Runtime) recoverPanic(fn func() *Completion) (completion *Completion) {
+	stackDepth := len(r.ExecutionContextStack)
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			clear(r.ExecutionContextStack[stackDepth:])
+			r.ExecutionContextStack = r.ExecutionContextStack[:stackDepth]
+			completion = NewTerminateCompletion(&InterruptedError{Reason: &PanicError{Value: recovered, Stack: debug.Stack()}})
+		}
+	}()
+	return fn()
+}
+
+// ExecuteContext is like Execute, but interrupts fn when ctx is done, with the cause of ctx as the reason.
+func (r *Runtime) ExecuteContext(ctx context.Context, fn func() *Completion) *Completion {
+	if ctx.Err() != nil {
+		return r.Execute(func() *Completion {
+			return NewTerminateCompletion(&InterruptedError{Reason: context.Cause(ctx)})
+		})
+	}
+
+	var interrupted *InterruptedError
+	done := make(chan struct{})
+	stop := context.AfterFunc(ctx, func() {
+		defer close(done)
+		interrupted = &InterruptedError{Reason: context.Cause(ctx)}
+		r.interrupt(interrupted)
+	})
+
+	completion := r.Execute(fn)
+
+	// The context may have been cancelled after fn returned, don't let that interrupt the next evaluation.
+	if !stop() {
+		<-done
+		r.interrupted.CompareAndSwap(interrupted, nil)
+	}
+
+	return completion
+}
@@ -0,0 +1,555 @@
+package runtime
+
+import (
+	"slices"
+	"strings"
+)
+
+// The locales with bundled data. Other locales fall back to the default locale.
+var intlAvailableLocales = []string{"en", "en-US", "und"}
+
+// The locale used when none of the requested locales are available.
+const intlDefaultLocale = "en-US"
+
+func NewIntlObject(runtime *Runtime) ObjectInterface {
+	realm := runtime.GetRunningRealm()
+	intlObj := OrdinaryObjectCreate(realm.GetIntrinsic(IntrinsicObjectPrototype))
+
+	// Intl.getCanonicalLocales
+	DefineBuiltinFunction(runtime, intlObj, "getCanonicalLocales", IntlGetCanonicalLocales, 1)
+
+	for _, constructor := range []struct {
+		name      string
+		intrinsic Intrinsic
+	}{
+		{"Collator", IntrinsicIntlCollatorConstructor},
+		{"DateTimeFormat", IntrinsicIntlDateTimeFormatConstructor},
+		{"ListFormat", IntrinsicIntlListFormatConstructor},
+		{"NumberFormat", IntrinsicIntlNumberFormatConstructor},
+		{"PluralRules", IntrinsicIntlPluralRulesConstructor},
+		{"RelativeTimeFormat", IntrinsicIntlRelativeTimeFormatConstructor},
+	} {
+		// Intl.Collator, Intl.DateTimeFormat, ...
+		intlObj.DefineOwnProperty(runtime, NewStringValue(constructor.name), &DataPropertyDescriptor{
+			Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(constructor.intrinsic)),
+			Writable:     true,
+			Enumerable:   false,
+			Configurable: true,
+		})
+	}
+
+	// Intl[%Symbol.toStringTag%]
+	intlObj.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("Intl"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	return intlObj
+}
+
+// Intl.getCanonicalLocales(locales)
+func IntlGetCanonicalLocales(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	locales := NewUndefinedValue()
+	if len(arguments) > 0 {
+		locales = arguments[0]
+	}
+
+	list, completion := CanonicalizeLocaleList(runtime, locales)
+	if completion != nil {
+		return completion
+	}
+	return NewNormalCompletion(newStringArray(runtime, list))
+}
+
+// defineSupportedLocalesOf defines the supportedLocalesOf function every Intl constructor has.
+func defineSupportedLocalesOf(runtime *Runtime, constructor ObjectInterface) {
+	// Intl.X.supportedLocalesOf(locales, options)
+	DefineBuiltinFunction(runtime, constructor, "supportedLocalesOf", IntlSupportedLocalesOf, 1)
+}
+
+// Intl.X.supportedLocalesOf(locales, options)
+func IntlSupportedLocalesOf(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	requested, completion := CanonicalizeLocaleList(runtime, arguments[0])
+	if completion != nil {
+		return completion
+	}
+
+	options, completion := coerceIntlOptions(runtime, arguments[1])
+	if completion != nil {
+		return completion
+	}
+
+	if _, completion := getIntlStringOption(runtime, options, "localeMatcher", []string{"lookup", "best fit"}, "best fit"); completion != nil {
+		return completion
+	}
+
+	supported := []string{}
+	for _, locale := range requested {
+		if lookupIntlLocale(removeUnicodeExtensions(locale)) != "" {
+			supported = append(supported, locale)
+		}
+	}
+	return NewNormalCompletion(newStringArray(runtime, supported))
+}
+
+// CanonicalizeLocaleList converts the locales argument of an Intl constructor, a language tag or a list of them, to a
+// list of canonical language tags without duplicates. It throws a RangeError for tags that aren't well-formed.
+func CanonicalizeLocaleList(runtime *Runtime, locales *JavaScriptValue) ([]string, *Completion) {
+	if locales.Type == TypeUndefined {
+		return []string{}, nil
+	}
+
+	var object ObjectInterface
+	if locales.Type == TypeString {
+		object = CreateArrayFromList(runtime, []*JavaScriptValue{locales})
+	} else {
+		completion := ToObject(runtime, locales)
+		if completion.Type != Normal {
+			return nil, completion
+		}
+		object = completion.Value.(*JavaScriptValue).Value.(ObjectInterface)
+	}
+	objectVal := NewJavaScriptValue(TypeObject, object)
+
+	completion := LengthOfArrayLike(runtime, object)
+	if completion.Type != Normal {
+		return nil, completion
+	}
+	length := int(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+
+	seen := []string{}
+	for idx := range length {
+		key := NewStringValue(NumberToString(&Number{Value: float64(idx)}, 10).Value.(*String).Value)
+
+		completion := object.HasProperty(runtime, key)
+		if completion.Type != Normal {
+			return nil, completion
+		}
+		if !completion.Value.(*JavaScriptValue).Value.(*Boolean).Value {
+			continue
+		}
+
+		completion = object.Get(runtime, key, objectVal)
+		if completion.Type != Normal {
+			return nil, completion
+		}
+
+		value := completion.Value.(*JavaScriptValue)
+		if value.Type != TypeString && value.Type != TypeObject {
+			return nil, NewThrowCompletion(NewTypeError(runtime, "Language ID should be string or object."))
+		}
+
+		completion = ToString(runtime, value)
+		if completion.Type != Normal {
+			return nil, completion
+		}
+
+		tag, ok := CanonicalizeLanguageTag(completion.Value.(*JavaScriptValue).Value.(*String).Value)
+		if !ok {
+			return nil, NewThrowCompletion(NewRangeError(runtime, "Incorrect locale information provided"))
+		}
+		if !slices.Contains(seen, tag) {
+			seen = append(seen, tag)
+		}
+	}
+
+	return seen, nil
+}
+
+// CanonicalizeLanguageTag checks that tag is a well-formed BCP 47 language tag and returns it with the canonical case
+// of each subtag: a lowercase language, titlecase script and uppercase region.
+func CanonicalizeLanguageTag(tag string) (string, bool) {
+	subtags := strings.Split(strings.ToLower(tag), "-")
+
+	isAlpha := func(s string) bool {
+		return s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyz") == ""
+	}
+	isDigit := func(s string) bool {
+		return s != "" && strings.Trim(s, "0123456789") == ""
+	}
+	isAlphanumeric := func(s string) bool {
+		return s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyz0123456789") == ""
+	}
+
+	// The language: 2 or 3 letters, or 5 to 8 letters.
+	language := subtags[0]
+	if !isAlpha(language) || len(language) == 4 || len(language) > 8 || len(language) < 2 {
+		return "", false
+	}
+	result := []string{language}
+	idx := 1
+
+	// The script: 4 letters.
+	if idx < len(subtags) && len(subtags[idx]) == 4 && isAlpha(subtags[idx]) {
+		result = append(result, strings.ToUpper(subtags[idx][:1])+subtags[idx][1:])
+		idx++
+	}
+
+	// The region: 2 letters or 3 digits.
+	if idx < len(subtags) && ((len(subtags[idx]) == 2 && isAlpha(subtags[idx])) || (len(subtags[idx]) == 3 && isDigit(subtags[idx]))) {
+		result = append(result, strings.ToUpper(subtags[idx]))
+		idx++
+	}
+
+	// Variants: 5 to 8 alphanumerics, or a digit followed by 3 alphanumerics.
+	variants := []string{}
+	for idx < len(subtags) {
+		variant := subtags[idx]
+		if !isAlphanumeric(variant) || !((len(variant) >= 5 && len(variant) <= 8) || (len(variant) == 4 && isDigit(variant[:1]))) {
+			break
+		}
+		if slices.Contains(variants, variant) {
+			return "", false
+		}
+		variants = append(variants, variant)
+		result = append(result, variant)
+		idx++
+	}
+
+	// Extensions, each a singleton followed by subtags of 2 to 8 alphanumerics, and a private use section.
+	singletons := []string{}
+	for idx < len(subtags) {
+		singleton := subtags[idx]
+		if len(singleton) != 1 || !isAlphanumeric(singleton) {
+			return "", false
+		}
+
+		if singleton == "x" {
+			if idx+1 == len(subtags) {
+				return "", false
+			}
+			for _, subtag := range subtags[idx+1:] {
+				if !isAlphanumeric(subtag) || len(subtag) > 8 {
+					return "", false
+				}
+			}
+			result = append(result, subtags[idx:]...)
+			break
+		}
+
+		if slices.Contains(singletons, singleton) {
+			return "", false
+		}
+		singletons = append(singletons, singleton)
+
+		end := idx + 1
+		for end < len(subtags) && len(subtags[end]) > 1 {
+			if !isAlphanumeric(subtags[end]) || len(subtags[end]) > 8 {
+				return "", false
+			}
+			end++
+		}
+		if end == idx+1 {
+			return "", false
+		}
+		result = append(result, subtags[idx:end]...)
+		idx = end
+	}
+
+	return strings.Join(result, "-"), true
+}
+
+// removeUnicodeExtensions removes the "-u-" extension from a canonical language tag.
+func removeUnicodeExtensions(tag string) string {
+	subtags := strings.Split(tag, "-")
+	for idx := 1; idx < len(subtags); idx++ {
+		if len(subtags[idx]) != 1 {
+			continue
+		}
+		if subtags[idx] == "x" {
+			break
+		}
+		if subtags[idx] == "u" {
+			end := idx + 1
+			for end < len(subtags) && len(subtags[end]) > 1 {
+				end++
+			}
+			return strings.Join(append(subtags[:idx:idx], subtags[end:]...), "-")
+		}
+	}
+	return tag
+}
+
+// lookupIntlLocale returns the longest prefix of a language tag that is an available locale, or "" if there isn't one.
+// This corresponds to LookupMatchingLocaleByPrefix in the spec.
+func lookupIntlLocale(tag string) string {
+	for tag != "" {
+		if slices.Contains(intlAvailableLocales, tag) {
+			return tag
+		}
+
+		idx := strings.LastIndex(tag, "-")
+		if idx < 0 {
+			return ""
+		}
+		tag = tag[:idx]
+
+		// A singleton is removed together with the subtag after it.
+		if idx >= 2 && tag[idx-2] == '-' {
+			tag = tag[:idx-2]
+		}
+	}
+	return ""
+}
+
+// isWellFormedIntlType reports whether a value of the numberingSystem or collation options is a Unicode locale type,
+// subtags of 3 to 8 letters and digits separated by "-".
+func isWellFormedIntlType(value string) bool {
+	for _, subtag := range strings.Split(value, "-") {
+		if len(subtag) < 3 || len(subtag) > 8 || strings.Trim(strings.ToLower(subtag), "abcdefghijklmnopqrstuvwxyz0123456789") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// ResolveLocale picks the first of the requested locales that is available, or the default locale if none are. The
+// result is the locale itself, e.g. "en-US", and the locale whose data it uses, e.g. "en".
+func ResolveLocale(requested []string) (locale string, dataLocale string) {
+	locale = intlDefaultLocale
+	for _, tag := range requested {
+		if match := lookupIntlLocale(removeUnicodeExtensions(tag)); match != "" {
+			locale = match
+			break
+		}
+	}
+
+	dataLocale = locale
+	if strings.HasPrefix(locale, "en") {
+		dataLocale = "en"
+	}
+	return locale, dataLocale
+}
+
+// coerceIntlOptions converts the options argument of an Intl constructor to an object, using an empty object when it
+// is undefined. This corresponds to CoerceOptionsToObject in the spec.
+func coerceIntlOptions(runtime *Runtime, options *JavaScriptValue) (*JavaScriptValue, *Completion) {
+	if options.Type == TypeUndefined {
+		return NewJavaScriptValue(TypeObject, OrdinaryObjectCreate(nil)), nil
+	}
+
+	completion := ToObject(runtime, options)
+	if completion.Type != Normal {
+		return nil, completion
+	}
+	return completion.Value.(*JavaScriptValue), nil
+}
+
+// getIntlOptionsObject checks the options argument of an Intl constructor, which must be an object or undefined. This
+// corresponds to GetOptionsObject in the spec.
+func getIntlOptionsObject(runtime *Runtime, options *JavaScriptValue) (*JavaScriptValue, *Completion) {
+	switch options.Type {
+	case TypeUndefined:
+		return NewJavaScriptValue(TypeObject, OrdinaryObjectCreate(nil)), nil
+	case TypeObject:
+		return options, nil
+	}
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Options must be an object"))
+}
+
+// getIntlOption reads a member of an options object, returning nil if it is undefined.
+func getIntlOption(runtime *Runtime, options *JavaScriptValue, name string) (*JavaScriptValue, *Completion) {
+	completion := options.Value.(ObjectInterface).Get(runtime, NewStringValue(name), options)
+	if completion.Type != Normal {
+		return nil, completion
+	}
+
+	value := completion.Value.(*JavaScriptValue)
+	if value.Type == TypeUndefined {
+		return nil, nil
+	}
+	return value, nil
+}
+
+// getIntlStringOption reads a string member of an options object, which must be one of values unless values is nil.
+// It returns fallback if the member is undefined. This corresponds to GetOption in the spec with a type of "string".
+func getIntlStringOption(runtime *Runtime, options *JavaScriptValue, name string, values []string, fallback string) (string, *Completion) {
+	value, completion := getIntlOption(runtime, options, name)
+	if completion != nil || value == nil {
+		return fallback, completion
+	}
+
+	completion = ToString(runtime, value)
+	if completion.Type != Normal {
+		return "", completion
+	}
+
+	text := completion.Value.(*JavaScriptValue).Value.(*String).Value
+	if values != nil && !slices.Contains(values, text) {
+		return "", NewThrowCompletion(NewRangeError(runtime, "Value "+text+" out of range for Intl options property "+name))
+	}
+	return text, nil
+}
+
+// getIntlBooleanOption reads a boolean member of an options object, returning nil if it is undefined. This
+// corresponds to GetOption in the spec with a type of "boolean".
+func getIntlBooleanOption(runtime *Runtime, options *JavaScriptValue, name string) (*bool, *Completion) {
+	value, completion := getIntlOption(runtime, options, name)
+	if completion != nil || value == nil {
+		return nil, completion
+	}
+
+	result := ToBoolean(value).Value.(*JavaScriptValue).Value.(*Boolean).Value
+	return &result, nil
+}
+
+// getIntlNumberOption reads an integer member of an options object in the range minimum to maximum, returning fallback
+// if it is undefined. This corresponds to GetNumberOption in the spec.
+func getIntlNumberOption(runtime *Runtime, options *JavaScriptValue, name string, minimum int, maximum int, fallback int) (int, *Completion) {
+	value, completion := getIntlOption(runtime, options, name)
+	if completion != nil {
+		return 0, completion
+	}
+	return defaultIntlNumberOption(runtime, value, name, minimum, maximum, fallback)
+}
+
+// defaultIntlNumberOption converts an option that was read to an integer in the range minimum to maximum, returning
+// fallback if it is nil. This corresponds to DefaultNumberOption in the spec.
+func defaultIntlNumberOption(runtime *Runtime, value *JavaScriptValue, name string, minimum int, maximum int, fallback int) (int, *Completion) {
+	if value == nil {
+		return fallback, nil
+	}
+
+	completion := ToNumber(runtime, value)
+	if completion.Type != Normal {
+		return 0, completion
+	}
+
+	number := completion.Value.(*JavaScriptValue).Value.(*Number)
+	if number.NaN || number.Value < float64(minimum) || number.Value > float64(maximum) {
+		return 0, NewThrowCompletion(NewRangeError(runtime, name+" value is out of range."))
+	}
+	return int(number.Value), nil
+}
+
+// intlPart is a piece of a formatted string, with the type formatToParts reports it as.
+type intlPart struct {
+	Type  string
+	Value string
+}
+
+// joinIntlParts concatenates the values of parts.
+func joinIntlParts(parts []intlPart) string {
+	var builder strings.Builder
+	for _, part := range parts {
+		builder.WriteString(part.Value)
+	}
+	return builder.String()
+}
+
+// newIntlPartsArray creates the array formatToParts returns, an object with a type and value for each part, and any
+// other members the extra function adds.
+func newIntlPartsArray(runtime *Runtime, parts []intlPart, extra func(part intlPart, object ObjectInterface)) *JavaScriptValue {
+	objectProto := runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype)
+
+	values := make([]*JavaScriptValue, len(parts))
+	for idx, part := range parts {
+		object := OrdinaryObjectCreate(objectProto)
+		CreateDataProperty(runtime, object, NewStringValue("type"), NewStringValue(part.Type))
+		CreateDataProperty(runtime, object, NewStringValue("value"), NewStringValue(part.Value))
+		if extra != nil {
+			extra(part, object)
+		}
+		values[idx] = NewJavaScriptValue(TypeObject, object)
+	}
+	return NewJavaScriptValue(TypeObject, CreateArrayFromList(runtime, values))
+}
+
+// newStringArray creates an array of strings.
+func newStringArray(runtime *Runtime, list []string) *JavaScriptValue {
+	values := make([]*JavaScriptValue, len(list))
+	for idx, item := range list {
+		values[idx] = NewStringValue(item)
+	}
+	return NewJavaScriptValue(TypeObject, CreateArrayFromList(runtime, values))
+}
+
+// intlResolvedOptions creates the object resolvedOptions returns, with the members in the order given. Members with a
+// nil value are left out.
+func intlResolvedOptions(runtime *Runtime, members []intlResolvedOption) *JavaScriptValue {
+	object := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+	for _, member := range members {
+		if member.Value != nil {
+			CreateDataProperty(runtime, object, NewStringValue(member.Name), member.Value)
+		}
+	}
+	return NewJavaScriptValue(TypeObject, object)
+}
+
+type intlResolvedOption struct {
+	Name  string
+	Value *JavaScriptValue
+}
+
+// newIntlServiceConstructor creates the constructor of an Intl service, e.g. Intl.NumberFormat, with its prototype
+// and supportedLocalesOf.
+func newIntlServiceConstructor(runtime *Runtime, behaviour NativeFunctionBehaviour, name string, prototype Intrinsic) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		behaviour,
+		0,
+		NewStringValue(name),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// Intl.X.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(prototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	defineSupportedLocalesOf(runtime, constructor)
+
+	return constructor
+}
+
+// defineIntlToStringTag defines the toStringTag of the prototype of an Intl service, e.g. "Intl.NumberFormat".
+func defineIntlToStringTag(runtime *Runtime, prototype ObjectInterface, tag string) {
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue(tag),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+// intlConstructorArguments pads the arguments of an Intl constructor to its locales and options, and canonicalizes the
+// locales.
+func intlConstructorArguments(runtime *Runtime, arguments []*JavaScriptValue) ([]string, *JavaScriptValue, *Completion) {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	requested, completion := CanonicalizeLocaleList(runtime, arguments[0])
+	if completion != nil {
+		return nil, nil, completion
+	}
+	return requested, arguments[1], nil
+}
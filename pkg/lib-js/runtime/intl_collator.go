@@ -0,0 +1,115 @@
+package runtime
+
+import (
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// CollatorState is the state of an Intl.Collator object, its internal slots in the spec.
+type CollatorState struct {
+	Locale            string
+	Usage             string // "sort" or "search".
+	Sensitivity       string // "base", "accent", "case" or "variant".
+	IgnorePunctuation bool
+	Collation         string
+	Numeric           bool
+	CaseFirst         string // "upper", "lower" or "false".
+
+	// Strings are compared with the CLDR root collation, which is also that of English.
+	collator        *collate.Collator
+	caseInsensitive *collate.Collator // Compares strings without their case, to find those only their case orders.
+
+	BoundCompare *FunctionObject
+}
+
+// InitializeCollator reads the options of a Collator for the requested locales.
+func InitializeCollator(runtime *Runtime, requested []string, options *JavaScriptValue) (*CollatorState, *Completion) {
+	options, completion := coerceIntlOptions(runtime, options)
+	if completion != nil {
+		return nil, completion
+	}
+
+	usage, completion := getIntlStringOption(runtime, options, "usage", []string{"sort", "search"}, "sort")
+	if completion != nil {
+		return nil, completion
+	}
+
+	if _, completion := getIntlStringOption(runtime, options, "localeMatcher", []string{"lookup", "best fit"}, "best fit"); completion != nil {
+		return nil, completion
+	}
+
+	// Only the default collation is supported, so the collation option is checked but has no effect.
+	collation, completion := getIntlStringOption(runtime, options, "collation", nil, "")
+	if completion != nil {
+		return nil, completion
+	}
+	if collation != "" && !isWellFormedIntlType(collation) {
+		return nil, NewThrowCompletion(NewRangeError(runtime, "Invalid collation : "+collation))
+	}
+
+	numeric, completion := getIntlBooleanOption(runtime, options, "numeric")
+	if completion != nil {
+		return nil, completion
+	}
+
+	caseFirst, completion := getIntlStringOption(runtime, options, "caseFirst", []string{"upper", "lower", "false"}, "false")
+	if completion != nil {
+		return nil, completion
+	}
+
+	sensitivity, completion := getIntlStringOption(runtime, options, "sensitivity", []string{"base", "accent", "case", "variant"}, "variant")
+	if completion != nil {
+		return nil, completion
+	}
+
+	ignorePunctuation, completion := getIntlBooleanOption(runtime, options, "ignorePunctuation")
+	if completion != nil {
+		return nil, completion
+	}
+
+	locale, _ := ResolveLocale(requested)
+	collator := &CollatorState{
+		Locale:            locale,
+		Usage:             usage,
+		Sensitivity:       sensitivity,
+		IgnorePunctuation: ignorePunctuation != nil && *ignorePunctuation,
+		Collation:         "default",
+		Numeric:           numeric != nil && *numeric,
+		CaseFirst:         caseFirst,
+	}
+
+	collateOptions := []collate.Option{}
+	switch sensitivity {
+	case "base":
+		collateOptions = append(collateOptions, collate.IgnoreCase, collate.IgnoreDiacritics)
+	case "accent":
+		collateOptions = append(collateOptions, collate.IgnoreCase)
+	case "case":
+		collateOptions = append(collateOptions, collate.IgnoreDiacritics)
+	}
+	if collator.Numeric {
+		collateOptions = append(collateOptions, collate.Numeric)
+	}
+	if collator.IgnorePunctuation {
+		// Shifted alternate handling ignores whitespace and punctuation.
+		collateOptions = append(collateOptions, collate.OptionsFromTag(language.MustParse("und-u-ka-shifted")))
+	}
+
+	collator.collator = collate.New(language.Und, collateOptions...)
+	if caseFirst == "upper" {
+		collator.caseInsensitive = collate.New(language.Und, append(collateOptions, collate.IgnoreCase)...)
+	}
+	return collator, nil
+}
+
+// CompareStrings compares two strings with a Collator, returning a negative number, zero or a positive number.
+func CompareStrings(collator *CollatorState, x string, y string) int {
+	result := collator.collator.CompareString(x, y)
+
+	// The collation sorts lowercase letters first, so strings only their case orders are reversed to sort uppercase
+	// letters first.
+	if result != 0 && collator.caseInsensitive != nil && collator.caseInsensitive.CompareString(x, y) == 0 {
+		return -result
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package runtime
+
+func NewIntlCollatorConstructor(runtime *Runtime) *FunctionObject {
+	return newIntlServiceConstructor(runtime, IntlCollatorConstructor, "Collator", IntrinsicIntlCollatorPrototype)
+}
+
+// Intl.Collator(locales, options)
+func IntlCollatorConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	// Intl.Collator may be called as a function, which creates a new Collator.
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		newTarget = NewJavaScriptValue(TypeObject, function)
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicIntlCollatorPrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	requested, options, completion := intlConstructorArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	collator, completion := InitializeCollator(runtime, requested, options)
+	if completion != nil {
+		return completion
+	}
+
+	collatorVal := createCompletion.Value.(*JavaScriptValue)
+	collatorVal.Value.(*Object).Collator = collator
+
+	return NewNormalCompletion(collatorVal)
+}
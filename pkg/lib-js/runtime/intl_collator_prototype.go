@@ -0,0 +1,97 @@
+package runtime
+
+func NewIntlCollatorPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineIntlCollatorPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Intl.Collator.prototype.compare
+	DefineBuiltinAccessorFunction(runtime, prototype, "compare", IntlCollatorPrototypeCompareGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// Intl.Collator.prototype.resolvedOptions
+	DefineBuiltinFunction(runtime, prototype, "resolvedOptions", IntlCollatorPrototypeResolvedOptions, 0)
+
+	// Intl.Collator.prototype[%Symbol.toStringTag%]
+	defineIntlToStringTag(runtime, prototype, "Intl.Collator")
+}
+
+func thisCollator(runtime *Runtime, thisArg *JavaScriptValue, method string) (*CollatorState, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.Collator != nil {
+			return object.Collator, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Intl.Collator.prototype."+method+" called on incompatible receiver"))
+}
+
+// get Intl.Collator.prototype.compare
+func IntlCollatorPrototypeCompareGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	collator, completion := thisCollator(runtime, thisArg, "compare")
+	if completion != nil {
+		return completion
+	}
+
+	// The function is bound to the Collator, so it can be passed to Array.prototype.sort.
+	if collator.BoundCompare == nil {
+		collator.BoundCompare = CreateBuiltinFunction(
+			runtime,
+			func(runtime *Runtime, function *FunctionObject, thisArg *JavaScriptValue, arguments []*JavaScriptValue, newTarget *JavaScriptValue) *Completion {
+				values := make([]string, 2)
+				for idx := range values {
+					value := NewUndefinedValue()
+					if idx < len(arguments) {
+						value = arguments[idx]
+					}
+
+					completion := ToString(runtime, value)
+					if completion.Type != Normal {
+						return completion
+					}
+					values[idx] = completion.Value.(*JavaScriptValue).Value.(*String).Value
+				}
+
+				return NewNormalCompletion(NewNumberValue(float64(CompareStrings(collator, values[0], values[1])), false))
+			},
+			2,
+			NewStringValue(""),
+			nil,
+			nil,
+		)
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, collator.BoundCompare))
+}
+
+// Intl.Collator.prototype.resolvedOptions()
+func IntlCollatorPrototypeResolvedOptions(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	collator, completion := thisCollator(runtime, thisArg, "resolvedOptions")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(intlResolvedOptions(runtime, []intlResolvedOption{
+		{"locale", NewStringValue(collator.Locale)},
+		{"usage", NewStringValue(collator.Usage)},
+		{"sensitivity", NewStringValue(collator.Sensitivity)},
+		{"ignorePunctuation", NewBooleanValue(collator.IgnorePunctuation)},
+		{"collation", NewStringValue(collator.Collation)},
+		{"numeric", NewBooleanValue(collator.Numeric)},
+		{"caseFirst", NewStringValue(collator.CaseFirst)},
+	}))
+}
@@ -0,0 +1,738 @@
+package runtime
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateTimeFormatState is the state of an Intl.DateTimeFormat object, its internal slots in the spec.
+type DateTimeFormatState struct {
+	Locale          string
+	Data            *intlLocaleData
+	Calendar        string
+	NumberingSystem string
+	TimeZone        string
+	Location        *time.Location
+	HourCycle       string // "h11", "h12", "h23" or "h24", or "" if times are not formatted.
+	DateStyle       string
+	TimeStyle       string
+
+	// The fields that are formatted and their widths, as they were requested.
+	Fields                 map[string]string
+	FractionalSecondDigits int
+
+	// The pattern dates are formatted with, in the syntax of CLDR. See FormatDateTimeToParts.
+	Pattern string
+
+	BoundFormat *FunctionObject
+}
+
+// intlDateTimeField is an option of a DateTimeFormat that formats a field of dates, with the widths it can have.
+type intlDateTimeField struct {
+	Name   string
+	Values []string
+}
+
+// intlDateTimeFields are the field options of a DateTimeFormat, in the order they are read and reported by
+// resolvedOptions.
+var intlDateTimeFields = []intlDateTimeField{
+	{"weekday", []string{"narrow", "short", "long"}},
+	{"era", []string{"narrow", "short", "long"}},
+	{"year", []string{"2-digit", "numeric"}},
+	{"month", []string{"2-digit", "numeric", "narrow", "short", "long"}},
+	{"day", []string{"2-digit", "numeric"}},
+	{"hour", []string{"2-digit", "numeric"}},
+	{"minute", []string{"2-digit", "numeric"}},
+	{"second", []string{"2-digit", "numeric"}},
+	{"timeZoneName", []string{"short", "long", "shortOffset", "longOffset"}},
+}
+
+var intlDateTimeStyles = []string{"full", "long", "medium", "short"}
+
+// InitializeDateTimeFormat reads the options of a DateTimeFormat for the requested locales. This corresponds to
+// CreateDateTimeFormat in the spec, with the required and defaults of "any" and "date".
+func InitializeDateTimeFormat(runtime *Runtime, requested []string, options *JavaScriptValue) (*DateTimeFormatState, *Completion) {
+	options, completion := coerceIntlOptions(runtime, options)
+	if completion != nil {
+		return nil, completion
+	}
+
+	if _, completion := getIntlStringOption(runtime, options, "localeMatcher", []string{"lookup", "best fit"}, "best fit"); completion != nil {
+		return nil, completion
+	}
+
+	// Only the Gregorian calendar is supported.
+	calendar, completion := getIntlStringOption(runtime, options, "calendar", nil, "gregory")
+	if completion != nil {
+		return nil, completion
+	}
+	if calendar != "gregory" && calendar != "iso8601" {
+		return nil, NewThrowCompletion(NewRangeError(runtime, "Invalid calendar : "+calendar))
+	}
+
+	if completion := readIntlNumberingSystem(runtime, options); completion != nil {
+		return nil, completion
+	}
+
+	hour12, completion := getIntlBooleanOption(runtime, options, "hour12")
+	if completion != nil {
+		return nil, completion
+	}
+
+	hourCycle, completion := getIntlStringOption(runtime, options, "hourCycle", []string{"h11", "h12", "h23", "h24"}, "")
+	if completion != nil {
+		return nil, completion
+	}
+
+	timeZone, completion := getIntlOption(runtime, options, "timeZone")
+	if completion != nil {
+		return nil, completion
+	}
+	timeZoneName, location, completion := resolveIntlTimeZone(runtime, timeZone)
+	if completion != nil {
+		return nil, completion
+	}
+
+	locale, dataLocale := ResolveLocale(requested)
+	dtf := &DateTimeFormatState{
+		Locale:          locale,
+		Data:            intlLocaleDataByLocale[dataLocale],
+		Calendar:        "gregory",
+		NumberingSystem: "latn",
+		TimeZone:        timeZoneName,
+		Location:        location,
+		Fields:          map[string]string{},
+	}
+
+	// The fields are read in the order of the spec, which reads fractionalSecondDigits after second.
+	for _, field := range intlDateTimeFields {
+		if field.Name == "timeZoneName" {
+			digits, completion := getIntlNumberOption(runtime, options, "fractionalSecondDigits", 1, 3, 0)
+			if completion != nil {
+				return nil, completion
+			}
+			dtf.FractionalSecondDigits = digits
+		}
+
+		value, completion := getIntlStringOption(runtime, options, field.Name, field.Values, "")
+		if completion != nil {
+			return nil, completion
+		}
+		if value != "" {
+			dtf.Fields[field.Name] = value
+		}
+	}
+
+	if _, completion := getIntlStringOption(runtime, options, "formatMatcher", []string{"basic", "best fit"}, "best fit"); completion != nil {
+		return nil, completion
+	}
+
+	dateStyle, completion := getIntlStringOption(runtime, options, "dateStyle", intlDateTimeStyles, "")
+	if completion != nil {
+		return nil, completion
+	}
+
+	timeStyle, completion := getIntlStringOption(runtime, options, "timeStyle", intlDateTimeStyles, "")
+	if completion != nil {
+		return nil, completion
+	}
+
+	if dateStyle != "" || timeStyle != "" {
+		for _, field := range intlDateTimeFields {
+			if _, ok := dtf.Fields[field.Name]; ok {
+				return nil, NewThrowCompletion(NewTypeError(runtime, "Can't set option "+field.Name+" when dateStyle or timeStyle is used"))
+			}
+		}
+		if dtf.FractionalSecondDigits != 0 {
+			return nil, NewThrowCompletion(NewTypeError(runtime, "Can't set option fractionalSecondDigits when dateStyle or timeStyle is used"))
+		}
+		dtf.DateStyle, dtf.TimeStyle = dateStyle, timeStyle
+	} else if !dtf.hasField("weekday", "year", "month", "day", "hour", "minute", "second") && dtf.FractionalSecondDigits == 0 {
+		// Without fields or styles, dates are formatted with the year, month and day.
+		dtf.Fields["year"], dtf.Fields["month"], dtf.Fields["day"] = "numeric", "numeric", "numeric"
+	}
+
+	if dtf.TimeStyle != "" || dtf.hasField("hour") {
+		dtf.HourCycle = dtf.resolveHourCycle(hourCycle, hour12)
+	}
+
+	dtf.Pattern = dtf.buildPattern()
+	return dtf, nil
+}
+
+// hasField reports whether any of the named fields are formatted.
+func (dtf *DateTimeFormatState) hasField(names ...string) bool {
+	for _, name := range names {
+		if _, ok := dtf.Fields[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveHourCycle picks the hour cycle from the hour12 and hourCycle options, where hour12 takes precedence, or the
+// default of the locale.
+func (dtf *DateTimeFormatState) resolveHourCycle(hourCycle string, hour12 *bool) string {
+	if hour12 != nil {
+		if *hour12 {
+			if dtf.Data.HourCycle == "h11" {
+				return "h11"
+			}
+			return "h12"
+		}
+		return "h23"
+	}
+	if hourCycle != "" {
+		return hourCycle
+	}
+	return dtf.Data.HourCycle
+}
+
+// intlOffsetTimeZonePattern matches time zones that are offsets from UTC, e.g. "+05:30".
+var intlOffsetTimeZonePattern = regexp.MustCompile(`^([+-])([01][0-9]|2[0-3])(?::?([0-5][0-9]))?$`)
+
+// resolveIntlTimeZone returns the canonical name and location of the timeZone option, which may be an IANA name or an
+// offset from UTC. Without the option, the time zone of the runtime is used.
+func resolveIntlTimeZone(runtime *Runtime, timeZone *JavaScriptValue) (string, *time.Location, *Completion) {
+	if timeZone == nil {
+		if runtime.TimeZone == nil || runtime.TimeZone == time.UTC {
+			return "UTC", time.UTC, nil
+		}
+		return runtime.TimeZone.String(), runtime.TimeZone, nil
+	}
+
+	completion := ToString(runtime, timeZone)
+	if completion.Type != Normal {
+		return "", nil, completion
+	}
+	name := completion.Value.(*JavaScriptValue).Value.(*String).Value
+
+	switch strings.ToUpper(name) {
+	case "UTC", "ETC/UTC", "ETC/GMT", "GMT":
+		return "UTC", time.UTC, nil
+	}
+
+	if match := intlOffsetTimeZonePattern.FindStringSubmatch(name); match != nil {
+		hours, _ := strconv.Atoi(match[2])
+		minutes := "00"
+		if match[3] != "" {
+			minutes = match[3]
+		}
+		minutesValue, _ := strconv.Atoi(minutes)
+
+		offset := hours*3600 + minutesValue*60
+		if match[1] == "-" {
+			offset = -offset
+		}
+		canonical := match[1] + match[2] + ":" + minutes
+		return canonical, time.FixedZone(canonical, offset), nil
+	}
+
+	if name != "" && name != "Local" {
+		if location, err := time.LoadLocation(name); err == nil {
+			return name, location, nil
+		}
+	}
+	return "", nil, NewThrowCompletion(NewRangeError(runtime, "Invalid time zone specified: "+name))
+}
+
+// buildPattern picks the pattern of the styles or fields of a DateTimeFormat from the data of its locale.
+func (dtf *DateTimeFormatState) buildPattern() string {
+	data := dtf.Data
+
+	if dtf.DateStyle != "" || dtf.TimeStyle != "" {
+		datePattern, timePattern := "", ""
+		if dtf.DateStyle != "" {
+			datePattern = data.DatePatterns[slices.Index(intlDateTimeStyles, dtf.DateStyle)]
+		}
+		if dtf.TimeStyle != "" {
+			timePattern = dtf.timeStylePattern(slices.Index(intlDateTimeStyles, dtf.TimeStyle))
+		}
+
+		switch {
+		case timePattern == "":
+			return datePattern
+		case datePattern == "":
+			return timePattern
+		}
+		return joinIntlDateTimePatterns(data.DateTimePatterns[slices.Index(intlDateTimeStyles, dtf.DateStyle)], datePattern, timePattern)
+	}
+
+	datePattern := dtf.fieldsPattern(dtf.dateSkeleton())
+	timePattern := dtf.fieldsPattern(dtf.timeSkeleton())
+
+	if digits := dtf.FractionalSecondDigits; digits != 0 {
+		fraction := strings.Repeat("S", digits)
+		if idx := strings.LastIndexByte(timePattern, 's'); idx >= 0 {
+			timePattern = timePattern[:idx+1] + data.Decimal + fraction + timePattern[idx+1:]
+		} else {
+			timePattern = strings.TrimSpace(timePattern + " " + fraction)
+		}
+	}
+
+	if zone, ok := dtf.Fields["timeZoneName"]; ok {
+		zonePattern := map[string]string{"short": "z", "long": "zzzz", "shortOffset": "O", "longOffset": "OOOO"}[zone]
+		timePattern = strings.TrimSpace(timePattern + " " + zonePattern)
+	}
+
+	switch {
+	case timePattern == "":
+		return datePattern
+	case datePattern == "":
+		return timePattern
+	}
+
+	// Dates with long months are joined to times like those of the long and full styles, e.g. "January 5 at 3 PM".
+	glue := data.DateTimePatterns[3]
+	switch dtf.Fields["month"] {
+	case "long":
+		glue = data.DateTimePatterns[1]
+		if dtf.hasField("weekday") {
+			glue = data.DateTimePatterns[0]
+		}
+	case "short":
+		glue = data.DateTimePatterns[2]
+	}
+	return joinIntlDateTimePatterns(glue, datePattern, timePattern)
+}
+
+// timeStylePattern returns the pattern of a time style, in the hour cycle of the DateTimeFormat.
+func (dtf *DateTimeFormatState) timeStylePattern(style int) string {
+	pattern := dtf.Data.TimePatterns[style]
+
+	// The patterns of the locale are in its default hour cycle, so other cycles use the pattern of the hour, minute and
+	// second fields, followed by the time zone of the style.
+	if isTwelveHourCycle(dtf.HourCycle) != isTwelveHourCycle(dtf.Data.HourCycle) {
+		skeleton := "Hm"
+		if isTwelveHourCycle(dtf.HourCycle) {
+			skeleton = "hm"
+		}
+		if style != 3 {
+			skeleton += "s"
+		}
+		pattern = dtf.Data.AvailableFormats[skeleton] + []string{" zzzz", " z", "", ""}[style]
+	}
+	return convertIntlHourCycle(pattern, dtf.HourCycle)
+}
+
+// dateSkeleton returns the skeleton of the date fields of a DateTimeFormat, the key of its pattern in the available
+// formats of the locale.
+func (dtf *DateTimeFormatState) dateSkeleton() string {
+	var skeleton strings.Builder
+	if dtf.hasField("era") {
+		skeleton.WriteString("G")
+	}
+	if dtf.hasField("year") {
+		skeleton.WriteString("y")
+	}
+	switch dtf.Fields["month"] {
+	case "":
+	case "numeric", "2-digit":
+		skeleton.WriteString("M")
+	default:
+		skeleton.WriteString("MMM")
+	}
+	if dtf.hasField("weekday") {
+		skeleton.WriteString("E")
+	}
+	if dtf.hasField("day") {
+		skeleton.WriteString("d")
+	}
+	return skeleton.String()
+}
+
+// timeSkeleton returns the skeleton of the time fields of a DateTimeFormat.
+func (dtf *DateTimeFormatState) timeSkeleton() string {
+	var skeleton strings.Builder
+	if dtf.hasField("hour") {
+		if isTwelveHourCycle(dtf.HourCycle) {
+			skeleton.WriteString("h")
+		} else {
+			skeleton.WriteString("H")
+		}
+	}
+	if dtf.hasField("minute") {
+		skeleton.WriteString("m")
+	}
+	if dtf.hasField("second") {
+		skeleton.WriteString("s")
+	}
+	return skeleton.String()
+}
+
+// fieldsPattern returns the pattern of a skeleton, with the widths of its fields adjusted to the options of the
+// DateTimeFormat. Skeletons without a pattern of their own are formatted as their fields separated by spaces.
+func (dtf *DateTimeFormatState) fieldsPattern(skeleton string) string {
+	if skeleton == "" {
+		return ""
+	}
+
+	pattern, ok := dtf.Data.AvailableFormats[skeleton]
+	if !ok {
+		fields := []string{}
+		for _, token := range tokenizeIntlDateTimePattern(skeleton) {
+			fields = append(fields, token.Text)
+		}
+		pattern = strings.Join(fields, " ")
+	}
+
+	tokens := tokenizeIntlDateTimePattern(pattern)
+	var builder strings.Builder
+	for _, token := range tokens {
+		if token.Literal {
+			builder.WriteString(quoteIntlDateTimeLiteral(token.Text))
+			continue
+		}
+		builder.WriteString(dtf.adjustFieldWidth(token.Text))
+	}
+	return convertIntlHourCycle(builder.String(), dtf.HourCycle)
+}
+
+// adjustFieldWidth returns a field of a pattern in the width of its option. Numeric fields are only ever widened, as
+// the locale may pad them, e.g. the hours of "HH:mm".
+func (dtf *DateTimeFormatState) adjustFieldWidth(field string) string {
+	symbol := field[0]
+	width := func(option string) string {
+		switch option {
+		case "2-digit":
+			return strings.Repeat(string(symbol), 2)
+		case "numeric":
+			return field
+		case "short":
+			return strings.Repeat(string(symbol), 3)
+		case "long":
+			return strings.Repeat(string(symbol), 4)
+		case "narrow":
+			return strings.Repeat(string(symbol), 5)
+		}
+		return field
+	}
+
+	switch symbol {
+	case 'G':
+		if dtf.Fields["era"] == "short" {
+			return "G"
+		}
+		return width(dtf.Fields["era"])
+	case 'y':
+		if dtf.Fields["year"] == "numeric" {
+			return "y"
+		}
+		return width(dtf.Fields["year"])
+	case 'M', 'L':
+		return width(dtf.Fields["month"])
+	case 'E', 'c':
+		return width(dtf.Fields["weekday"])
+	case 'd':
+		return width(dtf.Fields["day"])
+	case 'h', 'H', 'K', 'k':
+		return width(dtf.Fields["hour"])
+	case 'm':
+		return width(dtf.Fields["minute"])
+	case 's':
+		return width(dtf.Fields["second"])
+	}
+	return field
+}
+
+// isTwelveHourCycle reports whether an hour cycle has 12 hours and a day period.
+func isTwelveHourCycle(hourCycle string) bool {
+	return hourCycle == "h11" || hourCycle == "h12"
+}
+
+// convertIntlHourCycle replaces the hour fields of a pattern with those of an hour cycle.
+func convertIntlHourCycle(pattern string, hourCycle string) string {
+	symbol := map[string]byte{"h11": 'K', "h12": 'h', "h23": 'H', "h24": 'k'}[hourCycle]
+	if symbol == 0 {
+		return pattern
+	}
+
+	tokens := tokenizeIntlDateTimePattern(pattern)
+	var builder strings.Builder
+	for _, token := range tokens {
+		switch {
+		case token.Literal:
+			builder.WriteString(quoteIntlDateTimeLiteral(token.Text))
+		case strings.ContainsRune("hHKk", rune(token.Text[0])):
+			builder.WriteString(strings.Repeat(string(symbol), len(token.Text)))
+		default:
+			builder.WriteString(token.Text)
+		}
+	}
+	return builder.String()
+}
+
+// joinIntlDateTimePatterns joins a date and time pattern with a pattern of the locale, where "{1}" is the date and
+// "{0}" the time.
+func joinIntlDateTimePatterns(glue string, datePattern string, timePattern string) string {
+	return strings.NewReplacer("{1}", datePattern, "{0}", timePattern).Replace(glue)
+}
+
+// intlDateTimeToken is a field or literal of a date pattern.
+type intlDateTimeToken struct {
+	Text    string
+	Literal bool
+}
+
+// tokenizeIntlDateTimePattern splits a pattern into its fields, which are runs of the same letter, and literals, which
+// are other characters or text quoted with '.
+func tokenizeIntlDateTimePattern(pattern string) []intlDateTimeToken {
+	tokens := []intlDateTimeToken{}
+	appendLiteral := func(text string) {
+		if len(tokens) > 0 && tokens[len(tokens)-1].Literal {
+			tokens[len(tokens)-1].Text += text
+		} else {
+			tokens = append(tokens, intlDateTimeToken{Text: text, Literal: true})
+		}
+	}
+
+	runes := []rune(pattern)
+	for idx := 0; idx < len(runes); idx++ {
+		char := runes[idx]
+		switch {
+		case char == '\'':
+			// '' is a quote, and text between quotes is literal.
+			if idx+1 < len(runes) && runes[idx+1] == '\'' {
+				appendLiteral("'")
+				idx++
+				continue
+			}
+
+			end := idx + 1
+			for end < len(runes) && runes[end] != '\'' {
+				end++
+			}
+			appendLiteral(string(runes[idx+1 : end]))
+			idx = end
+		case (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z'):
+			end := idx + 1
+			for end < len(runes) && runes[end] == char {
+				end++
+			}
+			tokens = append(tokens, intlDateTimeToken{Text: string(runes[idx:end])})
+			idx = end - 1
+		default:
+			appendLiteral(string(char))
+		}
+	}
+	return tokens
+}
+
+// quoteIntlDateTimeLiteral quotes a literal of a pattern if it contains letters or quotes.
+func quoteIntlDateTimeLiteral(text string) string {
+	if !strings.ContainsFunc(text, func(char rune) bool {
+		return char == '\'' || (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z')
+	}) {
+		return text
+	}
+	return "'" + strings.ReplaceAll(text, "'", "''") + "'"
+}
+
+// ToIntlTimeValue converts the argument of format to a time value, the milliseconds since the epoch, which defaults to
+// the current time. The engine has no Date objects, so dates are always given as time values.
+func ToIntlTimeValue(runtime *Runtime, value *JavaScriptValue) (time.Time, *Completion) {
+	if value.Type == TypeUndefined {
+		return runtime.Now(), nil
+	}
+
+	completion := ToNumber(runtime, value)
+	if completion.Type != Normal {
+		return time.Time{}, completion
+	}
+
+	// TimeClip
+	number := completion.Value.(*JavaScriptValue).Value.(*Number)
+	if number.NaN || math.IsNaN(number.Value) || math.Abs(number.Value) > 8.64e15 {
+		return time.Time{}, NewThrowCompletion(NewRangeError(runtime, "Invalid time value"))
+	}
+	return time.UnixMilli(int64(number.Value)), nil
+}
+
+// FormatDateTimeToParts formats a time with the pattern of a DateTimeFormat, as parts. This corresponds to
+// FormatDateTimePattern in the spec.
+func FormatDateTimeToParts(dtf *DateTimeFormatState, t time.Time) []intlPart {
+	data := dtf.Data
+	t = t.In(dtf.Location)
+
+	year := t.Year()
+	era := 1
+	if year <= 0 {
+		era, year = 0, 1-year
+	}
+
+	pad := func(n int, width int) string {
+		text := strconv.Itoa(n)
+		if len(text) < width {
+			text = strings.Repeat("0", width-len(text)) + text
+		}
+		return text
+	}
+	text := func(names []string, width int) string {
+		switch {
+		case width == 4:
+			return names[2]
+		case width >= 5:
+			return names[0]
+		}
+		return names[1]
+	}
+
+	parts := []intlPart{}
+	for _, token := range tokenizeIntlDateTimePattern(dtf.Pattern) {
+		if token.Literal {
+			if len(parts) > 0 && parts[len(parts)-1].Type == "literal" {
+				parts[len(parts)-1].Value += token.Text
+			} else {
+				parts = append(parts, intlPart{"literal", token.Text})
+			}
+			continue
+		}
+
+		width := len(token.Text)
+		switch token.Text[0] {
+		case 'G':
+			parts = append(parts, intlPart{"era", text([]string{data.Eras[0][era], data.Eras[1][era], data.Eras[2][era]}, width)})
+		case 'y':
+			value := pad(year, width)
+			if width == 2 {
+				value = pad(year%100, 2)
+			}
+			parts = append(parts, intlPart{"year", value})
+		case 'M', 'L':
+			month := int(t.Month()) - 1
+			value := pad(month+1, width)
+			if width >= 3 {
+				value = text([]string{data.Months[0][month], data.Months[1][month], data.Months[2][month]}, width)
+			}
+			parts = append(parts, intlPart{"month", value})
+		case 'E', 'c':
+			weekday := int(t.Weekday())
+			parts = append(parts, intlPart{"weekday", text([]string{data.Weekdays[0][weekday], data.Weekdays[1][weekday], data.Weekdays[2][weekday]}, width)})
+		case 'd':
+			parts = append(parts, intlPart{"day", pad(t.Day(), width)})
+		case 'a':
+			parts = append(parts, intlPart{"dayPeriod", data.DayPeriods[t.Hour()/12]})
+		case 'h':
+			parts = append(parts, intlPart{"hour", pad((t.Hour()+11)%12+1, width)})
+		case 'K':
+			parts = append(parts, intlPart{"hour", pad(t.Hour()%12, width)})
+		case 'H':
+			parts = append(parts, intlPart{"hour", pad(t.Hour(), width)})
+		case 'k':
+			parts = append(parts, intlPart{"hour", pad((t.Hour()+23)%24+1, width)})
+		case 'm':
+			parts = append(parts, intlPart{"minute", pad(t.Minute(), width)})
+		case 's':
+			parts = append(parts, intlPart{"second", pad(t.Second(), width)})
+		case 'S':
+			parts = append(parts, intlPart{"fractionalSecond", pad(t.Nanosecond()/int(time.Millisecond), 3)[:width]})
+		case 'z', 'O':
+			parts = append(parts, intlPart{"timeZoneName", dtf.timeZoneName(t, token.Text)})
+		}
+	}
+	return parts
+}
+
+// timeZoneName formats the time zone of a time. There is no data for the names of time zones, so zones other than UTC
+// are written as their offset, e.g. "GMT-8" and "GMT-08:00".
+func (dtf *DateTimeFormatState) timeZoneName(t time.Time, field string) string {
+	data := dtf.Data
+	long := len(field) == 4
+
+	if field[0] == 'z' && dtf.TimeZone == "UTC" {
+		if long {
+			return data.UTCLongName
+		}
+		return data.UTCShortName
+	}
+
+	_, offset := t.Zone()
+	if offset == 0 {
+		return strings.Replace(data.GMTPattern, "{0}", "", 1)
+	}
+
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	hours, minutes := offset/3600, offset/60%60
+
+	var gmtOffset string
+	switch {
+	case long:
+		gmtOffset = sign + pad2(hours) + ":" + pad2(minutes)
+	case minutes != 0:
+		gmtOffset = sign + strconv.Itoa(hours) + ":" + pad2(minutes)
+	default:
+		gmtOffset = sign + strconv.Itoa(hours)
+	}
+	return strings.Replace(data.GMTPattern, "{0}", gmtOffset, 1)
+}
+
+// pad2 formats a number with at least two digits.
+func pad2(n int) string {
+	if n < 10 {
+		return "0" + strconv.Itoa(n)
+	}
+	return strconv.Itoa(n)
+}
+
+// resolvedFieldWidths returns the widths of the fields of the pattern of a DateTimeFormat, as resolvedOptions reports
+// them.
+func (dtf *DateTimeFormatState) resolvedFieldWidths() map[string]string {
+	widths := map[string]string{}
+	numeric := func(width int) string {
+		if width == 2 {
+			return "2-digit"
+		}
+		return "numeric"
+	}
+	textual := func(width int) string {
+		switch {
+		case width == 4:
+			return "long"
+		case width >= 5:
+			return "narrow"
+		}
+		return "short"
+	}
+
+	for _, token := range tokenizeIntlDateTimePattern(dtf.Pattern) {
+		if token.Literal {
+			continue
+		}
+
+		width := len(token.Text)
+		switch token.Text[0] {
+		case 'G':
+			widths["era"] = textual(width)
+		case 'y':
+			widths["year"] = numeric(width)
+		case 'M', 'L':
+			if width >= 3 {
+				widths["month"] = textual(width)
+			} else {
+				widths["month"] = numeric(width)
+			}
+		case 'E', 'c':
+			widths["weekday"] = textual(width)
+		case 'd':
+			widths["day"] = numeric(width)
+		case 'h', 'H', 'K', 'k':
+			widths["hour"] = numeric(width)
+		case 'm':
+			widths["minute"] = numeric(width)
+		case 's':
+			widths["second"] = numeric(width)
+		}
+	}
+
+	if zone, ok := dtf.Fields["timeZoneName"]; ok {
+		widths["timeZoneName"] = zone
+	}
+	return widths
+}
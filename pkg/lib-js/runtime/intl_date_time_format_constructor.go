@@ -0,0 +1,39 @@
+package runtime
+
+func NewIntlDateTimeFormatConstructor(runtime *Runtime) *FunctionObject {
+	return newIntlServiceConstructor(runtime, IntlDateTimeFormatConstructor, "DateTimeFormat", IntrinsicIntlDateTimeFormatPrototype)
+}
+
+// Intl.DateTimeFormat(locales, options)
+func IntlDateTimeFormatConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	// Intl.DateTimeFormat may be called as a function, which creates a new DateTimeFormat.
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		newTarget = NewJavaScriptValue(TypeObject, function)
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicIntlDateTimeFormatPrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	requested, options, completion := intlConstructorArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	dateTimeFormat, completion := InitializeDateTimeFormat(runtime, requested, options)
+	if completion != nil {
+		return completion
+	}
+
+	dateTimeFormatVal := createCompletion.Value.(*JavaScriptValue)
+	dateTimeFormatVal.Value.(*Object).DateTimeFormat = dateTimeFormat
+
+	return NewNormalCompletion(dateTimeFormatVal)
+}
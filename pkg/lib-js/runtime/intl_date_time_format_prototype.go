@@ -0,0 +1,145 @@
+package runtime
+
+func NewIntlDateTimeFormatPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineIntlDateTimeFormatPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Intl.DateTimeFormat.prototype.format
+	DefineBuiltinAccessorFunction(runtime, prototype, "format", IntlDateTimeFormatPrototypeFormatGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// Intl.DateTimeFormat.prototype.formatToParts
+	DefineBuiltinFunction(runtime, prototype, "formatToParts", IntlDateTimeFormatPrototypeFormatToParts, 1)
+
+	// Intl.DateTimeFormat.prototype.resolvedOptions
+	DefineBuiltinFunction(runtime, prototype, "resolvedOptions", IntlDateTimeFormatPrototypeResolvedOptions, 0)
+
+	// Intl.DateTimeFormat.prototype[%Symbol.toStringTag%]
+	defineIntlToStringTag(runtime, prototype, "Intl.DateTimeFormat")
+}
+
+func thisDateTimeFormat(runtime *Runtime, thisArg *JavaScriptValue, method string) (*DateTimeFormatState, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.DateTimeFormat != nil {
+			return object.DateTimeFormat, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Intl.DateTimeFormat.prototype."+method+" called on incompatible receiver"))
+}
+
+// get Intl.DateTimeFormat.prototype.format
+func IntlDateTimeFormatPrototypeFormatGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	dtf, completion := thisDateTimeFormat(runtime, thisArg, "format")
+	if completion != nil {
+		return completion
+	}
+
+	// The function is bound to the DateTimeFormat, so it can be passed around, e.g. to Array.prototype.map.
+	if dtf.BoundFormat == nil {
+		dtf.BoundFormat = CreateBuiltinFunction(
+			runtime,
+			func(runtime *Runtime, function *FunctionObject, thisArg *JavaScriptValue, arguments []*JavaScriptValue, newTarget *JavaScriptValue) *Completion {
+				value := NewUndefinedValue()
+				if len(arguments) > 0 {
+					value = arguments[0]
+				}
+
+				t, completion := ToIntlTimeValue(runtime, value)
+				if completion != nil {
+					return completion
+				}
+				return NewNormalCompletion(NewStringValue(joinIntlParts(FormatDateTimeToParts(dtf, t))))
+			},
+			1,
+			NewStringValue(""),
+			nil,
+			nil,
+		)
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, dtf.BoundFormat))
+}
+
+// Intl.DateTimeFormat.prototype.formatToParts(date)
+func IntlDateTimeFormatPrototypeFormatToParts(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	dtf, completion := thisDateTimeFormat(runtime, thisArg, "formatToParts")
+	if completion != nil {
+		return completion
+	}
+
+	value := NewUndefinedValue()
+	if len(arguments) > 0 {
+		value = arguments[0]
+	}
+
+	t, completion := ToIntlTimeValue(runtime, value)
+	if completion != nil {
+		return completion
+	}
+	return NewNormalCompletion(newIntlPartsArray(runtime, FormatDateTimeToParts(dtf, t), nil))
+}
+
+// Intl.DateTimeFormat.prototype.resolvedOptions()
+func IntlDateTimeFormatPrototypeResolvedOptions(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	dtf, completion := thisDateTimeFormat(runtime, thisArg, "resolvedOptions")
+	if completion != nil {
+		return completion
+	}
+
+	members := []intlResolvedOption{
+		{"locale", NewStringValue(dtf.Locale)},
+		{"calendar", NewStringValue(dtf.Calendar)},
+		{"numberingSystem", NewStringValue(dtf.NumberingSystem)},
+		{"timeZone", NewStringValue(dtf.TimeZone)},
+	}
+	if dtf.HourCycle != "" {
+		members = append(members,
+			intlResolvedOption{"hourCycle", NewStringValue(dtf.HourCycle)},
+			intlResolvedOption{"hour12", NewBooleanValue(isTwelveHourCycle(dtf.HourCycle))},
+		)
+	}
+
+	// The fields are reported with the widths of the pattern, unless a style picked the pattern.
+	if dtf.DateStyle == "" && dtf.TimeStyle == "" {
+		widths := dtf.resolvedFieldWidths()
+		for _, field := range intlDateTimeFields {
+			if field.Name == "timeZoneName" && dtf.FractionalSecondDigits != 0 {
+				members = append(members, intlResolvedOption{"fractionalSecondDigits", NewNumberValue(float64(dtf.FractionalSecondDigits), false)})
+			}
+			if width, ok := widths[field.Name]; ok {
+				members = append(members, intlResolvedOption{field.Name, NewStringValue(width)})
+			}
+		}
+	}
+
+	if dtf.DateStyle != "" {
+		members = append(members, intlResolvedOption{"dateStyle", NewStringValue(dtf.DateStyle)})
+	}
+	if dtf.TimeStyle != "" {
+		members = append(members, intlResolvedOption{"timeStyle", NewStringValue(dtf.TimeStyle)})
+	}
+
+	return NewNormalCompletion(intlResolvedOptions(runtime, members))
+}
@@ -0,0 +1,124 @@
+package runtime
+
+import "strings"
+
+// ListFormatState is the state of an Intl.ListFormat object, its internal slots in the spec.
+type ListFormatState struct {
+	Locale string
+	Data   *intlLocaleData
+	Type   string // "conjunction", "disjunction" or "unit".
+	Style  string // "long", "short" or "narrow".
+}
+
+// InitializeListFormat reads the options of a ListFormat for the requested locales.
+func InitializeListFormat(runtime *Runtime, requested []string, options *JavaScriptValue) (*ListFormatState, *Completion) {
+	options, completion := getIntlOptionsObject(runtime, options)
+	if completion != nil {
+		return nil, completion
+	}
+
+	if _, completion := getIntlStringOption(runtime, options, "localeMatcher", []string{"lookup", "best fit"}, "best fit"); completion != nil {
+		return nil, completion
+	}
+
+	locale, dataLocale := ResolveLocale(requested)
+
+	listType, completion := getIntlStringOption(runtime, options, "type", []string{"conjunction", "disjunction", "unit"}, "conjunction")
+	if completion != nil {
+		return nil, completion
+	}
+
+	style, completion := getIntlStringOption(runtime, options, "style", []string{"long", "short", "narrow"}, "long")
+	if completion != nil {
+		return nil, completion
+	}
+
+	return &ListFormatState{
+		Locale: locale,
+		Data:   intlLocaleDataByLocale[dataLocale],
+		Type:   listType,
+		Style:  style,
+	}, nil
+}
+
+// FormatListToParts joins a list of strings with the patterns of a ListFormat, as parts. This corresponds to
+// CreatePartsFromList in the spec.
+func FormatListToParts(lf *ListFormatState, list []string) []intlPart {
+	if len(list) == 0 {
+		return []intlPart{}
+	}
+
+	pattern := lf.Data.ListPatterns[lf.Type][lf.Style]
+	element := func(idx int) []intlPart {
+		return []intlPart{{"element", list[idx]}}
+	}
+
+	if len(list) == 2 {
+		return applyIntlListPattern(pattern.Two, element(0), element(1))
+	}
+
+	// Longer lists are joined from the end: the last two items with End, then each item before them with Middle, and
+	// the first item with Start.
+	parts := element(len(list) - 1)
+	for idx := len(list) - 2; idx >= 0; idx-- {
+		join := pattern.Middle
+		switch idx {
+		case len(list) - 2:
+			join = pattern.End
+		case 0:
+			join = pattern.Start
+		}
+		parts = applyIntlListPattern(join, element(idx), parts)
+	}
+	return parts
+}
+
+// applyIntlListPattern replaces "{0}" and "{1}" in a list pattern with parts, and the text around them with literals.
+func applyIntlListPattern(pattern string, first []intlPart, second []intlPart) []intlPart {
+	before, rest, _ := strings.Cut(pattern, "{0}")
+	between, after, _ := strings.Cut(rest, "{1}")
+
+	parts := []intlPart{}
+	appendLiteral := func(text string) {
+		if text != "" {
+			parts = append(parts, intlPart{"literal", text})
+		}
+	}
+
+	appendLiteral(before)
+	parts = append(parts, first...)
+	appendLiteral(between)
+	parts = append(parts, second...)
+	appendLiteral(after)
+	return parts
+}
+
+// StringListFromIterable reads the strings of the list passed to format, which must only yield strings.
+func StringListFromIterable(runtime *Runtime, iterable *JavaScriptValue) ([]string, *Completion) {
+	if iterable.Type == TypeUndefined {
+		return []string{}, nil
+	}
+
+	completion := GetIterator(runtime, iterable, IteratorKindSync)
+	if completion.Type != Normal {
+		return nil, completion
+	}
+	iterator := completion.Value.(*Iterator)
+
+	list := []string{}
+	for {
+		completion := IteratorStepValue(runtime, iterator)
+		if completion.Type != Normal {
+			return nil, completion
+		}
+		if iterator.Done {
+			return list, nil
+		}
+
+		value := completion.Value.(*JavaScriptValue)
+		if value.Type != TypeString {
+			return nil, IteratorClose(runtime, iterator, NewThrowCompletion(NewTypeError(runtime, "Iterable yielded a value which is not a string")))
+		}
+		list = append(list, value.Value.(*String).Value)
+	}
+}
@@ -0,0 +1,38 @@
+package runtime
+
+func NewIntlListFormatConstructor(runtime *Runtime) *FunctionObject {
+	return newIntlServiceConstructor(runtime, IntlListFormatConstructor, "ListFormat", IntrinsicIntlListFormatPrototype)
+}
+
+// Intl.ListFormat(locales, options)
+func IntlListFormatConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "Intl.ListFormat constructor requires 'new'"))
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicIntlListFormatPrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	requested, options, completion := intlConstructorArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	listFormat, completion := InitializeListFormat(runtime, requested, options)
+	if completion != nil {
+		return completion
+	}
+
+	listFormatVal := createCompletion.Value.(*JavaScriptValue)
+	listFormatVal.Value.(*Object).ListFormat = listFormat
+
+	return NewNormalCompletion(listFormatVal)
+}
@@ -0,0 +1,100 @@
+package runtime
+
+func NewIntlListFormatPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineIntlListFormatPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Intl.ListFormat.prototype.format
+	DefineBuiltinFunction(runtime, prototype, "format", IntlListFormatPrototypeFormat, 1)
+
+	// Intl.ListFormat.prototype.formatToParts
+	DefineBuiltinFunction(runtime, prototype, "formatToParts", IntlListFormatPrototypeFormatToParts, 1)
+
+	// Intl.ListFormat.prototype.resolvedOptions
+	DefineBuiltinFunction(runtime, prototype, "resolvedOptions", IntlListFormatPrototypeResolvedOptions, 0)
+
+	// Intl.ListFormat.prototype[%Symbol.toStringTag%]
+	defineIntlToStringTag(runtime, prototype, "Intl.ListFormat")
+}
+
+func thisListFormat(runtime *Runtime, thisArg *JavaScriptValue, method string) (*ListFormatState, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.ListFormat != nil {
+			return object.ListFormat, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Intl.ListFormat.prototype."+method+" called on incompatible receiver"))
+}
+
+// listFormatArguments returns the ListFormat and list of strings the format methods are called with.
+func listFormatArguments(runtime *Runtime, thisArg *JavaScriptValue, arguments []*JavaScriptValue, method string) (*ListFormatState, []string, *Completion) {
+	lf, completion := thisListFormat(runtime, thisArg, method)
+	if completion != nil {
+		return nil, nil, completion
+	}
+
+	iterable := NewUndefinedValue()
+	if len(arguments) > 0 {
+		iterable = arguments[0]
+	}
+
+	list, completion := StringListFromIterable(runtime, iterable)
+	if completion != nil {
+		return nil, nil, completion
+	}
+	return lf, list, nil
+}
+
+// Intl.ListFormat.prototype.format(list)
+func IntlListFormatPrototypeFormat(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	lf, list, completion := listFormatArguments(runtime, thisArg, arguments, "format")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(joinIntlParts(FormatListToParts(lf, list))))
+}
+
+// Intl.ListFormat.prototype.formatToParts(list)
+func IntlListFormatPrototypeFormatToParts(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	lf, list, completion := listFormatArguments(runtime, thisArg, arguments, "formatToParts")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(newIntlPartsArray(runtime, FormatListToParts(lf, list), nil))
+}
+
+// Intl.ListFormat.prototype.resolvedOptions()
+func IntlListFormatPrototypeResolvedOptions(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	lf, completion := thisListFormat(runtime, thisArg, "resolvedOptions")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(intlResolvedOptions(runtime, []intlResolvedOption{
+		{"locale", NewStringValue(lf.Locale)},
+		{"type", NewStringValue(lf.Type)},
+		{"style", NewStringValue(lf.Style)},
+	}))
+}
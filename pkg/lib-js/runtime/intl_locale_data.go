@@ -0,0 +1,410 @@
+package runtime
+
+// intlLocaleData is the CLDR data of a locale that the Intl services use.
+type intlLocaleData struct {
+	// Number symbols.
+	Decimal     string
+	Group       string
+	PercentSign string
+	MinusSign   string
+	PlusSign    string
+	Exponential string
+	Infinity    string
+	NaN         string
+
+	// Number patterns, where "-" is the sign, "#" the number, "%" the percent sign and "¤" the currency.
+	DecimalPattern       string
+	PercentPattern       string
+	CurrencyPattern      string
+	AccountingPattern    string // The pattern of negative numbers in accounting; positive numbers use CurrencyPattern.
+	CurrencyNamePattern  string
+	ShortCompactPatterns []intlCompactPattern
+	LongCompactPatterns  []intlCompactPattern
+
+	// Currencies, keyed by ISO 4217 code. Names are keyed by plural category.
+	CurrencySymbols       map[string]string
+	NarrowCurrencySymbols map[string]string
+	CurrencyNames         map[string]map[string]string
+
+	// Gregorian calendar names, indexed by width: narrow, abbreviated and wide.
+	Months     [3][12]string
+	Weekdays   [3][7]string // Starting on Sunday.
+	Eras       [3][2]string // BC and AD.
+	DayPeriods [2]string    // AM and PM.
+
+	// Date and time patterns, indexed by style: full, long, medium and short.
+	DatePatterns     [4]string
+	TimePatterns     [4]string
+	DateTimePatterns [4]string // "{1}" is the date and "{0}" the time.
+
+	// Patterns for sets of date and time fields, keyed by skeleton.
+	AvailableFormats map[string]string
+	HourCycle        string
+
+	// Time zone names.
+	UTCShortName string
+	UTCLongName  string
+	GMTPattern   string
+
+	// Plural rules, which pick the plural category of a number.
+	PluralCategories        []string
+	OrdinalPluralCategories []string
+	PluralRule              func(operands intlPluralOperands) string
+	OrdinalPluralRule       func(operands intlPluralOperands) string
+
+	// List patterns, keyed by type and then style.
+	ListPatterns map[string]map[string]intlListPattern
+
+	// Relative time patterns, keyed by unit and then style.
+	RelativeTimePatterns map[string]map[string]intlRelativeTimePattern
+}
+
+// intlCompactPattern is the compact form of numbers of a magnitude, which are divided by 10 to the power of Divisor
+// and written with Compact after them, separated by Literal.
+type intlCompactPattern struct {
+	Divisor int
+	Literal string
+	Compact string
+}
+
+// intlListPattern joins lists: Two joins a list of two, and longer lists join their first two items with Start, the
+// items in the middle with Middle and the last one with End.
+type intlListPattern struct {
+	Start  string
+	Middle string
+	End    string
+	Two    string
+}
+
+// intlRelativeTimePattern formats amounts of a unit of time, in the future and past keyed by plural category, with
+// phrases for some amounts with numeric set to "auto", e.g. "yesterday" for -1 day.
+type intlRelativeTimePattern struct {
+	Future  map[string]string
+	Past    map[string]string
+	Phrases map[int]string
+}
+
+var intlLocaleDataByLocale = map[string]*intlLocaleData{
+	"en":  intlEnglishData,
+	"und": intlRootData,
+}
+
+var intlEnglishData = &intlLocaleData{
+	Decimal:     ".",
+	Group:       ",",
+	PercentSign: "%",
+	MinusSign:   "-",
+	PlusSign:    "+",
+	Exponential: "E",
+	Infinity:    "∞",
+	NaN:         "NaN",
+
+	DecimalPattern:      "-#",
+	PercentPattern:      "-#%",
+	CurrencyPattern:     "-¤#",
+	AccountingPattern:   "(¤#)",
+	CurrencyNamePattern: "-# ¤",
+	ShortCompactPatterns: []intlCompactPattern{
+		{Divisor: 3, Compact: "K"},
+		{Divisor: 6, Compact: "M"},
+		{Divisor: 9, Compact: "B"},
+		{Divisor: 12, Compact: "T"},
+	},
+	LongCompactPatterns: []intlCompactPattern{
+		{Divisor: 3, Literal: " ", Compact: "thousand"},
+		{Divisor: 6, Literal: " ", Compact: "million"},
+		{Divisor: 9, Literal: " ", Compact: "billion"},
+		{Divisor: 12, Literal: " ", Compact: "trillion"},
+	},
+
+	CurrencySymbols: map[string]string{
+		"AUD": "A$", "BRL": "R$", "CAD": "CA$", "CNY": "CN¥", "EUR": "€", "GBP": "£", "HKD": "HK$", "ILS": "₪",
+		"INR": "₹", "JPY": "¥", "KRW": "₩", "MXN": "MX$", "NZD": "NZ$", "TWD": "NT$", "USD": "$", "VND": "₫",
+	},
+	NarrowCurrencySymbols: map[string]string{
+		"AUD": "$", "BRL": "R$", "CAD": "$", "CHF": "CHF", "CNY": "¥", "EUR": "€", "GBP": "£", "HKD": "$", "ILS": "₪",
+		"INR": "₹", "JPY": "¥", "KRW": "₩", "MXN": "$", "NZD": "$", "SEK": "kr", "TWD": "$", "USD": "$", "VND": "₫",
+	},
+	CurrencyNames: map[string]map[string]string{
+		"AUD": {"one": "Australian dollar", "other": "Australian dollars"},
+		"BRL": {"one": "Brazilian real", "other": "Brazilian reals"},
+		"CAD": {"one": "Canadian dollar", "other": "Canadian dollars"},
+		"CHF": {"one": "Swiss franc", "other": "Swiss francs"},
+		"CNY": {"one": "Chinese yuan", "other": "Chinese yuan"},
+		"EUR": {"one": "euro", "other": "euros"},
+		"GBP": {"one": "British pound", "other": "British pounds"},
+		"HKD": {"one": "Hong Kong dollar", "other": "Hong Kong dollars"},
+		"INR": {"one": "Indian rupee", "other": "Indian rupees"},
+		"JPY": {"one": "Japanese yen", "other": "Japanese yen"},
+		"KRW": {"one": "South Korean won", "other": "South Korean won"},
+		"MXN": {"one": "Mexican peso", "other": "Mexican pesos"},
+		"NZD": {"one": "New Zealand dollar", "other": "New Zealand dollars"},
+		"SEK": {"one": "Swedish krona", "other": "Swedish kronor"},
+		"USD": {"one": "US dollar", "other": "US dollars"},
+	},
+
+	Months: [3][12]string{
+		{"J", "F", "M", "A", "M", "J", "J", "A", "S", "O", "N", "D"},
+		{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	Weekdays: [3][7]string{
+		{"S", "M", "T", "W", "T", "F", "S"},
+		{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	},
+	Eras: [3][2]string{
+		{"B", "A"},
+		{"BC", "AD"},
+		{"Before Christ", "Anno Domini"},
+	},
+	DayPeriods: [2]string{"AM", "PM"},
+
+	DatePatterns:     [4]string{"EEEE, MMMM d, y", "MMMM d, y", "MMM d, y", "M/d/yy"},
+	TimePatterns:     [4]string{"h:mm:ss\u202fa zzzz", "h:mm:ss\u202fa z", "h:mm:ss\u202fa", "h:mm\u202fa"},
+	DateTimePatterns: [4]string{"{1} 'at' {0}", "{1} 'at' {0}", "{1}, {0}", "{1}, {0}"},
+
+	AvailableFormats: map[string]string{
+		"d": "d", "E": "ccc", "Ed": "d E", "Gy": "y G", "GyMd": "M/d/y G", "GyMMM": "MMM y G", "GyMMMd": "MMM d, y G",
+		"GyMMMEd": "E, MMM d, y G", "M": "L", "Md": "M/d", "MEd": "E, M/d", "MMM": "LLL", "MMMd": "MMM d",
+		"MMMEd": "E, MMM d", "y": "y", "yM": "M/y", "yMd": "M/d/y", "yMEd": "E, M/d/y", "yMMM": "MMM y",
+		"yMMMd": "MMM d, y", "yMMMEd": "E, MMM d, y",
+		"h": "h\u202fa", "hm": "h:mm\u202fa", "hms": "h:mm:ss\u202fa", "H": "HH", "Hm": "HH:mm", "Hms": "HH:mm:ss",
+		"m": "m", "ms": "mm:ss", "s": "s",
+	},
+	HourCycle: "h12",
+
+	UTCShortName: "UTC",
+	UTCLongName:  "Coordinated Universal Time",
+	GMTPattern:   "GMT{0}",
+
+	PluralCategories:        []string{"one", "other"},
+	OrdinalPluralCategories: []string{"one", "two", "few", "other"},
+	PluralRule: func(operands intlPluralOperands) string {
+		if operands.I == 1 && operands.V == 0 {
+			return "one"
+		}
+		return "other"
+	},
+	OrdinalPluralRule: func(operands intlPluralOperands) string {
+		if operands.V != 0 {
+			return "other"
+		}
+		switch n10, n100 := operands.I%10, operands.I%100; {
+		case n10 == 1 && n100 != 11:
+			return "one"
+		case n10 == 2 && n100 != 12:
+			return "two"
+		case n10 == 3 && n100 != 13:
+			return "few"
+		}
+		return "other"
+	},
+
+	ListPatterns: map[string]map[string]intlListPattern{
+		"conjunction": {
+			"long":   {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, and {1}", Two: "{0} and {1}"},
+			"short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, & {1}", Two: "{0} & {1}"},
+			"narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+		},
+		"disjunction": {
+			"long":   {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, or {1}", Two: "{0} or {1}"},
+			"short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, or {1}", Two: "{0} or {1}"},
+			"narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, or {1}", Two: "{0} or {1}"},
+		},
+		"unit": {
+			"long":   {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+			"short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+			"narrow": {Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}", Two: "{0} {1}"},
+		},
+	},
+
+	RelativeTimePatterns: map[string]map[string]intlRelativeTimePattern{
+		"year": {
+			"long":   englishRelativeTime("year", "years", map[int]string{-1: "last year", 0: "this year", 1: "next year"}),
+			"short":  englishRelativeTime("yr.", "yr.", map[int]string{-1: "last yr.", 0: "this yr.", 1: "next yr."}),
+			"narrow": englishNarrowRelativeTime("y", map[int]string{-1: "last yr.", 0: "this yr.", 1: "next yr."}),
+		},
+		"quarter": {
+			"long":   englishRelativeTime("quarter", "quarters", map[int]string{-1: "last quarter", 0: "this quarter", 1: "next quarter"}),
+			"short":  englishRelativeTime("qtr.", "qtrs.", map[int]string{-1: "last qtr.", 0: "this qtr.", 1: "next qtr."}),
+			"narrow": englishNarrowRelativeTime("q", map[int]string{-1: "last qtr.", 0: "this qtr.", 1: "next qtr."}),
+		},
+		"month": {
+			"long":   englishRelativeTime("month", "months", map[int]string{-1: "last month", 0: "this month", 1: "next month"}),
+			"short":  englishRelativeTime("mo.", "mo.", map[int]string{-1: "last mo.", 0: "this mo.", 1: "next mo."}),
+			"narrow": englishNarrowRelativeTime("mo", map[int]string{-1: "last mo.", 0: "this mo.", 1: "next mo."}),
+		},
+		"week": {
+			"long":   englishRelativeTime("week", "weeks", map[int]string{-1: "last week", 0: "this week", 1: "next week"}),
+			"short":  englishRelativeTime("wk.", "wk.", map[int]string{-1: "last wk.", 0: "this wk.", 1: "next wk."}),
+			"narrow": englishNarrowRelativeTime("w", map[int]string{-1: "last wk.", 0: "this wk.", 1: "next wk."}),
+		},
+		"day": {
+			"long":   englishRelativeTime("day", "days", map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"}),
+			"short":  englishRelativeTime("day", "days", map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"}),
+			"narrow": englishNarrowRelativeTime("d", map[int]string{-1: "yesterday", 0: "today", 1: "tomorrow"}),
+		},
+		"hour": {
+			"long":   englishRelativeTime("hour", "hours", map[int]string{0: "this hour"}),
+			"short":  englishRelativeTime("hr.", "hr.", map[int]string{0: "this hour"}),
+			"narrow": englishNarrowRelativeTime("h", map[int]string{0: "this hour"}),
+		},
+		"minute": {
+			"long":   englishRelativeTime("minute", "minutes", map[int]string{0: "this minute"}),
+			"short":  englishRelativeTime("min.", "min.", map[int]string{0: "this minute"}),
+			"narrow": englishNarrowRelativeTime("m", map[int]string{0: "this minute"}),
+		},
+		"second": {
+			"long":   englishRelativeTime("second", "seconds", map[int]string{0: "now"}),
+			"short":  englishRelativeTime("sec.", "sec.", map[int]string{0: "now"}),
+			"narrow": englishNarrowRelativeTime("s", map[int]string{0: "now"}),
+		},
+	},
+}
+
+// englishRelativeTime returns the English patterns of a unit, e.g. "in 1 day" and "2 days ago".
+func englishRelativeTime(one string, other string, phrases map[int]string) intlRelativeTimePattern {
+	return intlRelativeTimePattern{
+		Future:  map[string]string{"one": "in {0} " + one, "other": "in {0} " + other},
+		Past:    map[string]string{"one": "{0} " + one + " ago", "other": "{0} " + other + " ago"},
+		Phrases: phrases,
+	}
+}
+
+// englishNarrowRelativeTime returns the narrow English patterns of a unit, e.g. "in 1d" and "2d ago".
+func englishNarrowRelativeTime(symbol string, phrases map[int]string) intlRelativeTimePattern {
+	return intlRelativeTimePattern{
+		Future:  map[string]string{"other": "in {0}" + symbol},
+		Past:    map[string]string{"other": "{0}" + symbol + " ago"},
+		Phrases: phrases,
+	}
+}
+
+// intlRootData is the data of the root locale, "und", which CLDR uses for locales without data of their own.
+var intlRootData = &intlLocaleData{
+	Decimal:     ".",
+	Group:       ",",
+	PercentSign: "%",
+	MinusSign:   "-",
+	PlusSign:    "+",
+	Exponential: "E",
+	Infinity:    "∞",
+	NaN:         "NaN",
+
+	DecimalPattern:      "-#",
+	PercentPattern:      "-#%",
+	CurrencyPattern:     "-¤\u00a0#",
+	AccountingPattern:   "-¤\u00a0#",
+	CurrencyNamePattern: "-# ¤",
+	ShortCompactPatterns: []intlCompactPattern{
+		{Divisor: 3, Compact: "K"},
+		{Divisor: 6, Compact: "M"},
+		{Divisor: 9, Compact: "G"},
+		{Divisor: 12, Compact: "T"},
+	},
+	LongCompactPatterns: []intlCompactPattern{
+		{Divisor: 3, Compact: "K"},
+		{Divisor: 6, Compact: "M"},
+		{Divisor: 9, Compact: "G"},
+		{Divisor: 12, Compact: "T"},
+	},
+
+	CurrencySymbols: map[string]string{
+		"AUD": "A$", "BRL": "R$", "CAD": "CA$", "CNY": "CN¥", "EUR": "€", "GBP": "£", "HKD": "HK$", "ILS": "₪",
+		"INR": "₹", "JPY": "JP¥", "KRW": "₩", "MXN": "MX$", "NZD": "NZ$", "TWD": "NT$", "USD": "US$", "VND": "₫",
+	},
+	NarrowCurrencySymbols: map[string]string{
+		"AUD": "$", "BRL": "R$", "CAD": "$", "CNY": "¥", "EUR": "€", "GBP": "£", "HKD": "$", "ILS": "₪", "INR": "₹",
+		"JPY": "¥", "KRW": "₩", "MXN": "$", "NZD": "$", "TWD": "$", "USD": "$", "VND": "₫",
+	},
+	CurrencyNames: map[string]map[string]string{},
+
+	Months: [3][12]string{
+		{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+		{"M01", "M02", "M03", "M04", "M05", "M06", "M07", "M08", "M09", "M10", "M11", "M12"},
+		{"M01", "M02", "M03", "M04", "M05", "M06", "M07", "M08", "M09", "M10", "M11", "M12"},
+	},
+	Weekdays: [3][7]string{
+		{"S", "M", "T", "W", "T", "F", "S"},
+		{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	},
+	Eras: [3][2]string{
+		{"BCE", "CE"},
+		{"BCE", "CE"},
+		{"BCE", "CE"},
+	},
+	DayPeriods: [2]string{"AM", "PM"},
+
+	DatePatterns:     [4]string{"y MMMM d, EEEE", "y MMMM d", "y MMM d", "y-MM-dd"},
+	TimePatterns:     [4]string{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+	DateTimePatterns: [4]string{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"},
+
+	AvailableFormats: map[string]string{
+		"d": "d", "E": "ccc", "Ed": "d, E", "Gy": "G y", "GyMd": "G y-MM-dd", "GyMMM": "G y MMM", "GyMMMd": "G y MMM d",
+		"GyMMMEd": "G y MMM d, E", "M": "L", "Md": "MM-dd", "MEd": "MM-dd, E", "MMM": "LLL", "MMMd": "MMM d",
+		"MMMEd": "MMM d, E", "y": "y", "yM": "y-MM", "yMd": "y-MM-dd", "yMEd": "y-MM-dd, E", "yMMM": "y MMM",
+		"yMMMd": "y MMM d", "yMMMEd": "y MMM d, E",
+		"h": "h\u202fa", "hm": "h:mm\u202fa", "hms": "h:mm:ss\u202fa", "H": "HH", "Hm": "HH:mm", "Hms": "HH:mm:ss",
+		"m": "m", "ms": "mm:ss", "s": "s",
+	},
+	HourCycle: "h23",
+
+	UTCShortName: "GMT",
+	UTCLongName:  "GMT",
+	GMTPattern:   "GMT{0}",
+
+	PluralCategories:        []string{"other"},
+	OrdinalPluralCategories: []string{"other"},
+	PluralRule: func(operands intlPluralOperands) string {
+		return "other"
+	},
+	OrdinalPluralRule: func(operands intlPluralOperands) string {
+		return "other"
+	},
+
+	ListPatterns: map[string]map[string]intlListPattern{
+		"conjunction": rootListPatterns,
+		"disjunction": rootListPatterns,
+		"unit":        rootListPatterns,
+	},
+
+	RelativeTimePatterns: map[string]map[string]intlRelativeTimePattern{
+		"year":    rootRelativeTime("y"),
+		"quarter": rootRelativeTime("Q"),
+		"month":   rootRelativeTime("m"),
+		"week":    rootRelativeTime("w"),
+		"day":     rootRelativeTime("d"),
+		"hour":    rootRelativeTime("h"),
+		"minute":  rootRelativeTime("min"),
+		"second":  rootRelativeTime("s"),
+	},
+}
+
+var rootListPatterns = map[string]intlListPattern{
+	"long":   {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+	"short":  {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+	"narrow": {Start: "{0}, {1}", Middle: "{0}, {1}", End: "{0}, {1}", Two: "{0}, {1}"},
+}
+
+// rootRelativeTime returns the root patterns of a unit, which are the same in every style, e.g. "+1 d" and "-2 d".
+func rootRelativeTime(symbol string) map[string]intlRelativeTimePattern {
+	pattern := intlRelativeTimePattern{
+		Future: map[string]string{"other": "+{0} " + symbol},
+		Past:   map[string]string{"other": "-{0} " + symbol},
+	}
+	return map[string]intlRelativeTimePattern{"long": pattern, "short": pattern, "narrow": pattern}
+}
+
+// intlCurrencyDigits returns the number of fraction digits of a currency, from ISO 4217.
+func intlCurrencyDigits(currency string) int {
+	switch currency {
+	case "BIF", "CLP", "DJF", "GNF", "ISK", "JPY", "KMF", "KRW", "PYG", "RWF", "UGX", "UYI", "VND", "VUV", "XAF",
+		"XOF", "XPF":
+		return 0
+	case "BHD", "IQD", "JOD", "KWD", "LYD", "OMR", "TND":
+		return 3
+	}
+	return 2
+}
@@ -0,0 +1,685 @@
+package runtime
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NumberFormatState is the state of an Intl.NumberFormat object, its internal slots in the spec.
+type NumberFormatState struct {
+	Locale          string
+	Data            *intlLocaleData
+	NumberingSystem string
+
+	Style           string
+	Currency        string
+	CurrencyDisplay string
+	CurrencySign    string
+	Notation        string
+	CompactDisplay  string
+	UseGrouping     string // "always", "auto", "min2", or "" for no grouping.
+	SignDisplay     string
+
+	Digits IntlDigitOptions
+
+	// The function the format getter returns, created the first time it is read.
+	BoundFormat *FunctionObject
+}
+
+// IntlDigitOptions are the options that decide which digits of a number are shown, set by SetNumberFormatDigitOptions.
+type IntlDigitOptions struct {
+	MinimumIntegerDigits     int
+	MinimumFractionDigits    int
+	MaximumFractionDigits    int
+	MinimumSignificantDigits int
+	MaximumSignificantDigits int
+	RoundingType             string // "fractionDigits", "significantDigits", "morePrecision" or "lessPrecision".
+	RoundingPriority         string // This corresponds to [[ComputedRoundingPriority]] in the spec.
+	RoundingMode             string
+	TrailingZeroDisplay      string
+}
+
+var intlRoundingModes = []string{"ceil", "floor", "expand", "trunc", "halfCeil", "halfFloor", "halfExpand", "halfTrunc", "halfEven"}
+
+// SetNumberFormatDigitOptions reads the digit options of Intl.NumberFormat and Intl.PluralRules, with the default
+// fraction digits of the style.
+func SetNumberFormatDigitOptions(
+	runtime *Runtime,
+	options *JavaScriptValue,
+	mnfdDefault int,
+	mxfdDefault int,
+	notation string,
+) (IntlDigitOptions, *Completion) {
+	digits := IntlDigitOptions{}
+
+	mnid, completion := getIntlNumberOption(runtime, options, "minimumIntegerDigits", 1, 21, 1)
+	if completion != nil {
+		return digits, completion
+	}
+	digits.MinimumIntegerDigits = mnid
+
+	raw := map[string]*JavaScriptValue{}
+	for _, name := range []string{"minimumFractionDigits", "maximumFractionDigits", "minimumSignificantDigits", "maximumSignificantDigits"} {
+		value, completion := getIntlOption(runtime, options, name)
+		if completion != nil {
+			return digits, completion
+		}
+		raw[name] = value
+	}
+
+	roundingIncrement, completion := getIntlNumberOption(runtime, options, "roundingIncrement", 1, 5000, 1)
+	if completion != nil {
+		return digits, completion
+	}
+	if roundingIncrement != 1 {
+		return digits, NewThrowCompletion(NewRangeError(runtime, "roundingIncrement value is out of range."))
+	}
+
+	roundingMode, completion := getIntlStringOption(runtime, options, "roundingMode", intlRoundingModes, "halfExpand")
+	if completion != nil {
+		return digits, completion
+	}
+	digits.RoundingMode = roundingMode
+
+	roundingPriority, completion := getIntlStringOption(runtime, options, "roundingPriority", []string{"auto", "morePrecision", "lessPrecision"}, "auto")
+	if completion != nil {
+		return digits, completion
+	}
+
+	trailingZeroDisplay, completion := getIntlStringOption(runtime, options, "trailingZeroDisplay", []string{"auto", "stripIfInteger"}, "auto")
+	if completion != nil {
+		return digits, completion
+	}
+	digits.TrailingZeroDisplay = trailingZeroDisplay
+
+	hasSd := raw["minimumSignificantDigits"] != nil || raw["maximumSignificantDigits"] != nil
+	hasFd := raw["minimumFractionDigits"] != nil || raw["maximumFractionDigits"] != nil
+
+	needSd, needFd := true, true
+	if roundingPriority == "auto" {
+		needSd = hasSd
+		if needSd || (!hasFd && notation == "compact") {
+			needFd = false
+		}
+	}
+
+	if needSd {
+		digits.MinimumSignificantDigits, digits.MaximumSignificantDigits = 1, 21
+		if hasSd {
+			mnsd, completion := defaultIntlNumberOption(runtime, raw["minimumSignificantDigits"], "minimumSignificantDigits", 1, 21, 1)
+			if completion != nil {
+				return digits, completion
+			}
+			mxsd, completion := defaultIntlNumberOption(runtime, raw["maximumSignificantDigits"], "maximumSignificantDigits", mnsd, 21, 21)
+			if completion != nil {
+				return digits, completion
+			}
+			digits.MinimumSignificantDigits, digits.MaximumSignificantDigits = mnsd, mxsd
+		}
+	}
+
+	if needFd {
+		digits.MinimumFractionDigits, digits.MaximumFractionDigits = mnfdDefault, mxfdDefault
+		if hasFd {
+			mnfd, completion := defaultIntlNumberOption(runtime, raw["minimumFractionDigits"], "minimumFractionDigits", 0, 100, -1)
+			if completion != nil {
+				return digits, completion
+			}
+			mxfd, completion := defaultIntlNumberOption(runtime, raw["maximumFractionDigits"], "maximumFractionDigits", 0, 100, -1)
+			if completion != nil {
+				return digits, completion
+			}
+
+			switch {
+			case mnfd == -1:
+				mnfd = min(mnfdDefault, mxfd)
+			case mxfd == -1:
+				mxfd = max(mxfdDefault, mnfd)
+			case mnfd > mxfd:
+				return digits, NewThrowCompletion(NewRangeError(runtime, "maximumFractionDigits value is out of range."))
+			}
+			digits.MinimumFractionDigits, digits.MaximumFractionDigits = mnfd, mxfd
+		}
+	}
+
+	switch {
+	case !needSd && !needFd:
+		// Compact notation shows two significant digits, or all the integer digits if there are more.
+		digits.MinimumFractionDigits, digits.MaximumFractionDigits = 0, 0
+		digits.MinimumSignificantDigits, digits.MaximumSignificantDigits = 1, 2
+		digits.RoundingType = "morePrecision"
+		digits.RoundingPriority = "morePrecision"
+	case roundingPriority == "auto":
+		digits.RoundingType = "fractionDigits"
+		if needSd {
+			digits.RoundingType = "significantDigits"
+		}
+		digits.RoundingPriority = "auto"
+	default:
+		digits.RoundingType = roundingPriority
+		digits.RoundingPriority = roundingPriority
+	}
+
+	return digits, nil
+}
+
+// intlDecimal is a non-negative decimal number, the integer Digits times 10 to the power of Exponent. Digits has no
+// leading or trailing zeros, so zero has no digits.
+type intlDecimal struct {
+	Digits   string
+	Exponent int
+}
+
+// newIntlDecimalFromFloat returns the decimal of the absolute value of a finite number, using the shortest digits that
+// round-trip, as Number.prototype.toString does.
+func newIntlDecimalFromFloat(value float64) intlDecimal {
+	text := strconv.FormatFloat(math.Abs(value), 'e', -1, 64)
+	mantissa, exponentText, _ := strings.Cut(text, "e")
+	exponent, _ := strconv.Atoi(exponentText)
+
+	digits := strings.Replace(mantissa, ".", "", 1)
+	return newIntlDecimal(digits, exponent-(len(digits)-1))
+}
+
+// newIntlDecimal returns the decimal digits times 10 to the power of exponent, without leading or trailing zeros.
+func newIntlDecimal(digits string, exponent int) intlDecimal {
+	digits = strings.TrimLeft(digits, "0")
+	trimmed := strings.TrimRight(digits, "0")
+	exponent += len(digits) - len(trimmed)
+	if trimmed == "" {
+		exponent = 0
+	}
+	return intlDecimal{Digits: trimmed, Exponent: exponent}
+}
+
+func (d intlDecimal) IsZero() bool {
+	return d.Digits == ""
+}
+
+// Magnitude returns the power of ten of the first digit, or 0 for zero.
+func (d intlDecimal) Magnitude() int {
+	if d.IsZero() {
+		return 0
+	}
+	return d.Exponent + len(d.Digits) - 1
+}
+
+// Scale multiplies the decimal by 10 to the power of n.
+func (d intlDecimal) Scale(n int) intlDecimal {
+	if d.IsZero() {
+		return d
+	}
+	return intlDecimal{Digits: d.Digits, Exponent: d.Exponent + n}
+}
+
+// RoundAt rounds the decimal to a multiple of 10 to the power of magnitude, with one of intlRoundingModes. Modes like
+// "floor" depend on the sign of the number, which is negative if negative is true.
+func (d intlDecimal) RoundAt(magnitude int, mode string, negative bool) intlDecimal {
+	if d.IsZero() || d.Exponent >= magnitude {
+		return d
+	}
+
+	dropCount := magnitude - d.Exponent
+	kept, dropped := "", d.Digits
+	if dropCount < len(d.Digits) {
+		kept, dropped = d.Digits[:len(d.Digits)-dropCount], d.Digits[len(d.Digits)-dropCount:]
+	} else {
+		dropped = strings.Repeat("0", dropCount-len(d.Digits)) + d.Digits
+	}
+
+	// How the dropped digits compare to half of the last kept digit.
+	half := 0
+	switch {
+	case dropped[0] > '5':
+		half = 1
+	case dropped[0] < '5':
+		half = -1
+	case strings.TrimRight(dropped[1:], "0") != "":
+		half = 1
+	}
+
+	// The modes that depend on the sign are flipped for negative numbers, which round away from zero to go down.
+	if negative {
+		switch mode {
+		case "ceil":
+			mode = "trunc"
+		case "floor":
+			mode = "expand"
+		case "halfCeil":
+			mode = "halfTrunc"
+		case "halfFloor":
+			mode = "halfExpand"
+		}
+	} else {
+		switch mode {
+		case "ceil":
+			mode = "expand"
+		case "floor":
+			mode = "trunc"
+		case "halfCeil":
+			mode = "halfExpand"
+		case "halfFloor":
+			mode = "halfTrunc"
+		}
+	}
+
+	increment := false
+	switch mode {
+	case "expand":
+		increment = true
+	case "halfExpand":
+		increment = half >= 0
+	case "halfTrunc":
+		increment = half > 0
+	case "halfEven":
+		lastKept := byte('0')
+		if kept != "" {
+			lastKept = kept[len(kept)-1]
+		}
+		increment = half > 0 || (half == 0 && (lastKept-'0')%2 == 1)
+	}
+
+	if increment {
+		kept = incrementDecimalDigits(kept)
+	}
+	return newIntlDecimal(kept, magnitude)
+}
+
+// incrementDecimalDigits adds one to a string of decimal digits.
+func incrementDecimalDigits(digits string) string {
+	bytes := []byte(digits)
+	for idx := len(bytes) - 1; idx >= 0; idx-- {
+		if bytes[idx] != '9' {
+			bytes[idx]++
+			return string(bytes)
+		}
+		bytes[idx] = '0'
+	}
+	return "1" + string(bytes)
+}
+
+// Format writes the decimal with at least minFraction fraction digits, returning the integer and fraction digits.
+func (d intlDecimal) Format(minFraction int) (string, string) {
+	integer, fraction := "0", ""
+	switch {
+	case d.IsZero():
+	case d.Exponent >= 0:
+		integer = d.Digits + strings.Repeat("0", d.Exponent)
+	case -d.Exponent >= len(d.Digits):
+		fraction = strings.Repeat("0", -d.Exponent-len(d.Digits)) + d.Digits
+	default:
+		integer, fraction = d.Digits[:len(d.Digits)+d.Exponent], d.Digits[len(d.Digits)+d.Exponent:]
+	}
+
+	if len(fraction) < minFraction {
+		fraction += strings.Repeat("0", minFraction-len(fraction))
+	}
+	return integer, fraction
+}
+
+// intlRoundedDecimal is a number rounded with digit options, as integer and fraction digits.
+type intlRoundedDecimal struct {
+	Value    intlDecimal
+	Integer  string
+	Fraction string
+}
+
+// roundIntlDecimal rounds a number with digit options. This corresponds to FormatNumericToString in the spec, without
+// the sign.
+func roundIntlDecimal(digits IntlDigitOptions, x intlDecimal, negative bool) intlRoundedDecimal {
+	// ToRawFixed rounds to a number of fraction digits.
+	fixed := func() (intlDecimal, int, int) {
+		rounded := x.RoundAt(-digits.MaximumFractionDigits, digits.RoundingMode, negative)
+		return rounded, digits.MinimumFractionDigits, -digits.MaximumFractionDigits
+	}
+
+	// ToRawPrecision rounds to a number of significant digits.
+	precision := func() (intlDecimal, int, int) {
+		magnitude := x.Magnitude() - digits.MaximumSignificantDigits + 1
+		rounded := x.RoundAt(magnitude, digits.RoundingMode, negative)
+		minFraction := max(0, digits.MinimumSignificantDigits-1-rounded.Magnitude())
+		return rounded, minFraction, magnitude
+	}
+
+	var rounded intlDecimal
+	var minFraction int
+	switch digits.RoundingType {
+	case "fractionDigits":
+		rounded, minFraction, _ = fixed()
+	case "significantDigits":
+		rounded, minFraction, _ = precision()
+	default:
+		fixedValue, fixedMinFraction, fixedMagnitude := fixed()
+		precisionValue, precisionMinFraction, precisionMagnitude := precision()
+
+		// morePrecision picks the result with more digits, lessPrecision the one with fewer.
+		usePrecision := precisionMagnitude <= fixedMagnitude
+		if digits.RoundingType == "lessPrecision" {
+			usePrecision = !usePrecision
+		}
+
+		if usePrecision {
+			rounded, minFraction = precisionValue, precisionMinFraction
+		} else {
+			rounded, minFraction = fixedValue, fixedMinFraction
+		}
+	}
+
+	integer, fraction := rounded.Format(minFraction)
+	if digits.TrailingZeroDisplay == "stripIfInteger" && strings.TrimRight(fraction, "0") == "" {
+		fraction = ""
+	}
+	if len(integer) < digits.MinimumIntegerDigits {
+		integer = strings.Repeat("0", digits.MinimumIntegerDigits-len(integer)) + integer
+	}
+
+	return intlRoundedDecimal{Value: rounded, Integer: integer, Fraction: fraction}
+}
+
+// intlMathematicalValue is a number being formatted: a decimal with a sign, or NaN or an infinity.
+type intlMathematicalValue struct {
+	Decimal  intlDecimal
+	Negative bool
+	NaN      bool
+	Infinite bool
+}
+
+// ToIntlMathematicalValue converts the value passed to format to a number. BigInts keep all their digits.
+func ToIntlMathematicalValue(runtime *Runtime, value *JavaScriptValue) (intlMathematicalValue, *Completion) {
+	completion := ToNumeric(runtime, value)
+	if completion.Type != Normal {
+		return intlMathematicalValue{}, completion
+	}
+
+	numeric := completion.Value.(*JavaScriptValue)
+	if numeric.Type == TypeBigInt {
+		bigInt := numeric.Value.(*BigInt).Value
+		text := bigInt.String()
+		return intlMathematicalValue{
+			Decimal:  newIntlDecimal(strings.TrimPrefix(text, "-"), 0),
+			Negative: bigInt.Sign() < 0,
+		}, nil
+	}
+
+	return newIntlMathematicalValue(numeric.Value.(*Number)), nil
+}
+
+func newIntlMathematicalValue(number *Number) intlMathematicalValue {
+	switch {
+	case number.NaN || math.IsNaN(number.Value):
+		return intlMathematicalValue{NaN: true}
+	case math.IsInf(number.Value, 0):
+		return intlMathematicalValue{Infinite: true, Negative: number.Value < 0}
+	}
+	return intlMathematicalValue{
+		Decimal:  newIntlDecimalFromFloat(number.Value),
+		Negative: math.Signbit(number.Value),
+	}
+}
+
+// FormatNumericToParts formats a number with a NumberFormat, as parts. This corresponds to PartitionNumberPattern in
+// the spec.
+func FormatNumericToParts(nf *NumberFormatState, x intlMathematicalValue) []intlPart {
+	data := nf.Data
+
+	numberParts := []intlPart{}
+	isZero := false
+	var rounded intlRoundedDecimal
+
+	switch {
+	case x.NaN:
+		numberParts = append(numberParts, intlPart{"nan", data.NaN})
+		isZero = true
+	case x.Infinite:
+		numberParts = append(numberParts, intlPart{"infinity", data.Infinity})
+	default:
+		value := x.Decimal
+		if nf.Style == "percent" {
+			value = value.Scale(2)
+		}
+
+		exponent, compact := 0, intlCompactPattern{}
+		switch nf.Notation {
+		case "scientific", "engineering":
+			exponent, rounded = nf.roundScientific(value, x.Negative)
+		case "compact":
+			compact, rounded = nf.roundCompact(value, x.Negative)
+		default:
+			rounded = roundIntlDecimal(nf.Digits, value, x.Negative)
+		}
+		isZero = rounded.Value.IsZero()
+
+		numberParts = append(numberParts, nf.groupIntegerDigits(rounded.Integer)...)
+		if rounded.Fraction != "" {
+			numberParts = append(numberParts, intlPart{"decimal", data.Decimal}, intlPart{"fraction", rounded.Fraction})
+		}
+
+		switch nf.Notation {
+		case "scientific", "engineering":
+			numberParts = append(numberParts, intlPart{"exponentSeparator", data.Exponential})
+			if exponent < 0 {
+				numberParts = append(numberParts, intlPart{"exponentMinusSign", data.MinusSign})
+			}
+			numberParts = append(numberParts, intlPart{"exponentInteger", strconv.Itoa(abs(exponent))})
+		case "compact":
+			if compact.Compact != "" {
+				if compact.Literal != "" {
+					numberParts = append(numberParts, intlPart{"literal", compact.Literal})
+				}
+				numberParts = append(numberParts, intlPart{"compact", compact.Compact})
+			}
+		}
+	}
+
+	// The sign.
+	sign := ""
+	switch nf.SignDisplay {
+	case "auto":
+		if x.Negative {
+			sign = "-"
+		}
+	case "always":
+		sign = "+"
+		if x.Negative {
+			sign = "-"
+		}
+	case "exceptZero":
+		if !isZero {
+			sign = "+"
+			if x.Negative {
+				sign = "-"
+			}
+		}
+	case "negative":
+		if x.Negative && !isZero {
+			sign = "-"
+		}
+	}
+
+	pattern := data.DecimalPattern
+	switch nf.Style {
+	case "percent":
+		pattern = data.PercentPattern
+	case "currency":
+		pattern = data.CurrencyPattern
+		if nf.CurrencyDisplay == "name" {
+			pattern = data.CurrencyNamePattern
+		} else if nf.CurrencySign == "accounting" && sign == "-" {
+			pattern = data.AccountingPattern
+		}
+	}
+
+	parts := []intlPart{}
+	for _, char := range pattern {
+		switch char {
+		case '-':
+			if sign == "-" {
+				parts = append(parts, intlPart{"minusSign", data.MinusSign})
+			} else if sign == "+" {
+				parts = append(parts, intlPart{"plusSign", data.PlusSign})
+			}
+		case '#':
+			parts = append(parts, numberParts...)
+		case '%':
+			parts = append(parts, intlPart{"percentSign", data.PercentSign})
+		case '¤':
+			parts = append(parts, intlPart{"currency", nf.currencyDisplayName(rounded)})
+		default:
+			if len(parts) > 0 && parts[len(parts)-1].Type == "literal" {
+				parts[len(parts)-1].Value += string(char)
+			} else {
+				parts = append(parts, intlPart{"literal", string(char)})
+			}
+		}
+	}
+
+	return insertCurrencySpacing(parts)
+}
+
+// roundScientific rounds a number in scientific or engineering notation, returning its exponent and rounded mantissa.
+func (nf *NumberFormatState) roundScientific(value intlDecimal, negative bool) (int, intlRoundedDecimal) {
+	exponentOf := func(magnitude int) int {
+		if nf.Notation == "engineering" {
+			return int(math.Floor(float64(magnitude)/3)) * 3
+		}
+		return magnitude
+	}
+
+	exponent := exponentOf(value.Magnitude())
+	rounded := roundIntlDecimal(nf.Digits, value.Scale(-exponent), negative)
+
+	// Rounding may carry into the next exponent, e.g. 9.9999 to 10.
+	if next := exponentOf(rounded.Value.Scale(exponent).Magnitude()); next != exponent && !rounded.Value.IsZero() {
+		exponent = next
+		rounded = roundIntlDecimal(nf.Digits, value.Scale(-exponent), negative)
+	}
+	return exponent, rounded
+}
+
+// roundCompact rounds a number in compact notation, returning the compact pattern of its magnitude and the rounded
+// number it is shown with.
+func (nf *NumberFormatState) roundCompact(value intlDecimal, negative bool) (intlCompactPattern, intlRoundedDecimal) {
+	patterns := nf.Data.ShortCompactPatterns
+	if nf.CompactDisplay == "long" {
+		patterns = nf.Data.LongCompactPatterns
+	}
+
+	patternOf := func(magnitude int) intlCompactPattern {
+		pattern := intlCompactPattern{}
+		for _, candidate := range patterns {
+			if candidate.Divisor <= magnitude {
+				pattern = candidate
+			}
+		}
+		return pattern
+	}
+
+	pattern := patternOf(value.Magnitude())
+	rounded := roundIntlDecimal(nf.Digits, value.Scale(-pattern.Divisor), negative)
+
+	// Rounding may carry into the next magnitude, e.g. 999,999 to 1,000K, which is shown as 1M.
+	if next := patternOf(rounded.Value.Scale(pattern.Divisor).Magnitude()); next.Divisor != pattern.Divisor && !rounded.Value.IsZero() {
+		pattern = next
+		rounded = roundIntlDecimal(nf.Digits, value.Scale(-pattern.Divisor), negative)
+	}
+	return pattern, rounded
+}
+
+// groupIntegerDigits splits the integer digits of a number into groups of three, with the grouping of the format.
+func (nf *NumberFormatState) groupIntegerDigits(integer string) []intlPart {
+	group := false
+	switch nf.UseGrouping {
+	case "always", "auto":
+		group = len(integer) > 3
+	case "min2":
+		group = len(integer) > 4
+	}
+	if !group {
+		return []intlPart{{"integer", integer}}
+	}
+
+	parts := []intlPart{}
+	first := len(integer) % 3
+	if first == 0 {
+		first = 3
+	}
+	parts = append(parts, intlPart{"integer", integer[:first]})
+	for idx := first; idx < len(integer); idx += 3 {
+		parts = append(parts, intlPart{"group", nf.Data.Group}, intlPart{"integer", integer[idx : idx+3]})
+	}
+	return parts
+}
+
+// currencyDisplayName returns how the currency is shown, with the plural form of its name for the rounded number.
+func (nf *NumberFormatState) currencyDisplayName(rounded intlRoundedDecimal) string {
+	data := nf.Data
+	switch nf.CurrencyDisplay {
+	case "code":
+		return nf.Currency
+	case "name":
+		names, ok := data.CurrencyNames[nf.Currency]
+		if !ok {
+			return nf.Currency
+		}
+		category := data.PluralRule(newIntlPluralOperands(rounded.Integer, rounded.Fraction))
+		if name, ok := names[category]; ok {
+			return name
+		}
+		return names["other"]
+	case "narrowSymbol":
+		if symbol, ok := data.NarrowCurrencySymbols[nf.Currency]; ok {
+			return symbol
+		}
+	}
+
+	if symbol, ok := data.CurrencySymbols[nf.Currency]; ok {
+		return symbol
+	}
+	return nf.Currency
+}
+
+// insertCurrencySpacing separates a currency that ends or starts with a letter from the number next to it, e.g.
+// "CHF 5.00" instead of "CHF5.00".
+func insertCurrencySpacing(parts []intlPart) []intlPart {
+	isNumber := func(part intlPart) bool {
+		return part.Type == "integer" || part.Type == "nan" || part.Type == "infinity"
+	}
+
+	result := make([]intlPart, 0, len(parts))
+	for idx, part := range parts {
+		result = append(result, part)
+		if part.Type != "currency" || idx+1 >= len(parts) || !isNumber(parts[idx+1]) {
+			continue
+		}
+
+		if last, _ := utf8.DecodeLastRuneInString(part.Value); unicode.IsLetter(last) {
+			result = append(result, intlPart{"literal", "\u00a0"})
+		}
+	}
+
+	for idx := len(result) - 1; idx > 0; idx-- {
+		if result[idx].Type != "currency" || (result[idx-1].Type != "fraction" && !isNumber(result[idx-1])) {
+			continue
+		}
+
+		if first, _ := utf8.DecodeRuneInString(result[idx].Value); unicode.IsLetter(first) {
+			result = append(result[:idx], append([]intlPart{{"literal", "\u00a0"}}, result[idx:]...)...)
+		}
+	}
+	return result
+}
+
+// FormatNumeric formats a number with a NumberFormat.
+func FormatNumeric(nf *NumberFormatState, x intlMathematicalValue) string {
+	return joinIntlParts(FormatNumericToParts(nf, x))
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,193 @@
+package runtime
+
+import "strings"
+
+func NewIntlNumberFormatConstructor(runtime *Runtime) *FunctionObject {
+	return newIntlServiceConstructor(runtime, IntlNumberFormatConstructor, "NumberFormat", IntrinsicIntlNumberFormatPrototype)
+}
+
+// Intl.NumberFormat(locales, options)
+func IntlNumberFormatConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	// Intl.NumberFormat may be called as a function, which creates a new NumberFormat.
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		newTarget = NewJavaScriptValue(TypeObject, function)
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicIntlNumberFormatPrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	requested, options, completion := intlConstructorArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	numberFormat, completion := InitializeNumberFormat(runtime, requested, options)
+	if completion != nil {
+		return completion
+	}
+
+	numberFormatVal := createCompletion.Value.(*JavaScriptValue)
+	numberFormatVal.Value.(*Object).NumberFormat = numberFormat
+
+	return NewNormalCompletion(numberFormatVal)
+}
+
+// InitializeNumberFormat reads the options of a NumberFormat for the requested locales.
+func InitializeNumberFormat(runtime *Runtime, requested []string, options *JavaScriptValue) (*NumberFormatState, *Completion) {
+	options, completion := coerceIntlOptions(runtime, options)
+	if completion != nil {
+		return nil, completion
+	}
+
+	if _, completion := getIntlStringOption(runtime, options, "localeMatcher", []string{"lookup", "best fit"}, "best fit"); completion != nil {
+		return nil, completion
+	}
+
+	if completion := readIntlNumberingSystem(runtime, options); completion != nil {
+		return nil, completion
+	}
+
+	locale, dataLocale := ResolveLocale(requested)
+	nf := &NumberFormatState{
+		Locale:          locale,
+		Data:            intlLocaleDataByLocale[dataLocale],
+		NumberingSystem: "latn",
+	}
+
+	style, completion := getIntlStringOption(runtime, options, "style", []string{"decimal", "percent", "currency"}, "decimal")
+	if completion != nil {
+		return nil, completion
+	}
+	nf.Style = style
+
+	currency, completion := getIntlStringOption(runtime, options, "currency", nil, "")
+	if completion != nil {
+		return nil, completion
+	}
+	if currency != "" && !isWellFormedCurrencyCode(currency) {
+		return nil, NewThrowCompletion(NewRangeError(runtime, "Invalid currency code : "+currency))
+	}
+	if style == "currency" {
+		if currency == "" {
+			return nil, NewThrowCompletion(NewTypeError(runtime, "Currency code is required with currency style."))
+		}
+		nf.Currency = strings.ToUpper(currency)
+	}
+
+	currencyDisplay, completion := getIntlStringOption(runtime, options, "currencyDisplay", []string{"code", "symbol", "narrowSymbol", "name"}, "symbol")
+	if completion != nil {
+		return nil, completion
+	}
+
+	currencySign, completion := getIntlStringOption(runtime, options, "currencySign", []string{"standard", "accounting"}, "standard")
+	if completion != nil {
+		return nil, completion
+	}
+	if style == "currency" {
+		nf.CurrencyDisplay, nf.CurrencySign = currencyDisplay, currencySign
+	}
+
+	notation, completion := getIntlStringOption(runtime, options, "notation", []string{"standard", "scientific", "engineering", "compact"}, "standard")
+	if completion != nil {
+		return nil, completion
+	}
+	nf.Notation = notation
+
+	mnfdDefault, mxfdDefault := 0, 3
+	switch {
+	case style == "currency" && notation == "standard":
+		mnfdDefault = intlCurrencyDigits(nf.Currency)
+		mxfdDefault = mnfdDefault
+	case style == "percent":
+		mxfdDefault = 0
+	}
+
+	digits, completion := SetNumberFormatDigitOptions(runtime, options, mnfdDefault, mxfdDefault, notation)
+	if completion != nil {
+		return nil, completion
+	}
+	nf.Digits = digits
+
+	compactDisplay, completion := getIntlStringOption(runtime, options, "compactDisplay", []string{"short", "long"}, "short")
+	if completion != nil {
+		return nil, completion
+	}
+	if notation == "compact" {
+		nf.CompactDisplay = compactDisplay
+	}
+
+	defaultUseGrouping := "auto"
+	if notation == "compact" {
+		defaultUseGrouping = "min2"
+	}
+	useGrouping, completion := getIntlUseGroupingOption(runtime, options, defaultUseGrouping)
+	if completion != nil {
+		return nil, completion
+	}
+	nf.UseGrouping = useGrouping
+
+	signDisplay, completion := getIntlStringOption(runtime, options, "signDisplay", []string{"auto", "never", "always", "exceptZero", "negative"}, "auto")
+	if completion != nil {
+		return nil, completion
+	}
+	nf.SignDisplay = signDisplay
+
+	return nf, nil
+}
+
+// readIntlNumberingSystem reads the numberingSystem option, which must be a well-formed type. Only Latin digits are
+// supported, so it has no effect.
+func readIntlNumberingSystem(runtime *Runtime, options *JavaScriptValue) *Completion {
+	numberingSystem, completion := getIntlStringOption(runtime, options, "numberingSystem", nil, "")
+	if completion != nil {
+		return completion
+	}
+
+	if numberingSystem != "" && !isWellFormedIntlType(numberingSystem) {
+		return NewThrowCompletion(NewRangeError(runtime, "Invalid numberingSystem : "+numberingSystem))
+	}
+	return nil
+}
+
+// getIntlUseGroupingOption reads the useGrouping option, which may be a boolean or one of the grouping strategies.
+// This corresponds to GetBooleanOrStringNumberFormatOption in the spec.
+func getIntlUseGroupingOption(runtime *Runtime, options *JavaScriptValue, fallback string) (string, *Completion) {
+	value, completion := getIntlOption(runtime, options, "useGrouping")
+	if completion != nil || value == nil {
+		return fallback, completion
+	}
+
+	if value.Type == TypeBoolean && value.Value.(*Boolean).Value {
+		return "always", nil
+	}
+	if !ToBoolean(value).Value.(*JavaScriptValue).Value.(*Boolean).Value {
+		return "", nil
+	}
+
+	completion = ToString(runtime, value)
+	if completion.Type != Normal {
+		return "", completion
+	}
+
+	text := completion.Value.(*JavaScriptValue).Value.(*String).Value
+	switch text {
+	case "true", "false":
+		return fallback, nil
+	case "min2", "auto", "always":
+		return text, nil
+	}
+	return "", NewThrowCompletion(NewRangeError(runtime, "Value "+text+" out of range for Intl options property useGrouping"))
+}
+
+// isWellFormedCurrencyCode reports whether currency is three ASCII letters.
+func isWellFormedCurrencyCode(currency string) bool {
+	return len(currency) == 3 && strings.Trim(strings.ToUpper(currency), "ABCDEFGHIJKLMNOPQRSTUVWXYZ") == ""
+}
@@ -0,0 +1,167 @@
+package runtime
+
+func NewIntlNumberFormatPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineIntlNumberFormatPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Intl.NumberFormat.prototype.format
+	DefineBuiltinAccessorFunction(runtime, prototype, "format", IntlNumberFormatPrototypeFormatGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// Intl.NumberFormat.prototype.formatToParts
+	DefineBuiltinFunction(runtime, prototype, "formatToParts", IntlNumberFormatPrototypeFormatToParts, 1)
+
+	// Intl.NumberFormat.prototype.resolvedOptions
+	DefineBuiltinFunction(runtime, prototype, "resolvedOptions", IntlNumberFormatPrototypeResolvedOptions, 0)
+
+	// Intl.NumberFormat.prototype[%Symbol.toStringTag%]
+	defineIntlToStringTag(runtime, prototype, "Intl.NumberFormat")
+}
+
+func thisNumberFormat(runtime *Runtime, thisArg *JavaScriptValue, method string) (*NumberFormatState, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.NumberFormat != nil {
+			return object.NumberFormat, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Intl.NumberFormat.prototype."+method+" called on incompatible receiver"))
+}
+
+// get Intl.NumberFormat.prototype.format
+func IntlNumberFormatPrototypeFormatGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	nf, completion := thisNumberFormat(runtime, thisArg, "format")
+	if completion != nil {
+		return completion
+	}
+
+	// The function is bound to the NumberFormat, so it can be passed around, e.g. to Array.prototype.map.
+	if nf.BoundFormat == nil {
+		nf.BoundFormat = CreateBuiltinFunction(
+			runtime,
+			func(runtime *Runtime, function *FunctionObject, thisArg *JavaScriptValue, arguments []*JavaScriptValue, newTarget *JavaScriptValue) *Completion {
+				value := NewUndefinedValue()
+				if len(arguments) > 0 {
+					value = arguments[0]
+				}
+
+				x, completion := ToIntlMathematicalValue(runtime, value)
+				if completion != nil {
+					return completion
+				}
+				return NewNormalCompletion(NewStringValue(FormatNumeric(nf, x)))
+			},
+			1,
+			NewStringValue(""),
+			nil,
+			nil,
+		)
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, nf.BoundFormat))
+}
+
+// Intl.NumberFormat.prototype.formatToParts(value)
+func IntlNumberFormatPrototypeFormatToParts(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	nf, completion := thisNumberFormat(runtime, thisArg, "formatToParts")
+	if completion != nil {
+		return completion
+	}
+
+	value := NewUndefinedValue()
+	if len(arguments) > 0 {
+		value = arguments[0]
+	}
+
+	x, completion := ToIntlMathematicalValue(runtime, value)
+	if completion != nil {
+		return completion
+	}
+	return NewNormalCompletion(newIntlPartsArray(runtime, FormatNumericToParts(nf, x), nil))
+}
+
+// Intl.NumberFormat.prototype.resolvedOptions()
+func IntlNumberFormatPrototypeResolvedOptions(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	nf, completion := thisNumberFormat(runtime, thisArg, "resolvedOptions")
+	if completion != nil {
+		return completion
+	}
+
+	members := []intlResolvedOption{
+		{"locale", NewStringValue(nf.Locale)},
+		{"numberingSystem", NewStringValue(nf.NumberingSystem)},
+		{"style", NewStringValue(nf.Style)},
+	}
+	if nf.Style == "currency" {
+		members = append(members,
+			intlResolvedOption{"currency", NewStringValue(nf.Currency)},
+			intlResolvedOption{"currencyDisplay", NewStringValue(nf.CurrencyDisplay)},
+			intlResolvedOption{"currencySign", NewStringValue(nf.CurrencySign)},
+		)
+	}
+
+	members = append(members, intlDigitResolvedOptions(nf.Digits)...)
+
+	useGrouping := NewBooleanValue(false)
+	if nf.UseGrouping != "" {
+		useGrouping = NewStringValue(nf.UseGrouping)
+	}
+	members = append(members,
+		intlResolvedOption{"useGrouping", useGrouping},
+		intlResolvedOption{"notation", NewStringValue(nf.Notation)},
+	)
+	if nf.Notation == "compact" {
+		members = append(members, intlResolvedOption{"compactDisplay", NewStringValue(nf.CompactDisplay)})
+	}
+	members = append(members,
+		intlResolvedOption{"signDisplay", NewStringValue(nf.SignDisplay)},
+		intlResolvedOption{"roundingIncrement", NewNumberValue(1, false)},
+		intlResolvedOption{"roundingMode", NewStringValue(nf.Digits.RoundingMode)},
+		intlResolvedOption{"roundingPriority", NewStringValue(nf.Digits.RoundingPriority)},
+		intlResolvedOption{"trailingZeroDisplay", NewStringValue(nf.Digits.TrailingZeroDisplay)},
+	)
+
+	return NewNormalCompletion(intlResolvedOptions(runtime, members))
+}
+
+// intlDigitResolvedOptions returns the digit options resolvedOptions reports, which are the fraction digits, the
+// significant digits or both, depending on how numbers are rounded.
+func intlDigitResolvedOptions(digits IntlDigitOptions) []intlResolvedOption {
+	members := []intlResolvedOption{
+		{"minimumIntegerDigits", NewNumberValue(float64(digits.MinimumIntegerDigits), false)},
+	}
+	if digits.RoundingType != "significantDigits" {
+		members = append(members,
+			intlResolvedOption{"minimumFractionDigits", NewNumberValue(float64(digits.MinimumFractionDigits), false)},
+			intlResolvedOption{"maximumFractionDigits", NewNumberValue(float64(digits.MaximumFractionDigits), false)},
+		)
+	}
+	if digits.RoundingType != "fractionDigits" {
+		members = append(members,
+			intlResolvedOption{"minimumSignificantDigits", NewNumberValue(float64(digits.MinimumSignificantDigits), false)},
+			intlResolvedOption{"maximumSignificantDigits", NewNumberValue(float64(digits.MaximumSignificantDigits), false)},
+		)
+	}
+	return members
+}
@@ -0,0 +1,86 @@
+package runtime
+
+import (
+	"math"
+	"strconv"
+)
+
+// PluralRulesState is the state of an Intl.PluralRules object, its internal slots in the spec.
+type PluralRulesState struct {
+	Locale string
+	Data   *intlLocaleData
+	Type   string // "cardinal" or "ordinal".
+	Digits IntlDigitOptions
+}
+
+// intlPluralOperands are the parts of a formatted number that plural rules look at: its integer digits I, and the
+// number V of visible fraction digits.
+type intlPluralOperands struct {
+	I int64
+	V int
+}
+
+// newIntlPluralOperands returns the operands of a number formatted as integer and fraction digits.
+func newIntlPluralOperands(integer string, fraction string) intlPluralOperands {
+	// Rules only look at the last few digits of large numbers, but must still see them as large.
+	large := int64(0)
+	if len(integer) > 17 {
+		integer = integer[len(integer)-17:]
+		large = 1e17
+	}
+
+	i, _ := strconv.ParseInt(integer, 10, 64)
+	return intlPluralOperands{I: i + large, V: len(fraction)}
+}
+
+// ResolvePlural returns the plural category of a number, after it is rounded with the digit options of the rules.
+func ResolvePlural(pr *PluralRulesState, number *Number) string {
+	if number.NaN || math.IsNaN(number.Value) || math.IsInf(number.Value, 0) {
+		return "other"
+	}
+
+	rounded := roundIntlDecimal(pr.Digits, newIntlDecimalFromFloat(number.Value), math.Signbit(number.Value))
+	operands := newIntlPluralOperands(rounded.Integer, rounded.Fraction)
+	if pr.Type == "ordinal" {
+		return pr.Data.OrdinalPluralRule(operands)
+	}
+	return pr.Data.PluralRule(operands)
+}
+
+// InitializePluralRules reads the options of a PluralRules for the requested locales.
+func InitializePluralRules(runtime *Runtime, requested []string, options *JavaScriptValue) (*PluralRulesState, *Completion) {
+	options, completion := coerceIntlOptions(runtime, options)
+	if completion != nil {
+		return nil, completion
+	}
+
+	if _, completion := getIntlStringOption(runtime, options, "localeMatcher", []string{"lookup", "best fit"}, "best fit"); completion != nil {
+		return nil, completion
+	}
+
+	pluralType, completion := getIntlStringOption(runtime, options, "type", []string{"cardinal", "ordinal"}, "cardinal")
+	if completion != nil {
+		return nil, completion
+	}
+
+	digits, completion := SetNumberFormatDigitOptions(runtime, options, 0, 3, "standard")
+	if completion != nil {
+		return nil, completion
+	}
+
+	locale, dataLocale := ResolveLocale(requested)
+	return &PluralRulesState{
+		Locale: locale,
+		Data:   intlLocaleDataByLocale[dataLocale],
+		Type:   pluralType,
+		Digits: digits,
+	}, nil
+}
+
+// Categories returns the plural categories of the rules.
+func (pr *PluralRulesState) Categories() []string {
+	if pr.Type == "ordinal" {
+		return pr.Data.OrdinalPluralCategories
+	}
+	return pr.Data.PluralCategories
+}
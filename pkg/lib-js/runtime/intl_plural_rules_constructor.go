@@ -0,0 +1,38 @@
+package runtime
+
+func NewIntlPluralRulesConstructor(runtime *Runtime) *FunctionObject {
+	return newIntlServiceConstructor(runtime, IntlPluralRulesConstructor, "PluralRules", IntrinsicIntlPluralRulesPrototype)
+}
+
+// Intl.PluralRules(locales, options)
+func IntlPluralRulesConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "Intl.PluralRules constructor requires 'new'"))
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicIntlPluralRulesPrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	requested, options, completion := intlConstructorArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	pluralRules, completion := InitializePluralRules(runtime, requested, options)
+	if completion != nil {
+		return completion
+	}
+
+	pluralRulesVal := createCompletion.Value.(*JavaScriptValue)
+	pluralRulesVal.Value.(*Object).PluralRules = pluralRules
+
+	return NewNormalCompletion(pluralRulesVal)
+}
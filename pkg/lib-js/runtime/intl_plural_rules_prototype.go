@@ -0,0 +1,122 @@
+package runtime
+
+func NewIntlPluralRulesPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineIntlPluralRulesPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Intl.PluralRules.prototype.select
+	DefineBuiltinFunction(runtime, prototype, "select", IntlPluralRulesPrototypeSelect, 1)
+
+	// Intl.PluralRules.prototype.selectRange
+	DefineBuiltinFunction(runtime, prototype, "selectRange", IntlPluralRulesPrototypeSelectRange, 2)
+
+	// Intl.PluralRules.prototype.resolvedOptions
+	DefineBuiltinFunction(runtime, prototype, "resolvedOptions", IntlPluralRulesPrototypeResolvedOptions, 0)
+
+	// Intl.PluralRules.prototype[%Symbol.toStringTag%]
+	defineIntlToStringTag(runtime, prototype, "Intl.PluralRules")
+}
+
+func thisPluralRules(runtime *Runtime, thisArg *JavaScriptValue, method string) (*PluralRulesState, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.PluralRules != nil {
+			return object.PluralRules, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Intl.PluralRules.prototype."+method+" called on incompatible receiver"))
+}
+
+// Intl.PluralRules.prototype.select(value)
+func IntlPluralRulesPrototypeSelect(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	pr, completion := thisPluralRules(runtime, thisArg, "select")
+	if completion != nil {
+		return completion
+	}
+
+	value := NewUndefinedValue()
+	if len(arguments) > 0 {
+		value = arguments[0]
+	}
+
+	numberCompletion := ToNumber(runtime, value)
+	if numberCompletion.Type != Normal {
+		return numberCompletion
+	}
+
+	return NewNormalCompletion(NewStringValue(ResolvePlural(pr, numberCompletion.Value.(*JavaScriptValue).Value.(*Number))))
+}
+
+// Intl.PluralRules.prototype.selectRange(start, end)
+func IntlPluralRulesPrototypeSelectRange(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	pr, completion := thisPluralRules(runtime, thisArg, "selectRange")
+	if completion != nil {
+		return completion
+	}
+
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+	if arguments[0].Type == TypeUndefined || arguments[1].Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "start and end must be defined"))
+	}
+
+	numbers := [2]*Number{}
+	for idx := range 2 {
+		numberCompletion := ToNumber(runtime, arguments[idx])
+		if numberCompletion.Type != Normal {
+			return numberCompletion
+		}
+		numbers[idx] = numberCompletion.Value.(*JavaScriptValue).Value.(*Number)
+		if numbers[idx].NaN {
+			return NewThrowCompletion(NewRangeError(runtime, "Invalid number range"))
+		}
+	}
+
+	// In the bundled locales, a range takes the category of its end, e.g. "1-2 days".
+	return NewNormalCompletion(NewStringValue(ResolvePlural(pr, numbers[1])))
+}
+
+// Intl.PluralRules.prototype.resolvedOptions()
+func IntlPluralRulesPrototypeResolvedOptions(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	pr, completion := thisPluralRules(runtime, thisArg, "resolvedOptions")
+	if completion != nil {
+		return completion
+	}
+
+	members := []intlResolvedOption{
+		{"locale", NewStringValue(pr.Locale)},
+		{"type", NewStringValue(pr.Type)},
+	}
+	members = append(members, intlDigitResolvedOptions(pr.Digits)...)
+	members = append(members,
+		intlResolvedOption{"pluralCategories", newStringArray(runtime, pr.Categories())},
+		intlResolvedOption{"roundingIncrement", NewNumberValue(1, false)},
+		intlResolvedOption{"roundingMode", NewStringValue(pr.Digits.RoundingMode)},
+		intlResolvedOption{"roundingPriority", NewStringValue(pr.Digits.RoundingPriority)},
+		intlResolvedOption{"trailingZeroDisplay", NewStringValue(pr.Digits.TrailingZeroDisplay)},
+	)
+
+	return NewNormalCompletion(intlResolvedOptions(runtime, members))
+}
@@ -0,0 +1,127 @@
+package runtime
+
+import (
+	"math"
+	"slices"
+	"strings"
+)
+
+// RelativeTimeFormatState is the state of an Intl.RelativeTimeFormat object, its internal slots in the spec.
+type RelativeTimeFormatState struct {
+	Locale          string
+	Data            *intlLocaleData
+	NumberingSystem string
+	NumberFormat    *NumberFormatState
+	Style           string // "long", "short" or "narrow".
+	Numeric         string // "always" or "auto".
+}
+
+// intlRelativeTimeUnits are the units RelativeTimeFormat accepts, which may also be written in the plural.
+var intlRelativeTimeUnits = []string{"second", "minute", "hour", "day", "week", "month", "quarter", "year"}
+
+// InitializeRelativeTimeFormat reads the options of a RelativeTimeFormat for the requested locales.
+func InitializeRelativeTimeFormat(runtime *Runtime, requested []string, options *JavaScriptValue) (*RelativeTimeFormatState, *Completion) {
+	options, completion := coerceIntlOptions(runtime, options)
+	if completion != nil {
+		return nil, completion
+	}
+
+	if _, completion := getIntlStringOption(runtime, options, "localeMatcher", []string{"lookup", "best fit"}, "best fit"); completion != nil {
+		return nil, completion
+	}
+
+	if completion := readIntlNumberingSystem(runtime, options); completion != nil {
+		return nil, completion
+	}
+
+	locale, dataLocale := ResolveLocale(requested)
+
+	style, completion := getIntlStringOption(runtime, options, "style", []string{"long", "short", "narrow"}, "long")
+	if completion != nil {
+		return nil, completion
+	}
+
+	numeric, completion := getIntlStringOption(runtime, options, "numeric", []string{"always", "auto"}, "always")
+	if completion != nil {
+		return nil, completion
+	}
+
+	numberFormat, completion := InitializeNumberFormat(runtime, []string{locale}, NewUndefinedValue())
+	if completion != nil {
+		return nil, completion
+	}
+
+	return &RelativeTimeFormatState{
+		Locale:          locale,
+		Data:            intlLocaleDataByLocale[dataLocale],
+		NumberingSystem: "latn",
+		NumberFormat:    numberFormat,
+		Style:           style,
+		Numeric:         numeric,
+	}, nil
+}
+
+// FormatRelativeTimeToParts formats an amount of a unit of time with a RelativeTimeFormat, as parts, and returns the
+// singular unit the number parts are reported with. This corresponds to PartitionRelativeTimePattern in the spec.
+func FormatRelativeTimeToParts(runtime *Runtime, rtf *RelativeTimeFormatState, value *JavaScriptValue, unit *JavaScriptValue) ([]intlPart, string, *Completion) {
+	completion := ToNumber(runtime, value)
+	if completion.Type != Normal {
+		return nil, "", completion
+	}
+	number := completion.Value.(*JavaScriptValue).Value.(*Number)
+
+	completion = ToString(runtime, unit)
+	if completion.Type != Normal {
+		return nil, "", completion
+	}
+	unitName := completion.Value.(*JavaScriptValue).Value.(*String).Value
+
+	if number.NaN || math.IsNaN(number.Value) || math.IsInf(number.Value, 0) {
+		return nil, "", NewThrowCompletion(NewRangeError(runtime, "Invalid time value"))
+	}
+
+	singular := strings.TrimSuffix(unitName, "s")
+	if !slices.Contains(intlRelativeTimeUnits, singular) {
+		return nil, "", NewThrowCompletion(NewRangeError(runtime, "Invalid unit argument '"+unitName+"'"))
+	}
+
+	patterns := rtf.Data.RelativeTimePatterns[singular][rtf.Style]
+
+	// With numeric set to "auto", some amounts have phrases of their own, e.g. "tomorrow" for 1 day.
+	if rtf.Numeric == "auto" && number.Value == math.Trunc(number.Value) && math.Abs(number.Value) <= 2 {
+		if phrase, ok := patterns.Phrases[int(number.Value)]; ok {
+			return []intlPart{{"literal", phrase}}, singular, nil
+		}
+	}
+
+	past := number.Value < 0 || math.Signbit(number.Value)
+	amount := &Number{Value: math.Abs(number.Value)}
+
+	category := ResolvePlural(&PluralRulesState{
+		Data:   rtf.Data,
+		Type:   "cardinal",
+		Digits: rtf.NumberFormat.Digits,
+	}, amount)
+
+	patternsByCategory := patterns.Future
+	if past {
+		patternsByCategory = patterns.Past
+	}
+	pattern, ok := patternsByCategory[category]
+	if !ok {
+		pattern = patternsByCategory["other"]
+	}
+
+	numberParts := FormatNumericToParts(rtf.NumberFormat, newIntlMathematicalValue(amount))
+	before, after, _ := strings.Cut(pattern, "{0}")
+
+	parts := []intlPart{}
+	if before != "" {
+		parts = append(parts, intlPart{"literal", before})
+	}
+	parts = append(parts, numberParts...)
+	if after != "" {
+		parts = append(parts, intlPart{"literal", after})
+	}
+	return parts, singular, nil
+}
@@ -0,0 +1,38 @@
+package runtime
+
+func NewIntlRelativeTimeFormatConstructor(runtime *Runtime) *FunctionObject {
+	return newIntlServiceConstructor(runtime, IntlRelativeTimeFormatConstructor, "RelativeTimeFormat", IntrinsicIntlRelativeTimeFormatPrototype)
+}
+
+// Intl.RelativeTimeFormat(locales, options)
+func IntlRelativeTimeFormatConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "Intl.RelativeTimeFormat constructor requires 'new'"))
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicIntlRelativeTimeFormatPrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	requested, options, completion := intlConstructorArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	relativeTimeFormat, completion := InitializeRelativeTimeFormat(runtime, requested, options)
+	if completion != nil {
+		return completion
+	}
+
+	relativeTimeFormatVal := createCompletion.Value.(*JavaScriptValue)
+	relativeTimeFormatVal.Value.(*Object).RelativeTimeFormat = relativeTimeFormat
+
+	return NewNormalCompletion(relativeTimeFormatVal)
+}
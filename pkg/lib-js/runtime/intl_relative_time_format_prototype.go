@@ -0,0 +1,103 @@
+package runtime
+
+func NewIntlRelativeTimeFormatPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineIntlRelativeTimeFormatPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Intl.RelativeTimeFormat.prototype.format
+	DefineBuiltinFunction(runtime, prototype, "format", IntlRelativeTimeFormatPrototypeFormat, 2)
+
+	// Intl.RelativeTimeFormat.prototype.formatToParts
+	DefineBuiltinFunction(runtime, prototype, "formatToParts", IntlRelativeTimeFormatPrototypeFormatToParts, 2)
+
+	// Intl.RelativeTimeFormat.prototype.resolvedOptions
+	DefineBuiltinFunction(runtime, prototype, "resolvedOptions", IntlRelativeTimeFormatPrototypeResolvedOptions, 0)
+
+	// Intl.RelativeTimeFormat.prototype[%Symbol.toStringTag%]
+	defineIntlToStringTag(runtime, prototype, "Intl.RelativeTimeFormat")
+}
+
+func thisRelativeTimeFormat(runtime *Runtime, thisArg *JavaScriptValue, method string) (*RelativeTimeFormatState, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.RelativeTimeFormat != nil {
+			return object.RelativeTimeFormat, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Intl.RelativeTimeFormat.prototype."+method+" called on incompatible receiver"))
+}
+
+// relativeTimeFormatToParts formats the value and unit arguments of the format methods, as parts.
+func relativeTimeFormatToParts(runtime *Runtime, thisArg *JavaScriptValue, arguments []*JavaScriptValue, method string) ([]intlPart, string, *Completion) {
+	rtf, completion := thisRelativeTimeFormat(runtime, thisArg, method)
+	if completion != nil {
+		return nil, "", completion
+	}
+
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	return FormatRelativeTimeToParts(runtime, rtf, arguments[0], arguments[1])
+}
+
+// Intl.RelativeTimeFormat.prototype.format(value, unit)
+func IntlRelativeTimeFormatPrototypeFormat(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	parts, _, completion := relativeTimeFormatToParts(runtime, thisArg, arguments, "format")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(joinIntlParts(parts)))
+}
+
+// Intl.RelativeTimeFormat.prototype.formatToParts(value, unit)
+func IntlRelativeTimeFormatPrototypeFormatToParts(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	parts, unit, completion := relativeTimeFormatToParts(runtime, thisArg, arguments, "formatToParts")
+	if completion != nil {
+		return completion
+	}
+
+	// The parts of the number are reported with the unit they are an amount of.
+	return NewNormalCompletion(newIntlPartsArray(runtime, parts, func(part intlPart, object ObjectInterface) {
+		if part.Type != "literal" {
+			CreateDataProperty(runtime, object, NewStringValue("unit"), NewStringValue(unit))
+		}
+	}))
+}
+
+// Intl.RelativeTimeFormat.prototype.resolvedOptions()
+func IntlRelativeTimeFormatPrototypeResolvedOptions(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	rtf, completion := thisRelativeTimeFormat(runtime, thisArg, "resolvedOptions")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(intlResolvedOptions(runtime, []intlResolvedOption{
+		{"locale", NewStringValue(rtf.Locale)},
+		{"style", NewStringValue(rtf.Style)},
+		{"numeric", NewStringValue(rtf.Numeric)},
+		{"numberingSystem", NewStringValue(rtf.NumberingSystem)},
+	}))
+}
@@ -154,6 +154,11 @@ func IteratorComplete(runtime *Runtime, iteratorResult *JavaScriptValue) *Comple
 }
 
 func IteratorClose(runtime *Runtime, iterator *Iterator, providedCompletion *Completion) *Completion {
+	// Don't run the iterator's return method while the script is being terminated.
+	if providedCompletion.Type == Terminate {
+		return providedCompletion
+	}
+
 	completion := ToObject(runtime, iterator.Iterator)
 	if completion.Type != Normal {
 		return completion
@@ -180,7 +185,7 @@ func IteratorClose(runtime *Runtime, iterator *Iterator, providedCompletion *Com
 		return providedCompletion
 	}
 
-	if completion.Type == Throw {
+	if completion.Type == Throw || completion.Type == Terminate {
 		return completion
 	}
 
@@ -293,3 +298,41 @@ func GetMethod(runtime *Runtime, obj *JavaScriptValue, key *JavaScriptValue) *Co
 
 	return NewNormalCompletion(method)
 }
+
+type PrimitiveHandling int
+
+const (
+	PrimitiveHandlingIterateStrings PrimitiveHandling = iota
+	PrimitiveHandlingReject
+)
+
+func GetIteratorFlattenable(runtime *Runtime, obj *JavaScriptValue, primitiveHandling PrimitiveHandling) *Completion {
+	if obj.Type != TypeObject {
+		if primitiveHandling == PrimitiveHandlingReject || obj.Type != TypeString {
+			return NewThrowCompletion(NewTypeError(runtime, "Value is not an iterable object"))
+		}
+	}
+
+	completion := GetMethod(runtime, obj, runtime.SymbolIterator)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	method := completion.Value.(*JavaScriptValue)
+
+	iterator := obj
+	if method.Type != TypeUndefined {
+		completion = Call(runtime, method, obj, []*JavaScriptValue{})
+		if completion.Type != Normal {
+			return completion
+		}
+
+		iterator = completion.Value.(*JavaScriptValue)
+	}
+
+	if iterator.Type != TypeObject {
+		return NewThrowCompletion(NewTypeError(runtime, "Iterator is not an object"))
+	}
+
+	return GetIteratorDirect(runtime, iterator)
+}
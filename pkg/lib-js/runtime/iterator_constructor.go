@@ -0,0 +1,79 @@
+package runtime
+
+func NewIteratorConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		IteratorConstructor,
+		0,
+		NewStringValue("Iterator"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// Iterator.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicIteratorPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	// Iterator.from
+	DefineBuiltinFunction(runtime, constructor, "from", IteratorFrom, 1)
+
+	return constructor
+}
+
+func IteratorConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "Constructor Iterator requires 'new'"))
+	}
+
+	if newTarget.Value == function {
+		return NewThrowCompletion(NewTypeError(runtime, "Abstract class Iterator not directly constructable"))
+	}
+
+	return OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicIteratorPrototype)
+}
+
+func IteratorFrom(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	completion := GetIteratorFlattenable(runtime, arguments[0], PrimitiveHandlingIterateStrings)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	iteratorRecord := completion.Value.(*Iterator)
+
+	iteratorConstructor := runtime.GetRunningRealm().GetIntrinsic(IntrinsicIteratorConstructor)
+	completion = OrdinaryHasInstance(runtime, NewJavaScriptValue(TypeObject, iteratorConstructor), iteratorRecord.Iterator)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	if completion.Value.(*JavaScriptValue).Value.(*Boolean).Value {
+		return NewNormalCompletion(iteratorRecord.Iterator)
+	}
+
+	wrapper := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicWrapForValidIteratorPrototype))
+	wrapper.(*Object).IteratedIterator = iteratorRecord
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, wrapper))
+}
@@ -0,0 +1,56 @@
+package runtime
+
+const iteratorHelperBrand = "Iterator Helper"
+
+// IteratorHelperStep produces the next value of an iterator helper.
+// It returns a Normal completion holding either the value to yield or an IteratorStepResult when the helper is done.
+type IteratorHelperStep func(runtime *Runtime, helper *Object) *Completion
+
+func CreateIteratorHelper(runtime *Runtime, underlying *Iterator, step IteratorHelperStep) *Object {
+	var helper *Object
+
+	closure := []Instruction{
+		// Loop starts here.
+		EmitEvaluateNativeCallback(func(runtime *Runtime, vm *ExecutionVM) *Completion {
+			completion := step(runtime, helper)
+			if completion.Type != Normal {
+				return completion
+			}
+
+			if result, ok := completion.Value.(*IteratorStepResult); ok && result.Done {
+				return NewNormalCompletion(NewBooleanValue(true))
+			}
+
+			vm.ScratchSpace["value"] = completion.Value.(*JavaScriptValue)
+			return NewNormalCompletion(NewBooleanValue(false))
+		}),
+		// If the step is done, break the loop and complete the closure.
+		EmitJumpIfTrue(2),
+		// Yield the value.
+		EmitYield(func(runtime *Runtime, vm *ExecutionVM) *JavaScriptValue {
+			value := vm.ScratchSpace["value"].(*JavaScriptValue)
+			vm.ScratchSpace["value"] = nil
+			return CreateIteratorResultObject(runtime, value, false)
+		}),
+	}
+
+	// Loop back to the start.
+	closure = append(closure, EmitJump(-len(closure)-1))
+
+	// Clean up the scratch space and return undefined.
+	cleanup := EmitEvaluateNativeCallback(func(runtime *Runtime, vm *ExecutionVM) *Completion {
+		delete(vm.ScratchSpace, "value")
+		return NewNormalCompletion(NewUndefinedValue())
+	})
+	closure = append(closure, cleanup)
+
+	helper = CreateIteratorFromClosure(
+		runtime,
+		closure,
+		iteratorHelperBrand,
+		runtime.GetRunningRealm().GetIntrinsic(IntrinsicIteratorHelperPrototype),
+	).(*Object)
+	helper.UnderlyingIterator = underlying
+
+	return helper
+}
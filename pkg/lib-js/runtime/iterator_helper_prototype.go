@@ -0,0 +1,85 @@
+package runtime
+
+func NewIteratorHelperPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicIteratorPrototype))
+}
+
+func DefineIteratorHelperPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// %IteratorHelperPrototype%.next
+	DefineBuiltinFunction(runtime, prototype, "next", IteratorHelperPrototypeNext, 0)
+
+	// %IteratorHelperPrototype%.return
+	DefineBuiltinFunction(runtime, prototype, "return", IteratorHelperPrototypeReturn, 0)
+
+	// %IteratorHelperPrototype%[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("Iterator Helper"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func thisIteratorHelperValue(runtime *Runtime, value *JavaScriptValue) (*Object, *Completion) {
+	if value.Type == TypeObject {
+		if object, ok := value.Value.(*Object); ok && object.UnderlyingIterator != nil {
+			return object, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Iterator Helper method called on incompatible receiver"))
+}
+
+func IteratorHelperPrototypeNext(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	helper, errCompletion := thisIteratorHelperValue(runtime, thisArg)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	return GeneratorResume(runtime, helper, NewUndefinedValue(), iteratorHelperBrand)
+}
+
+func IteratorHelperPrototypeReturn(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	helper, errCompletion := thisIteratorHelperValue(runtime, thisArg)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	completion := GeneratorValidate(runtime, helper, iteratorHelperBrand)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	if helper.GeneratorState == GeneratorStateCompleted {
+		return NewNormalCompletion(CreateIteratorResultObject(runtime, NewUndefinedValue(), true))
+	}
+
+	// Closure iterators can't receive an abrupt completion at their yield, so perform what the
+	// helper's closure would do with it: close the iterators it is reading from and complete.
+	helper.GeneratorState = GeneratorStateCompleted
+
+	completion = NewNormalCompletion(NewUndefinedValue())
+	if helper.UnderlyingInnerIterator != nil {
+		completion = IteratorClose(runtime, helper.UnderlyingInnerIterator, completion)
+		helper.UnderlyingInnerIterator = nil
+	}
+
+	completion = IteratorClose(runtime, helper.UnderlyingIterator, completion)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return NewNormalCompletion(CreateIteratorResultObject(runtime, NewUndefinedValue(), true))
+}
@@ -1,9 +1,687 @@
 package runtime
 
+import "math"
+
 func NewIteratorPrototype(runtime *Runtime) ObjectInterface {
 	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
 }
 
 func DefineIteratorPrototypeProperties(runtime *Runtime, obj ObjectInterface) {
-	// TODO: Define properties.
+	// Iterator.prototype.constructor
+	DefineBuiltinAccessorFunction(runtime, obj, "constructor", IteratorPrototypeConstructorGetter, IteratorPrototypeConstructorSetter, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// Iterator.prototype.drop
+	DefineBuiltinFunction(runtime, obj, "drop", IteratorPrototypeDrop, 1)
+
+	// Iterator.prototype.every
+	DefineBuiltinFunction(runtime, obj, "every", IteratorPrototypeEvery, 1)
+
+	// Iterator.prototype.filter
+	DefineBuiltinFunction(runtime, obj, "filter", IteratorPrototypeFilter, 1)
+
+	// Iterator.prototype.find
+	DefineBuiltinFunction(runtime, obj, "find", IteratorPrototypeFind, 1)
+
+	// Iterator.prototype.flatMap
+	DefineBuiltinFunction(runtime, obj, "flatMap", IteratorPrototypeFlatMap, 1)
+
+	// Iterator.prototype.forEach
+	DefineBuiltinFunction(runtime, obj, "forEach", IteratorPrototypeForEach, 1)
+
+	// Iterator.prototype.map
+	DefineBuiltinFunction(runtime, obj, "map", IteratorPrototypeMap, 1)
+
+	// Iterator.prototype.reduce
+	DefineBuiltinFunction(runtime, obj, "reduce", IteratorPrototypeReduce, 1)
+
+	// Iterator.prototype.some
+	DefineBuiltinFunction(runtime, obj, "some", IteratorPrototypeSome, 1)
+
+	// Iterator.prototype.take
+	DefineBuiltinFunction(runtime, obj, "take", IteratorPrototypeTake, 1)
+
+	// Iterator.prototype.toArray
+	DefineBuiltinFunction(runtime, obj, "toArray", IteratorPrototypeToArray, 0)
+
+	// Iterator.prototype[%Symbol.iterator%]
+	DefineBuiltinSymbolFunction(runtime, obj, runtime.SymbolIterator, IteratorPrototypeIterator, 0)
+
+	// Iterator.prototype[%Symbol.toStringTag%]
+	DefineBuiltinSymbolAccessorFunction(runtime, obj, runtime.SymbolToStringTag, IteratorPrototypeToStringTagGetter, IteratorPrototypeToStringTagSetter, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+// SetterThatIgnoresPrototypeProperties lets assignments through the accessors on Iterator.prototype
+// create own properties on inheriting objects, while the prototype itself can't be changed this way.
+func SetterThatIgnoresPrototypeProperties(
+	runtime *Runtime,
+	thisValue *JavaScriptValue,
+	home ObjectInterface,
+	key *JavaScriptValue,
+	value *JavaScriptValue,
+) *Completion {
+	if thisValue.Type != TypeObject {
+		return NewThrowCompletion(NewTypeError(runtime, "Setter called on a non-object"))
+	}
+
+	object := thisValue.Value.(ObjectInterface)
+	if object == home {
+		return NewThrowCompletion(NewTypeError(runtime, "Cannot assign to a read-only property of the prototype"))
+	}
+
+	completion := object.GetOwnProperty(runtime, key)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	if desc, ok := completion.Value.(PropertyDescriptor); !ok || desc == nil {
+		completion = CreateDataProperty(runtime, object, key, value)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		if !completion.Value.(*JavaScriptValue).Value.(*Boolean).Value {
+			return NewThrowCompletion(NewTypeError(runtime, "Cannot define property on object"))
+		}
+
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	completion = object.Set(runtime, key, value, thisValue)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	if !completion.Value.(*JavaScriptValue).Value.(*Boolean).Value {
+		return NewThrowCompletion(NewTypeError(runtime, "Cannot assign to read-only property"))
+	}
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+func IteratorPrototypeConstructorGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, runtime.GetRunningRealm().GetIntrinsic(IntrinsicIteratorConstructor)))
+}
+
+func IteratorPrototypeConstructorSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	home := runtime.GetRunningRealm().GetIntrinsic(IntrinsicIteratorPrototype)
+	return SetterThatIgnoresPrototypeProperties(runtime, thisArg, home, NewStringValue("constructor"), arguments[0])
+}
+
+func IteratorPrototypeToStringTagGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return NewNormalCompletion(NewStringValue("Iterator"))
+}
+
+func IteratorPrototypeToStringTagSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	home := runtime.GetRunningRealm().GetIntrinsic(IntrinsicIteratorPrototype)
+	return SetterThatIgnoresPrototypeProperties(runtime, thisArg, home, runtime.SymbolToStringTag, arguments[0])
+}
+
+func IteratorPrototypeIterator(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return NewNormalCompletion(thisArg)
+}
+
+// iteratorWithCallback validates the receiver and callback shared by most of the helper methods.
+// The receiver is closed if the callback is not callable.
+func iteratorWithCallback(runtime *Runtime, thisArg *JavaScriptValue, callback *JavaScriptValue, methodName string) (*Iterator, *Completion) {
+	if thisArg.Type != TypeObject {
+		return nil, NewThrowCompletion(NewTypeError(runtime, "Iterator.prototype."+methodName+" called on a non-object"))
+	}
+
+	if !IsCallable(callback) {
+		iterated := &Iterator{Iterator: thisArg, Next: NewUndefinedValue()}
+		err := NewThrowCompletion(NewTypeError(runtime, "Iterator.prototype."+methodName+" callback is not a function"))
+		return nil, IteratorClose(runtime, iterated, err)
+	}
+
+	completion := GetIteratorDirect(runtime, thisArg)
+	if completion.Type != Normal {
+		return nil, completion
+	}
+
+	return completion.Value.(*Iterator), nil
+}
+
+// iteratorWithLimit validates the receiver and limit of take and drop.
+// The receiver is closed if the limit is not a valid number.
+func iteratorWithLimit(runtime *Runtime, thisArg *JavaScriptValue, limit *JavaScriptValue, methodName string) (*Iterator, float64, *Completion) {
+	if thisArg.Type != TypeObject {
+		return nil, 0, NewThrowCompletion(NewTypeError(runtime, "Iterator.prototype."+methodName+" called on a non-object"))
+	}
+
+	iterated := &Iterator{Iterator: thisArg, Next: NewUndefinedValue()}
+
+	completion := ToNumber(runtime, limit)
+	if completion.Type != Normal {
+		return nil, 0, IteratorClose(runtime, iterated, completion)
+	}
+
+	if completion.Value.(*JavaScriptValue).Value.(*Number).NaN {
+		err := NewThrowCompletion(NewRangeError(runtime, "Iterator.prototype."+methodName+" limit must be a number"))
+		return nil, 0, IteratorClose(runtime, iterated, err)
+	}
+
+	completion = ToIntegerOrInfinity(runtime, completion.Value.(*JavaScriptValue))
+	if completion.Type != Normal {
+		return nil, 0, IteratorClose(runtime, iterated, completion)
+	}
+
+	integerLimit := completion.Value.(*JavaScriptValue).Value.(*Number).Value
+	if integerLimit < 0 {
+		err := NewThrowCompletion(NewRangeError(runtime, "Iterator.prototype."+methodName+" limit must be positive"))
+		return nil, 0, IteratorClose(runtime, iterated, err)
+	}
+
+	completion = GetIteratorDirect(runtime, thisArg)
+	if completion.Type != Normal {
+		return nil, 0, completion
+	}
+
+	return completion.Value.(*Iterator), integerLimit, nil
+}
+
+func IteratorPrototypeDrop(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	iterated, remaining, errCompletion := iteratorWithLimit(runtime, thisArg, arguments[0], "drop")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	helper := CreateIteratorHelper(runtime, iterated, func(runtime *Runtime, helper *Object) *Completion {
+		for remaining > 0 {
+			if remaining != math.Inf(1) {
+				remaining--
+			}
+
+			completion := IteratorStep(runtime, iterated)
+			if completion.Type != Normal {
+				return completion
+			}
+
+			if iterated.Done {
+				return completion
+			}
+		}
+
+		return IteratorStepValue(runtime, iterated)
+	})
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, helper))
+}
+
+func IteratorPrototypeEvery(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	predicate := arguments[0]
+
+	iterated, errCompletion := iteratorWithCallback(runtime, thisArg, predicate, "every")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	for counter := 0; ; counter++ {
+		completion := IteratorStepValue(runtime, iterated)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		if iterated.Done {
+			return NewNormalCompletion(NewBooleanValue(true))
+		}
+
+		value := completion.Value.(*JavaScriptValue)
+
+		completion = Call(runtime, predicate, NewUndefinedValue(), []*JavaScriptValue{value, NewNumberValue(float64(counter), false)})
+		if completion.Type != Normal {
+			return IteratorClose(runtime, iterated, completion)
+		}
+
+		if !ToBoolean(completion.Value.(*JavaScriptValue)).Value.(*JavaScriptValue).Value.(*Boolean).Value {
+			return IteratorClose(runtime, iterated, NewNormalCompletion(NewBooleanValue(false)))
+		}
+	}
+}
+
+func IteratorPrototypeFilter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	predicate := arguments[0]
+
+	iterated, errCompletion := iteratorWithCallback(runtime, thisArg, predicate, "filter")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	counter := 0
+	helper := CreateIteratorHelper(runtime, iterated, func(runtime *Runtime, helper *Object) *Completion {
+		for {
+			completion := IteratorStepValue(runtime, iterated)
+			if completion.Type != Normal || iterated.Done {
+				return completion
+			}
+
+			value := completion.Value.(*JavaScriptValue)
+
+			completion = Call(runtime, predicate, NewUndefinedValue(), []*JavaScriptValue{value, NewNumberValue(float64(counter), false)})
+			counter++
+			if completion.Type != Normal {
+				return IteratorClose(runtime, iterated, completion)
+			}
+
+			if ToBoolean(completion.Value.(*JavaScriptValue)).Value.(*JavaScriptValue).Value.(*Boolean).Value {
+				return NewNormalCompletion(value)
+			}
+		}
+	})
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, helper))
+}
+
+func IteratorPrototypeFind(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	predicate := arguments[0]
+
+	iterated, errCompletion := iteratorWithCallback(runtime, thisArg, predicate, "find")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	for counter := 0; ; counter++ {
+		completion := IteratorStepValue(runtime, iterated)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		if iterated.Done {
+			return NewNormalCompletion(NewUndefinedValue())
+		}
+
+		value := completion.Value.(*JavaScriptValue)
+
+		completion = Call(runtime, predicate, NewUndefinedValue(), []*JavaScriptValue{value, NewNumberValue(float64(counter), false)})
+		if completion.Type != Normal {
+			return IteratorClose(runtime, iterated, completion)
+		}
+
+		if ToBoolean(completion.Value.(*JavaScriptValue)).Value.(*JavaScriptValue).Value.(*Boolean).Value {
+			return IteratorClose(runtime, iterated, NewNormalCompletion(value))
+		}
+	}
+}
+
+func IteratorPrototypeFlatMap(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	mapper := arguments[0]
+
+	iterated, errCompletion := iteratorWithCallback(runtime, thisArg, mapper, "flatMap")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	counter := 0
+	helper := CreateIteratorHelper(runtime, iterated, func(runtime *Runtime, helper *Object) *Completion {
+		for {
+			// Continue yielding from the current inner iterator.
+			if inner := helper.UnderlyingInnerIterator; inner != nil {
+				completion := IteratorStepValue(runtime, inner)
+				if completion.Type != Normal {
+					helper.UnderlyingInnerIterator = nil
+					return IteratorClose(runtime, iterated, completion)
+				}
+
+				if !inner.Done {
+					return completion
+				}
+
+				helper.UnderlyingInnerIterator = nil
+			}
+
+			completion := IteratorStepValue(runtime, iterated)
+			if completion.Type != Normal || iterated.Done {
+				return completion
+			}
+
+			value := completion.Value.(*JavaScriptValue)
+
+			completion = Call(runtime, mapper, NewUndefinedValue(), []*JavaScriptValue{value, NewNumberValue(float64(counter), false)})
+			counter++
+			if completion.Type != Normal {
+				return IteratorClose(runtime, iterated, completion)
+			}
+
+			completion = GetIteratorFlattenable(runtime, completion.Value.(*JavaScriptValue), PrimitiveHandlingReject)
+			if completion.Type != Normal {
+				return IteratorClose(runtime, iterated, completion)
+			}
+
+			helper.UnderlyingInnerIterator = completion.Value.(*Iterator)
+		}
+	})
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, helper))
+}
+
+func IteratorPrototypeForEach(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	procedure := arguments[0]
+
+	iterated, errCompletion := iteratorWithCallback(runtime, thisArg, procedure, "forEach")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	for counter := 0; ; counter++ {
+		completion := IteratorStepValue(runtime, iterated)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		if iterated.Done {
+			return NewNormalCompletion(NewUndefinedValue())
+		}
+
+		value := completion.Value.(*JavaScriptValue)
+
+		completion = Call(runtime, procedure, NewUndefinedValue(), []*JavaScriptValue{value, NewNumberValue(float64(counter), false)})
+		if completion.Type != Normal {
+			return IteratorClose(runtime, iterated, completion)
+		}
+	}
+}
+
+func IteratorPrototypeMap(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	mapper := arguments[0]
+
+	iterated, errCompletion := iteratorWithCallback(runtime, thisArg, mapper, "map")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	counter := 0
+	helper := CreateIteratorHelper(runtime, iterated, func(runtime *Runtime, helper *Object) *Completion {
+		completion := IteratorStepValue(runtime, iterated)
+		if completion.Type != Normal || iterated.Done {
+			return completion
+		}
+
+		value := completion.Value.(*JavaScriptValue)
+
+		completion = Call(runtime, mapper, NewUndefinedValue(), []*JavaScriptValue{value, NewNumberValue(float64(counter), false)})
+		counter++
+		if completion.Type != Normal {
+			return IteratorClose(runtime, iterated, completion)
+		}
+
+		return completion
+	})
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, helper))
+}
+
+func IteratorPrototypeReduce(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	hasInitialValue := len(arguments) >= 2
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	reducer := arguments[0]
+
+	iterated, errCompletion := iteratorWithCallback(runtime, thisArg, reducer, "reduce")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	var accumulator *JavaScriptValue
+	counter := 0
+
+	if hasInitialValue {
+		accumulator = arguments[1]
+	} else {
+		completion := IteratorStepValue(runtime, iterated)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		if iterated.Done {
+			return NewThrowCompletion(NewTypeError(runtime, "Reduce of empty iterator with no initial value"))
+		}
+
+		accumulator = completion.Value.(*JavaScriptValue)
+		counter = 1
+	}
+
+	for ; ; counter++ {
+		completion := IteratorStepValue(runtime, iterated)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		if iterated.Done {
+			return NewNormalCompletion(accumulator)
+		}
+
+		value := completion.Value.(*JavaScriptValue)
+
+		completion = Call(runtime, reducer, NewUndefinedValue(), []*JavaScriptValue{accumulator, value, NewNumberValue(float64(counter), false)})
+		if completion.Type != Normal {
+			return IteratorClose(runtime, iterated, completion)
+		}
+
+		accumulator = completion.Value.(*JavaScriptValue)
+	}
+}
+
+func IteratorPrototypeSome(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	predicate := arguments[0]
+
+	iterated, errCompletion := iteratorWithCallback(runtime, thisArg, predicate, "some")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	for counter := 0; ; counter++ {
+		completion := IteratorStepValue(runtime, iterated)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		if iterated.Done {
+			return NewNormalCompletion(NewBooleanValue(false))
+		}
+
+		value := completion.Value.(*JavaScriptValue)
+
+		completion = Call(runtime, predicate, NewUndefinedValue(), []*JavaScriptValue{value, NewNumberValue(float64(counter), false)})
+		if completion.Type != Normal {
+			return IteratorClose(runtime, iterated, completion)
+		}
+
+		if ToBoolean(completion.Value.(*JavaScriptValue)).Value.(*JavaScriptValue).Value.(*Boolean).Value {
+			return IteratorClose(runtime, iterated, NewNormalCompletion(NewBooleanValue(true)))
+		}
+	}
+}
+
+func IteratorPrototypeTake(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	iterated, remaining, errCompletion := iteratorWithLimit(runtime, thisArg, arguments[0], "take")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	helper := CreateIteratorHelper(runtime, iterated, func(runtime *Runtime, helper *Object) *Completion {
+		if remaining == 0 {
+			completion := IteratorClose(runtime, iterated, NewNormalCompletion(NewUndefinedValue()))
+			if completion.Type != Normal {
+				return completion
+			}
+
+			return NewNormalCompletion(&IteratorStepResult{Done: true})
+		}
+
+		if remaining != math.Inf(1) {
+			remaining--
+		}
+
+		return IteratorStepValue(runtime, iterated)
+	})
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, helper))
+}
+
+func IteratorPrototypeToArray(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if thisArg.Type != TypeObject {
+		return NewThrowCompletion(NewTypeError(runtime, "Iterator.prototype.toArray called on a non-object"))
+	}
+
+	completion := GetIteratorDirect(runtime, thisArg)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = IteratorToList(runtime, completion.Value.(*Iterator))
+	if completion.Type != Normal {
+		return completion
+	}
+
+	array := CreateArrayFromList(runtime, completion.Value.([]*JavaScriptValue))
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, array))
 }
@@ -0,0 +1,197 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+)
+
+// JobCallback is an abstract closure with no parameters that is run when its job is dequeued.
+type JobCallback func(runtime *Runtime) *Completion
+
+type Job struct {
+	Callback JobCallback
+	Realm    *Realm
+}
+
+// JobQueue holds the jobs of a single agent. Jobs may be enqueued from any goroutine (e.g. by Atomics.notify
+// running in another agent), but they are only ever run on the goroutine that owns the Runtime.
+type JobQueue struct {
+	mutex      sync.Mutex
+	jobs       []*Job
+	operations map[*HostOperation]struct{}
+	wake       chan struct{}
+}
+
+func NewJobQueue() *JobQueue {
+	return &JobQueue{
+		jobs:       make([]*Job, 0),
+		operations: make(map[*HostOperation]struct{}),
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+func (q *JobQueue) enqueue(job *Job) {
+	q.mutex.Lock()
+	q.jobs = append(q.jobs, job)
+	q.mutex.Unlock()
+
+	q.signal()
+}
+
+func (q *JobQueue) dequeue() *Job {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.jobs) == 0 {
+		return nil
+	}
+
+	job := q.jobs[0]
+	q.jobs[0] = nil
+	q.jobs = q.jobs[1:]
+	return job
+}
+
+// clear discards the queued jobs and cancels the outstanding host operations, e.g. after the script that started them
+// was terminated.
+func (q *JobQueue) clear() {
+	q.mutex.Lock()
+	q.jobs = make([]*Job, 0)
+	operations := q.operations
+	q.operations = make(map[*HostOperation]struct{})
+	for operation := range operations {
+		operation.cancelled = true
+	}
+	q.mutex.Unlock()
+
+	// Cancel outside the lock, as cancelling may wait for the operation to notice.
+	for operation := range operations {
+		if operation.cancel != nil {
+			operation.cancel()
+		}
+	}
+}
+
+func (q *JobQueue) hasJobs() bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.jobs) > 0
+}
+
+func (q *JobQueue) hasPendingOperations() bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.operations) > 0
+}
+
+func (q *JobQueue) signal() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Runtime) HostEnqueuePromiseJob(callback JobCallback, realm *Realm) {
+	r.Jobs.enqueue(&Job{Callback: callback, Realm: realm})
+}
+
+func (r *Runtime) HostEnqueueGenericJob(callback JobCallback, realm *Realm) {
+	r.Jobs.enqueue(&Job{Callback: callback, Realm: realm})
+}
+
+// HostOperation is work the host does on another goroutine that enqueues jobs when it makes progress, such as an
+// Atomics.waitAsync waiter or an HTTP request. RunJobs keeps waiting while operations are outstanding. When execution
+// is terminated, the operations are cancelled and the jobs they enqueue afterwards are discarded, so a terminated
+// script can't block or run code in the next one.
+type HostOperation struct {
+	queue     *JobQueue
+	cancel    func()
+	cancelled bool // Guarded by the mutex of the queue.
+}
+
+// BeginHostOperation records that the host will enqueue jobs later, until End is called. cancel, if not nil, is called
+// when execution is terminated first, and should stop the work.
+func (r *Runtime) BeginHostOperation(cancel func()) *HostOperation {
+	operation := &HostOperation{queue: r.Jobs, cancel: cancel}
+
+	r.Jobs.mutex.Lock()
+	r.Jobs.operations[operation] = struct{}{}
+	r.Jobs.mutex.Unlock()
+
+	return operation
+}
+
+// EnqueueJob enqueues a job for the operation and reports whether it did, which it doesn't once the operation was
+// cancelled. It is safe to call from any goroutine.
+func (o *HostOperation) EnqueueJob(callback JobCallback, realm *Realm) bool {
+	o.queue.mutex.Lock()
+	queued := !o.cancelled
+	if queued {
+		o.queue.jobs = append(o.queue.jobs, &Job{Callback: callback, Realm: realm})
+	}
+	o.queue.mutex.Unlock()
+
+	o.queue.signal()
+	return queued
+}
+
+// End records that the operation won't enqueue more jobs. It is safe to call from any goroutine.
+func (o *HostOperation) End() {
+	o.queue.mutex.Lock()
+	delete(o.queue.operations, o)
+	o.queue.mutex.Unlock()
+
+	o.queue.signal()
+}
+
+// RunJobs runs queued jobs until the queue is empty and no host operation is outstanding.
+// It returns the first abrupt completion of a job, leaving the remaining jobs queued unless execution was terminated.
+func (r *Runtime) RunJobs() *Completion {
+	return r.Execute(r.runJobs)
+}
+
+// RunJobsContext is like RunJobs, but stops with a Terminate completion when ctx is done.
+func (r *Runtime) RunJobsContext(ctx context.Context) *Completion {
+	return r.ExecuteContext(ctx, r.runJobs)
+}
+
+func (r *Runtime) runJobs() *Completion {
+	for {
+		if completion := r.CheckInterrupt(); completion != nil {
+			return completion
+		}
+
+		job := r.Jobs.dequeue()
+		if job == nil {
+			if !r.Jobs.hasPendingOperations() {
+				return NewNormalCompletion(nil)
+			}
+
+			// Block until another goroutine enqueues a job or finishes an operation.
+			<-r.Jobs.wake
+			continue
+		}
+
+		completion := r.runJob(job)
+		if completion.Type == Throw || completion.Type == Terminate {
+			return completion
+		}
+	}
+}
+
+func (r *Runtime) runJob(job *Job) *Completion {
+	if job.Realm != nil {
+		r.PushExecutionContext(&ExecutionContext{
+			Realm: job.Realm,
+		})
+		defer r.PopExecutionContext()
+	}
+
+	completion := job.Callback(r)
+	r.ClearKeptObjects()
+	if completion == nil {
+		return NewNormalCompletion(nil)
+	}
+
+	return completion
+}
@@ -0,0 +1,115 @@
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseJSON parses text as JSON into a value, like JSON.parse without a reviver. Object keys keep the order they
+// appear in. It throws a SyntaxError if text isn't valid JSON.
+func ParseJSON(runtime *Runtime, text string) *Completion {
+	value, err := parseJSONText(runtime, text)
+	if err != nil {
+		return NewThrowCompletion(NewSyntaxError(runtime, err.Error()))
+	}
+	return NewNormalCompletion(value)
+}
+
+// parseJSONText parses text as JSON, returning why it isn't valid JSON if it isn't.
+func parseJSONText(runtime *Runtime, text string) (*JavaScriptValue, error) {
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+
+	value, err := parseJSONValue(runtime, decoder)
+	if err == nil {
+		// Only whitespace may follow the value.
+		if _, trailingErr := decoder.Token(); trailingErr != io.EOF {
+			err = errors.New("Unexpected non-whitespace character after JSON")
+		}
+	}
+
+	if err != nil {
+		return nil, errors.New("Unexpected token in JSON: " + err.Error())
+	}
+	return value, nil
+}
+
+func parseJSONValue(runtime *Runtime, decoder *json.Decoder) (*JavaScriptValue, error) {
+	token, err := decoder.Token()
+	if err == io.EOF {
+		return nil, errors.New("Unexpected end of JSON input")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch token := token.(type) {
+	case nil:
+		return NewNullValue(), nil
+	case bool:
+		return NewBooleanValue(token), nil
+	case string:
+		return NewStringValue(token), nil
+	case json.Number:
+		// Numbers too large for a float64 parse to an infinity, as they do in JSON.parse.
+		number, err := strconv.ParseFloat(string(token), 64)
+		if err != nil && !math.IsInf(number, 0) {
+			return nil, err
+		}
+		return NewNumberValue(number, false), nil
+	case json.Delim:
+		if token == '[' {
+			return parseJSONArray(runtime, decoder)
+		}
+		return parseJSONObject(runtime, decoder)
+	}
+
+	return nil, errors.New("Unexpected token")
+}
+
+func parseJSONArray(runtime *Runtime, decoder *json.Decoder) (*JavaScriptValue, error) {
+	elements := []*JavaScriptValue{}
+	for decoder.More() {
+		element, err := parseJSONValue(runtime, decoder)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+
+	// The closing bracket.
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	return NewJavaScriptValue(TypeObject, CreateArrayFromList(runtime, elements)), nil
+}
+
+func parseJSONObject(runtime *Runtime, decoder *json.Decoder) (*JavaScriptValue, error) {
+	object := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := parseJSONValue(runtime, decoder)
+		if err != nil {
+			return nil, err
+		}
+
+		// A repeated key keeps its first position and takes the last value.
+		CreateDataProperty(runtime, object, NewStringValue(key.(string)), value)
+	}
+
+	// The closing brace.
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	return NewJavaScriptValue(TypeObject, object), nil
+}
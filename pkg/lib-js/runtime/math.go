@@ -6,6 +6,9 @@ func NewMathObject(runtime *Runtime) ObjectInterface {
 	// Math.pow
 	DefineBuiltinFunction(runtime, mathObj, "pow", MathPow, 2)
 
+	// Math.random
+	DefineBuiltinFunction(runtime, mathObj, "random", MathRandom, 0)
+
 	// TODO: Define properties.
 
 	return mathObj
@@ -41,3 +44,13 @@ func MathPow(
 	result := NumberExponentiate(base, exponent)
 	return NewNormalCompletion(NewJavaScriptValue(TypeNumber, result))
 }
+
+func MathRandom(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return NewNormalCompletion(NewNumberValue(runtime.Random(), false))
+}
@@ -0,0 +1,56 @@
+package runtime
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// StepBudgetError is the reason a script was terminated for taking more steps than its budget allowed.
+type StepBudgetError struct {
+	Budget uint64
+}
+
+func (e *StepBudgetError) Error() string {
+	return fmt.Sprintf("step budget exceeded: more than %d steps", e.Budget)
+}
+
+// Step counts one unit of work towards the step budget: a statement, a loop iteration, a function call or an
+// instruction of the ExecutionVM. It returns a Terminate completion once the budget is spent or the runtime was
+// interrupted, and nil otherwise. The count only depends on the script, so a budget stops it at the same point on
+// every run.
+func (r *Runtime) Step() *Completion {
+	r.steps++
+	if r.stepLimit > 0 && r.steps > r.stepLimit {
+		return NewTerminateCompletion(&InterruptedError{Reason: &StepBudgetError{Budget: r.stepBudget}})
+	}
+	return r.CheckInterrupt()
+}
+
+// Steps returns how many steps scripts have taken on this runtime.
+func (r *Runtime) Steps() uint64 {
+	return r.steps
+}
+
+// SetStepBudget allows scripts to take budget more steps before they are terminated with a *StepBudgetError.
+// Zero removes the budget. Once it is spent, every evaluation is terminated until the budget is set again.
+func (r *Runtime) SetStepBudget(budget uint64) {
+	r.stepBudget = budget
+	r.stepLimit = 0
+	if budget > 0 {
+		r.stepLimit = r.steps + budget
+	}
+}
+
+// SetDeterministic replaces the sources of nondeterminism scripts can observe, so a run can be replayed exactly.
+// Math.random returns a sequence seeded by seed, and the clock starts at start and advances by a microsecond per step
+// instead of following the wall clock. Timers run on a VirtualClock, which also moves the clock scripts observe.
+func (r *Runtime) SetDeterministic(seed uint64, start time.Time) {
+	clock := NewVirtualClock(start)
+
+	r.Random = rand.New(rand.NewPCG(seed, seed)).Float64
+	r.Clock = clock
+	r.Now = func() time.Time {
+		return clock.Now().Add(time.Duration(r.steps) * time.Microsecond)
+	}
+}
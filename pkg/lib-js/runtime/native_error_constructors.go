@@ -109,6 +109,8 @@ func NativeErrorConstructor(
 		return completion
 	}
 
+	InstallErrorStack(runtime, object)
+
 	return NewNormalCompletion(objectVal)
 }
 
@@ -136,10 +138,29 @@ func NewURIError(runtime *Runtime, message string) *JavaScriptValue {
 	return NewNativeError(runtime, IntrinsicURIErrorConstructor, message)
 }
 
+// NewNamedError creates an Error with its own name, for the errors Web APIs throw as a DOMException, such as
+// DataCloneError. There is no DOMException, so they are Errors named after the exception.
+func NewNamedError(runtime *Runtime, name string, message string) *JavaScriptValue {
+	errorVal := NewNativeError(runtime, IntrinsicErrorConstructor, message)
+	errorVal.Value.(*Object).DefineOwnProperty(runtime, nameStr, &DataPropertyDescriptor{
+		Value:        NewStringValue(name),
+		Writable:     true,
+		Enumerable:   false,
+		Configurable: true,
+	})
+	return errorVal
+}
+
 func NewNativeError(runtime *Runtime, errorConstructor Intrinsic, message string) *JavaScriptValue {
 	realm := runtime.GetRunningRealm()
 	constructor := realm.GetIntrinsic(errorConstructor).(FunctionInterface)
+
+	// Errors are thrown at MaxCallDepth too, including the RangeError for exceeding it.
+	exempt := runtime.callDepthExempt
+	runtime.callDepthExempt = true
 	completion := constructor.Construct(runtime, []*JavaScriptValue{NewStringValue(message)}, nil)
+	runtime.callDepthExempt = exempt
+
 	if completion.Type != Normal {
 		panic("Assert failed: Failed to construct NativeError.")
 	}
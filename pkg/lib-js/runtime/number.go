@@ -2,7 +2,9 @@ package runtime
 
 import (
 	"math"
+	"math/big"
 	"strconv"
+	"strings"
 )
 
 type Number struct {
@@ -185,17 +187,107 @@ func NumberSameValueZero(left *Number, right *Number) bool {
 }
 
 func NumberToString(value *Number, radix int) *JavaScriptValue {
-	// TODO: Implement Number::toString according to the spec, this is just a placeholder.
-	if value.NaN {
+	if value.NaN || math.IsNaN(value.Value) {
 		return NewStringValue("NaN")
 	}
 
-	valueFloat := value.Value
-	valueInt := math.Trunc(valueFloat)
+	x := value.Value
+	if x == 0 {
+		return NewStringValue("0")
+	}
+
+	if x < 0 {
+		return NewStringValue("-" + NumberToString(&Number{Value: -x}, radix).Value.(*String).Value)
+	}
+
+	if math.IsInf(x, 1) {
+		return NewStringValue("Infinity")
+	}
+
+	if radix != 10 {
+		return NewStringValue(numberToRadixString(x, radix))
+	}
+
+	// Let n, k, and s be integers such that k ≥ 1, 10^(k-1) ≤ s < 10^k, s × 10^(n-k) is x,
+	// and k is as small as possible.
+	mantissa, exponentString, _ := strings.Cut(strconv.FormatFloat(x, 'e', -1, 64), "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	exponent, _ := strconv.Atoi(exponentString)
+	k := len(digits)
+	n := exponent + 1
+
+	if k <= n && n <= 21 {
+		return NewStringValue(digits + strings.Repeat("0", n-k))
+	}
+
+	if 0 < n && n <= 21 {
+		return NewStringValue(digits[:n] + "." + digits[n:])
+	}
+
+	if -6 < n && n <= 0 {
+		return NewStringValue("0." + strings.Repeat("0", -n) + digits)
+	}
 
-	if valueInt == valueFloat && radix == 10 {
-		return NewStringValue(strconv.FormatInt(int64(valueInt), radix))
+	sign := "+"
+	if n-1 < 0 {
+		sign = "-"
 	}
+	exponentDigits := strconv.Itoa(int(math.Abs(float64(n - 1))))
 
-	panic("TODO: Implement Number::toString for non-integer numbers.")
+	if k == 1 {
+		return NewStringValue(digits + "e" + sign + exponentDigits)
+	}
+	return NewStringValue(digits[:1] + "." + digits[1:] + "e" + sign + exponentDigits)
+}
+
+// numberToRadixString formats a finite, positive number in a radix other than 10, producing
+// the shortest fraction that still reads back as the same number.
+func numberToRadixString(x float64, radix int) string {
+	const chars = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	integer := math.Floor(x)
+	fraction := x - integer
+
+	// Half the distance to the next double, anything smaller can't be represented.
+	delta := math.Max(0.5*(math.Nextafter(x, math.Inf(1))-x), math.Nextafter(0, 1))
+
+	fractionDigits := make([]byte, 0)
+	if fraction >= delta {
+		for {
+			fraction *= float64(radix)
+			delta *= float64(radix)
+			digit := int(fraction)
+			fractionDigits = append(fractionDigits, chars[digit])
+			fraction -= float64(digit)
+
+			if (fraction > 0.5 || (fraction == 0.5 && digit&1 == 1)) && fraction+delta > 1 {
+				// Round up, carrying into the integer part if every digit overflows.
+				for {
+					if len(fractionDigits) == 0 {
+						integer++
+						break
+					}
+
+					last := strings.IndexByte(chars, fractionDigits[len(fractionDigits)-1])
+					fractionDigits = fractionDigits[:len(fractionDigits)-1]
+					if last+1 < radix {
+						fractionDigits = append(fractionDigits, chars[last+1])
+						break
+					}
+				}
+				break
+			}
+
+			if fraction < delta {
+				break
+			}
+		}
+	}
+
+	integerDigits, _ := new(big.Float).SetFloat64(integer).Int(nil)
+	result := integerDigits.Text(radix)
+	if len(fractionDigits) > 0 {
+		result += "." + string(fractionDigits)
+	}
+	return result
 }
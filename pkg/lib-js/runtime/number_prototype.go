@@ -8,5 +8,48 @@ func NewNumberPrototype(runtime *Runtime) ObjectInterface {
 }
 
 func DefineNumberPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Number.prototype.toLocaleString
+	DefineBuiltinFunction(runtime, prototype, "toLocaleString", NumberPrototypeToLocaleString, 0)
+
 	// TODO: Define other properties.
 }
+
+func thisNumberValue(runtime *Runtime, value *JavaScriptValue, method string) (*JavaScriptValue, *Completion) {
+	if value.Type == TypeNumber {
+		return value, nil
+	}
+
+	if value.Type == TypeObject {
+		if object, ok := value.Value.(*Object); ok && object.NumberData != nil {
+			return object.NumberData, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Number.prototype."+method+" requires that 'this' be a Number"))
+}
+
+// Number.prototype.toLocaleString(locales, options)
+func NumberPrototypeToLocaleString(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	number, completion := thisNumberValue(runtime, thisArg, "toLocaleString")
+	if completion != nil {
+		return completion
+	}
+
+	requested, options, completion := intlConstructorArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	numberFormat, completion := InitializeNumberFormat(runtime, requested, options)
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(FormatNumeric(numberFormat, newIntlMathematicalValue(number.Value.(*Number)))))
+}
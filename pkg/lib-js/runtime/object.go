@@ -6,10 +6,7 @@ type ObjectInterface interface {
 	GetPrototype() ObjectInterface
 	SetPrototype(prototype ObjectInterface)
 
-	GetProperties() map[string]PropertyDescriptor
-	GetSymbolProperties() map[*Symbol]PropertyDescriptor
-	SetProperties(properties map[string]PropertyDescriptor)
-	SetSymbolProperties(symbolProperties map[*Symbol]PropertyDescriptor)
+	GetProperties() *PropertyStore
 
 	GetPrivateElements() []*PrivateElement
 	SetPrivateElements(privateElements []*PrivateElement)
@@ -29,52 +26,15 @@ type ObjectInterface interface {
 }
 
 func GetPropertyFromObject(object ObjectInterface, key *JavaScriptValue) (PropertyDescriptor, bool) {
-	if key.Type == TypeSymbol {
-		propertyDesc, ok := object.GetSymbolProperties()[key.Value.(*Symbol)]
-		if !ok {
-			return nil, false
-		}
-		return propertyDesc, true
-	}
-
-	if key.Type != TypeString {
-		panic("Assert failed: GetPropertyFromObject key is not a string.")
-	}
-
-	propertyName := key.Value.(*String).Value
-	propertyDesc, ok := object.GetProperties()[propertyName]
-	if !ok {
-		return nil, false
-	}
-	return propertyDesc, true
+	return object.GetProperties().Get(key)
 }
 
 func SetPropertyToObject(object ObjectInterface, key *JavaScriptValue, descriptor PropertyDescriptor) {
-	if key.Type == TypeSymbol {
-		object.GetSymbolProperties()[key.Value.(*Symbol)] = descriptor
-		return
-	}
-
-	if key.Type != TypeString {
-		panic("Assert failed: SetPropertyToObject key is not a string.")
-	}
-
-	propertyName := key.Value.(*String).Value
-	object.GetProperties()[propertyName] = descriptor
+	object.GetProperties().Set(key, descriptor)
 }
 
 func DeletePropertyFromObject(object ObjectInterface, key *JavaScriptValue) {
-	if key.Type == TypeSymbol {
-		delete(object.GetSymbolProperties(), key.Value.(*Symbol))
-		return
-	}
-
-	if key.Type != TypeString {
-		panic("Assert failed: DeletePropertyFromObject key is not a string.")
-	}
-
-	propertyName := key.Value.(*String).Value
-	delete(object.GetProperties(), propertyName)
+	object.GetProperties().Delete(key)
 }
 
 type GeneratorState int
@@ -87,11 +47,10 @@ const (
 )
 
 type Object struct {
-	Prototype        ObjectInterface
-	Properties       map[string]PropertyDescriptor
-	SymbolProperties map[*Symbol]PropertyDescriptor
-	Extensible       bool
-	PrivateElements  []*PrivateElement
+	Prototype       ObjectInterface
+	Properties      *PropertyStore
+	Extensible      bool
+	PrivateElements []*PrivateElement
 
 	// Generator slots.
 	IsGenerator      bool
@@ -99,6 +58,13 @@ type Object struct {
 	GeneratorContext *ExecutionContext
 	GeneratorBrand   string
 
+	// Iterator Helper slots.
+	UnderlyingIterator      *Iterator // This corresponds to [[UnderlyingIterator]] in the spec.
+	UnderlyingInnerIterator *Iterator // The inner iterator that flatMap is currently yielding from, if any.
+
+	// WrapForValidIteratorPrototype slots.
+	IteratedIterator *Iterator // This corresponds to [[Iterated]] in the spec.
+
 	// Error slots.
 	IsError bool // This corresponds to [[ErrorData]] in the spec.
 
@@ -106,23 +72,77 @@ type Object struct {
 	NumberData  *JavaScriptValue
 	BooleanData *JavaScriptValue
 	BigIntData  *JavaScriptValue
+	SymbolData  *JavaScriptValue
 
 	// ArrayBuffer slots.
+	IsArrayBuffer               bool // Whether the object has an [[ArrayBufferData]] slot, which stays true once detached.
 	ArrayBufferData             []byte
 	ArrayBufferDataIsShared     bool
 	ArrayBufferByteLength       uint
 	ArrayBufferHasMaxByteLength bool
 	ArrayBufferMaxByteLength    uint
 	ArrayBufferDetachKey        *JavaScriptValue
+	ArrayBufferSharedDataBlock  *SharedDataBlock
+
+	// Promise slots.
+	IsPromise               bool // Whether the object has a [[PromiseState]] slot.
+	PromiseState            PromiseState
+	PromiseResult           *JavaScriptValue
+	PromiseFulfillReactions []*PromiseReaction
+	PromiseRejectReactions  []*PromiseReaction
+	PromiseIsHandled        bool
+
+	// DataView slots.
+	IsDataView                bool // This corresponds to [[DataView]] in the spec.
+	DataViewViewedArrayBuffer *Object
+	DataViewByteLength        uint
+	DataViewByteLengthAuto    bool
+	DataViewByteOffset        uint
+
+	// WeakRef slots.
+	IsWeakRef     bool          // Whether the object has a [[WeakRefTarget]] slot.
+	WeakRefTarget WeakReference // This corresponds to [[WeakRefTarget]] in the spec.
+
+	// FinalizationRegistry slots.
+	IsFinalizationRegistry              bool // Whether the object has a [[Cells]] slot.
+	FinalizationRegistryRealm           *Realm
+	FinalizationRegistryCleanupCallback *JavaScriptValue
+	FinalizationRegistryCells           []*FinalizationRegistryCell
+
+	// ShadowRealm slots.
+	ShadowRealm *Realm // This corresponds to [[ShadowRealm]] in the spec, nil for other objects.
+
+	// TextEncoder and TextDecoder slots.
+	IsTextEncoder bool
+	TextDecoder   *TextDecoderState // The decoder of a TextDecoder, nil for other objects.
+
+	// URL and URLSearchParams slots.
+	URL             *URLRecord           // The URL of a URL object, nil for other objects.
+	URLQueryObject  *Object              // The URLSearchParams object of a URL object, which shares its URL.
+	URLSearchParams *URLSearchParamsList // The list of a URLSearchParams, nil for other objects.
+
+	// Fetch slots.
+	Headers               *HeadersList      // The header list of a Headers object, nil for other objects.
+	Request               *RequestState     // The request of a Request object, nil for other objects.
+	Response              *ResponseState    // The response of a Response object, nil for other objects.
+	AbortSignal           *AbortSignalState // The state of an AbortSignal, nil for other objects.
+	AbortControllerSignal *Object           // The signal of an AbortController, nil for other objects.
+
+	// Intl slots.
+	NumberFormat       *NumberFormatState       // The state of an Intl.NumberFormat, nil for other objects.
+	DateTimeFormat     *DateTimeFormatState     // The state of an Intl.DateTimeFormat, nil for other objects.
+	Collator           *CollatorState           // The state of an Intl.Collator, nil for other objects.
+	PluralRules        *PluralRulesState        // The state of an Intl.PluralRules, nil for other objects.
+	ListFormat         *ListFormatState         // The state of an Intl.ListFormat, nil for other objects.
+	RelativeTimeFormat *RelativeTimeFormatState // The state of an Intl.RelativeTimeFormat, nil for other objects.
 }
 
 func NewEmptyObject() *Object {
 	return &Object{
-		Prototype:        nil,
-		Properties:       make(map[string]PropertyDescriptor),
-		SymbolProperties: make(map[*Symbol]PropertyDescriptor),
-		Extensible:       true,
-		PrivateElements:  make([]*PrivateElement, 0),
+		Prototype:       nil,
+		Properties:      NewPropertyStore(),
+		Extensible:      true,
+		PrivateElements: make([]*PrivateElement, 0),
 	}
 }
 
@@ -134,22 +154,10 @@ func (o *Object) SetPrototype(prototype ObjectInterface) {
 	o.Prototype = prototype
 }
 
-func (o *Object) GetProperties() map[string]PropertyDescriptor {
+func (o *Object) GetProperties() *PropertyStore {
 	return o.Properties
 }
 
-func (o *Object) SetProperties(properties map[string]PropertyDescriptor) {
-	o.Properties = properties
-}
-
-func (o *Object) GetSymbolProperties() map[*Symbol]PropertyDescriptor {
-	return o.SymbolProperties
-}
-
-func (o *Object) SetSymbolProperties(symbolProperties map[*Symbol]PropertyDescriptor) {
-	o.SymbolProperties = symbolProperties
-}
-
 func (o *Object) IsExtensible(runtime *Runtime) *Completion {
 	return NewNormalCompletion(NewBooleanValue(o.Extensible))
 }
@@ -238,7 +246,7 @@ func CopyDataProperties(
 			return NewUnusedCompletion()
 		}
 
-		if desc, ok := value.(*DataPropertyDescriptor); ok && desc != nil && desc.Enumerable {
+		if value != nil && value.GetEnumerable() {
 			valueCompletion := fromObj.Get(runtime, key, fromObjVal)
 			if valueCompletion.Type != Normal {
 				return valueCompletion
@@ -255,17 +263,19 @@ func CopyDataProperties(
 		return NewUnusedCompletion()
 	}
 
-	for key, value := range fromObj.GetProperties() {
-		keyString := NewStringValue(key)
-		completion := copyProperty(keyString, value)
+	completion := fromObj.OwnPropertyKeys(runtime)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	for _, key := range completion.Value.([]*JavaScriptValue) {
+		completion := fromObj.GetOwnProperty(runtime, key)
 		if completion.Type != Normal {
 			return completion
 		}
-	}
 
-	for key, value := range fromObj.GetSymbolProperties() {
-		keyString := NewJavaScriptValue(TypeSymbol, key)
-		completion := copyProperty(keyString, value)
+		value, _ := completion.Value.(PropertyDescriptor)
+		completion = copyProperty(key, value)
 		if completion.Type != Normal {
 			return completion
 		}
@@ -3,20 +3,18 @@ package runtime
 import "fmt"
 
 type ObjectPrototype struct {
-	Prototype        ObjectInterface
-	Properties       map[string]PropertyDescriptor
-	SymbolProperties map[*Symbol]PropertyDescriptor
-	Extensible       bool
-	PrivateElements  []*PrivateElement
+	Prototype       ObjectInterface
+	Properties      *PropertyStore
+	Extensible      bool
+	PrivateElements []*PrivateElement
 }
 
 func NewObjectPrototype(runtime *Runtime) ObjectInterface {
 	objectProto := &ObjectPrototype{
-		Prototype:        nil,
-		Properties:       make(map[string]PropertyDescriptor),
-		SymbolProperties: make(map[*Symbol]PropertyDescriptor),
-		Extensible:       true,
-		PrivateElements:  make([]*PrivateElement, 0),
+		Prototype:       nil,
+		Properties:      NewPropertyStore(),
+		Extensible:      true,
+		PrivateElements: make([]*PrivateElement, 0),
 	}
 
 	return objectProto
@@ -520,22 +518,10 @@ func (o *ObjectPrototype) SetPrototype(prototype ObjectInterface) {
 	o.Prototype = prototype
 }
 
-func (o *ObjectPrototype) GetProperties() map[string]PropertyDescriptor {
+func (o *ObjectPrototype) GetProperties() *PropertyStore {
 	return o.Properties
 }
 
-func (o *ObjectPrototype) SetProperties(properties map[string]PropertyDescriptor) {
-	o.Properties = properties
-}
-
-func (o *ObjectPrototype) GetSymbolProperties() map[*Symbol]PropertyDescriptor {
-	return o.SymbolProperties
-}
-
-func (o *ObjectPrototype) SetSymbolProperties(symbolProperties map[*Symbol]PropertyDescriptor) {
-	o.SymbolProperties = symbolProperties
-}
-
 func (o *ObjectPrototype) IsExtensible(runtime *Runtime) *Completion {
 	return NewNormalCompletion(NewBooleanValue(o.Extensible))
 }
@@ -1,18 +1,13 @@
 package runtime
 
-import (
-	"fmt"
-	"sort"
-	"strconv"
-)
+import "fmt"
 
 func OrdinaryObjectCreate(proto ObjectInterface) ObjectInterface {
 	object := &Object{
-		Prototype:        proto,
-		Properties:       make(map[string]PropertyDescriptor),
-		SymbolProperties: make(map[*Symbol]PropertyDescriptor),
-		Extensible:       true,
-		PrivateElements:  make([]*PrivateElement, 0),
+		Prototype:       proto,
+		Properties:      NewPropertyStore(),
+		Extensible:      true,
+		PrivateElements: make([]*PrivateElement, 0),
 	}
 
 	return object
@@ -93,10 +88,10 @@ func OrdinarySetPrototypeOf(runtime *Runtime, object ObjectInterface, prototype
 		p = NewJavaScriptValue(TypeObject, maybeObj)
 	}
 
-	if p.Type == TypeNull {
+	if prototype.Type == TypeNull {
 		object.SetPrototype(nil)
 	} else {
-		object.SetPrototype(p.Value.(ObjectInterface))
+		object.SetPrototype(prototype.Value.(ObjectInterface))
 	}
 	return NewNormalCompletion(NewBooleanValue(true))
 }
@@ -174,13 +169,19 @@ func OrdinaryDefineOwnProperty(runtime *Runtime, object ObjectInterface, key *Ja
 
 	isExtensible := completion.Value.(*JavaScriptValue).Value.(*Boolean).Value
 
-	return ValidateAndApplyPropertyDescriptor(
+	completion = ValidateAndApplyPropertyDescriptor(
 		NewJavaScriptValue(TypeObject, object),
 		key,
 		isExtensible,
 		descriptor,
 		currentDescriptor,
 	)
+
+	if runtime.resources != nil && currentDescriptor == nil && completion.Type == Normal && completion.Value.(*JavaScriptValue).Value.(*Boolean).Value {
+		runtime.chargeProperties(object, 1)
+	}
+
+	return completion
 }
 
 func OrdinarySet(runtime *Runtime, object ObjectInterface, key *JavaScriptValue, value *JavaScriptValue, receiver *JavaScriptValue) *Completion {
@@ -258,7 +259,17 @@ func OrdinarySet(runtime *Runtime, object ObjectInterface, key *JavaScriptValue,
 			}
 			return receiverObj.DefineOwnProperty(runtime, key, valueDesc)
 		} else {
-			return CreateDataProperty(runtime, receiverObj, key, value)
+			completion := CreateDataProperty(runtime, receiverObj, key, value)
+			if completion.Type != Normal {
+				return completion
+			}
+
+			// Adding the property may have gone over the runtime's resource limits.
+			if terminate := runtime.CheckInterrupt(); terminate != nil {
+				return terminate
+			}
+
+			return completion
 		}
 	}
 
@@ -305,6 +316,9 @@ func OrdinaryGet(runtime *Runtime, object ObjectInterface, key *JavaScriptValue,
 	}
 
 	if accessorDescriptor, ok := ownDescriptor.(*AccessorPropertyDescriptor); ok {
+		if accessorDescriptor.Get == nil {
+			return NewNormalCompletion(NewUndefinedValue())
+		}
 		return accessorDescriptor.Get.Call(runtime, receiver, []*JavaScriptValue{})
 	}
 
@@ -327,6 +341,10 @@ func OrdinaryDelete(runtime *Runtime, object ObjectInterface, key *JavaScriptVal
 	}
 
 	DeletePropertyFromObject(object, key)
+	if runtime.resources != nil {
+		runtime.chargeProperties(object, -1)
+	}
+
 	return NewNormalCompletion(NewBooleanValue(true))
 }
 
@@ -363,43 +381,7 @@ func HasOwnProperty(runtime *Runtime, object ObjectInterface, key *JavaScriptVal
 }
 
 func OrdinaryOwnPropertyKeys(object ObjectInterface) []*JavaScriptValue {
-	keys := make([]*JavaScriptValue, 0)
-	arrayKeys := make([]int, 0)
-
-	seen := make(map[string]bool)
-
-	for key, _ := range object.GetProperties() {
-		arrayKey, err := strconv.ParseInt(key, 10, 64)
-		if err != nil {
-			continue
-		}
-
-		keys = append(keys, NewStringValue(key))
-		arrayKeys = append(arrayKeys, int(arrayKey))
-
-		seen[key] = true
-	}
-
-	// Sort the keys in ascending order.
-	sort.Slice(keys, func(i, j int) bool {
-		return arrayKeys[i] < arrayKeys[j]
-	})
-
-	// TODO: This needs to be insertion order.
-	for key, _ := range object.GetProperties() {
-		if seen[key] {
-			continue
-		}
-
-		keys = append(keys, NewStringValue(key))
-	}
-
-	// TODO: This needs to be insertion order.
-	for key, _ := range object.GetSymbolProperties() {
-		keys = append(keys, NewJavaScriptValue(TypeSymbol, key))
-	}
-
-	return keys
+	return object.GetProperties().Keys()
 }
 
 func OrdinaryCreateFromConstructor(
@@ -413,7 +395,10 @@ func OrdinaryCreateFromConstructor(
 	}
 
 	prototype := completion.Value.(*JavaScriptValue).Value.(ObjectInterface)
-	return NewNormalCompletion(NewJavaScriptValue(TypeObject, OrdinaryObjectCreate(prototype)))
+	object := OrdinaryObjectCreate(prototype)
+	runtime.trackObject(object)
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, object))
 }
 
 func GetPrototypeFromConstructor(
@@ -443,6 +428,38 @@ func GetPrototypeFromConstructor(
 	return NewNormalCompletion(NewJavaScriptValue(TypeObject, realm.GetIntrinsic(defaultProto)))
 }
 
+func SpeciesConstructor(runtime *Runtime, object ObjectInterface, defaultConstructor FunctionInterface) *Completion {
+	completion := object.Get(runtime, NewStringValue("constructor"), NewJavaScriptValue(TypeObject, object))
+	if completion.Type != Normal {
+		return completion
+	}
+
+	constructor := completion.Value.(*JavaScriptValue)
+	if constructor.Type == TypeUndefined {
+		return NewNormalCompletion(NewJavaScriptValue(TypeObject, defaultConstructor))
+	}
+
+	if constructor.Type != TypeObject {
+		return NewThrowCompletion(NewTypeError(runtime, "object.constructor is not an object"))
+	}
+
+	completion = constructor.Value.(ObjectInterface).Get(runtime, runtime.SymbolSpecies, constructor)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	species := completion.Value.(*JavaScriptValue)
+	if species.Type == TypeUndefined || species.Type == TypeNull {
+		return NewNormalCompletion(NewJavaScriptValue(TypeObject, defaultConstructor))
+	}
+
+	if speciesFunc, ok := species.Value.(FunctionInterface); ok && speciesFunc.HasConstructMethod() {
+		return NewNormalCompletion(species)
+	}
+
+	return NewThrowCompletion(NewTypeError(runtime, "object.constructor[Symbol.species] is not a constructor"))
+}
+
 func OrdinaryHasInstance(runtime *Runtime, constructorVal *JavaScriptValue, objectVal *JavaScriptValue) *Completion {
 	if constructorVal.Type != TypeObject {
 		return NewNormalCompletion(NewBooleanValue(false))
@@ -0,0 +1,393 @@
+package runtime
+
+type PromiseState int
+
+const (
+	PromiseStatePending PromiseState = iota
+	PromiseStateFulfilled
+	PromiseStateRejected
+)
+
+type PromiseReactionType int
+
+const (
+	PromiseReactionTypeFulfill PromiseReactionType = iota
+	PromiseReactionTypeReject
+)
+
+type PromiseCapability struct {
+	Promise *JavaScriptValue
+	Resolve *JavaScriptValue
+	Reject  *JavaScriptValue
+}
+
+type PromiseReaction struct {
+	// nil when the reaction has no capability, e.g. for reactions created by the host.
+	Capability *PromiseCapability
+	Type       PromiseReactionType
+	// nil corresponds to an empty handler in the spec.
+	Handler *JavaScriptValue
+}
+
+func IsPromise(value *JavaScriptValue) bool {
+	if value.Type != TypeObject {
+		return false
+	}
+
+	object, ok := value.Value.(*Object)
+	return ok && object.IsPromise
+}
+
+func CreateResolvingFunctions(runtime *Runtime, promise *Object) (*FunctionObject, *FunctionObject) {
+	alreadyResolved := false
+	promiseVal := NewJavaScriptValue(TypeObject, promise)
+
+	resolveClosure := func(
+		runtime *Runtime,
+		function *FunctionObject,
+		thisArg *JavaScriptValue,
+		arguments []*JavaScriptValue,
+		newTarget *JavaScriptValue,
+	) *Completion {
+		resolution := NewUndefinedValue()
+		if len(arguments) > 0 {
+			resolution = arguments[0]
+		}
+
+		if alreadyResolved {
+			return NewNormalCompletion(NewUndefinedValue())
+		}
+		alreadyResolved = true
+
+		if resolution.Type == TypeObject && resolution.Value == promise {
+			RejectPromise(runtime, promise, NewTypeError(runtime, "Chaining cycle detected for promise"))
+			return NewNormalCompletion(NewUndefinedValue())
+		}
+
+		if resolution.Type != TypeObject {
+			FulfillPromise(runtime, promise, resolution)
+			return NewNormalCompletion(NewUndefinedValue())
+		}
+
+		completion := resolution.Value.(ObjectInterface).Get(runtime, NewStringValue("then"), resolution)
+		if completion.Type != Normal {
+			RejectPromise(runtime, promise, completion.Value.(*JavaScriptValue))
+			return NewNormalCompletion(NewUndefinedValue())
+		}
+
+		thenAction := completion.Value.(*JavaScriptValue)
+		if !IsCallable(thenAction) {
+			FulfillPromise(runtime, promise, resolution)
+			return NewNormalCompletion(NewUndefinedValue())
+		}
+
+		job, realm := NewPromiseResolveThenableJob(runtime, promiseVal, resolution, thenAction)
+		runtime.HostEnqueuePromiseJob(job, realm)
+
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	rejectClosure := func(
+		runtime *Runtime,
+		function *FunctionObject,
+		thisArg *JavaScriptValue,
+		arguments []*JavaScriptValue,
+		newTarget *JavaScriptValue,
+	) *Completion {
+		reason := NewUndefinedValue()
+		if len(arguments) > 0 {
+			reason = arguments[0]
+		}
+
+		if alreadyResolved {
+			return NewNormalCompletion(NewUndefinedValue())
+		}
+		alreadyResolved = true
+
+		RejectPromise(runtime, promise, reason)
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	resolve := CreateBuiltinFunction(runtime, resolveClosure, 1, NewStringValue(""), nil, nil)
+	reject := CreateBuiltinFunction(runtime, rejectClosure, 1, NewStringValue(""), nil, nil)
+
+	return resolve, reject
+}
+
+func FulfillPromise(runtime *Runtime, promise *Object, value *JavaScriptValue) {
+	if promise.PromiseState != PromiseStatePending {
+		panic("Assert failed: FulfillPromise called on a settled promise.")
+	}
+
+	reactions := promise.PromiseFulfillReactions
+	promise.PromiseResult = value
+	promise.PromiseFulfillReactions = nil
+	promise.PromiseRejectReactions = nil
+	promise.PromiseState = PromiseStateFulfilled
+
+	TriggerPromiseReactions(runtime, reactions, value)
+}
+
+func RejectPromise(runtime *Runtime, promise *Object, reason *JavaScriptValue) {
+	if promise.PromiseState != PromiseStatePending {
+		panic("Assert failed: RejectPromise called on a settled promise.")
+	}
+
+	reactions := promise.PromiseRejectReactions
+	promise.PromiseResult = reason
+	promise.PromiseFulfillReactions = nil
+	promise.PromiseRejectReactions = nil
+	promise.PromiseState = PromiseStateRejected
+
+	TriggerPromiseReactions(runtime, reactions, reason)
+}
+
+func TriggerPromiseReactions(runtime *Runtime, reactions []*PromiseReaction, argument *JavaScriptValue) {
+	for _, reaction := range reactions {
+		job, realm := NewPromiseReactionJob(runtime, reaction, argument)
+		runtime.HostEnqueuePromiseJob(job, realm)
+	}
+}
+
+func NewPromiseCapability(runtime *Runtime, constructor *JavaScriptValue) *Completion {
+	constructorObj, ok := constructor.Value.(FunctionInterface)
+	if constructor.Type != TypeObject || !ok || !constructorObj.HasConstructMethod() {
+		return NewThrowCompletion(NewTypeError(runtime, "Promise resolver is not a constructor"))
+	}
+
+	capability := &PromiseCapability{
+		Promise: NewUndefinedValue(),
+		Resolve: NewUndefinedValue(),
+		Reject:  NewUndefinedValue(),
+	}
+
+	executorClosure := func(
+		runtime *Runtime,
+		function *FunctionObject,
+		thisArg *JavaScriptValue,
+		arguments []*JavaScriptValue,
+		newTarget *JavaScriptValue,
+	) *Completion {
+		for idx := range 2 {
+			if idx >= len(arguments) {
+				arguments = append(arguments, NewUndefinedValue())
+			}
+		}
+
+		if capability.Resolve.Type != TypeUndefined {
+			return NewThrowCompletion(NewTypeError(runtime, "Promise executor has already been invoked with a resolve function"))
+		}
+
+		if capability.Reject.Type != TypeUndefined {
+			return NewThrowCompletion(NewTypeError(runtime, "Promise executor has already been invoked with a reject function"))
+		}
+
+		capability.Resolve = arguments[0]
+		capability.Reject = arguments[1]
+
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	executor := CreateBuiltinFunction(runtime, executorClosure, 2, NewStringValue(""), nil, nil)
+
+	completion := Construct(runtime, constructorObj, []*JavaScriptValue{NewJavaScriptValue(TypeObject, executor)}, nil)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	if !IsCallable(capability.Resolve) {
+		return NewThrowCompletion(NewTypeError(runtime, "Promise resolve function is not callable"))
+	}
+
+	if !IsCallable(capability.Reject) {
+		return NewThrowCompletion(NewTypeError(runtime, "Promise reject function is not callable"))
+	}
+
+	capability.Promise = completion.Value.(*JavaScriptValue)
+	return NewNormalCompletion(capability)
+}
+
+// NewPromiseCapabilityFromIntrinsic creates a capability for %Promise% of the running realm, which can't fail.
+func NewPromiseCapabilityFromIntrinsic(runtime *Runtime) *PromiseCapability {
+	constructor := runtime.GetRunningRealm().GetIntrinsic(IntrinsicPromiseConstructor)
+	completion := NewPromiseCapability(runtime, NewJavaScriptValue(TypeObject, constructor))
+	if completion.Type != Normal {
+		panic("Assert failed: NewPromiseCapability failed for %Promise%.")
+	}
+
+	return completion.Value.(*PromiseCapability)
+}
+
+// IfAbruptRejectPromise rejects the capability's promise when the completion is abrupt.
+// It returns nil if the completion was normal, so the caller can continue.
+func IfAbruptRejectPromise(runtime *Runtime, completion *Completion, capability *PromiseCapability) *Completion {
+	if completion.Type == Terminate {
+		return completion
+	}
+
+	if completion.Type != Throw {
+		return nil
+	}
+
+	rejectCompletion := Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{completion.Value.(*JavaScriptValue)})
+	if rejectCompletion.Type != Normal {
+		return rejectCompletion
+	}
+
+	return NewNormalCompletion(capability.Promise)
+}
+
+func NewPromiseReactionJob(runtime *Runtime, reaction *PromiseReaction, argument *JavaScriptValue) (JobCallback, *Realm) {
+	job := func(runtime *Runtime) *Completion {
+		var handlerResult *Completion
+		if reaction.Handler == nil {
+			if reaction.Type == PromiseReactionTypeFulfill {
+				handlerResult = NewNormalCompletion(argument)
+			} else {
+				handlerResult = NewThrowCompletion(argument)
+			}
+		} else {
+			handlerResult = Call(runtime, reaction.Handler, NewUndefinedValue(), []*JavaScriptValue{argument})
+		}
+
+		if handlerResult.Type == Terminate {
+			return handlerResult
+		}
+
+		capability := reaction.Capability
+		if capability == nil {
+			if handlerResult.Type == Throw {
+				panic("Assert failed: Promise reaction without a capability completed abruptly.")
+			}
+			return NewNormalCompletion(nil)
+		}
+
+		if handlerResult.Type == Throw {
+			return Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{handlerResult.Value.(*JavaScriptValue)})
+		}
+
+		return Call(runtime, capability.Resolve, NewUndefinedValue(), []*JavaScriptValue{handlerResult.Value.(*JavaScriptValue)})
+	}
+
+	var handlerRealm *Realm
+	if reaction.Handler != nil {
+		completion := GetFunctionRealm(runtime, reaction.Handler.Value.(FunctionInterface))
+		if completion.Type == Normal {
+			handlerRealm = completion.Value.(*Realm)
+		} else {
+			handlerRealm = runtime.GetRunningRealm()
+		}
+	}
+
+	return job, handlerRealm
+}
+
+func NewPromiseResolveThenableJob(
+	runtime *Runtime,
+	promiseToResolve *JavaScriptValue,
+	thenable *JavaScriptValue,
+	then *JavaScriptValue,
+) (JobCallback, *Realm) {
+	job := func(runtime *Runtime) *Completion {
+		resolve, reject := CreateResolvingFunctions(runtime, promiseToResolve.Value.(*Object))
+		rejectVal := NewJavaScriptValue(TypeObject, reject)
+
+		completion := Call(runtime, then, thenable, []*JavaScriptValue{NewJavaScriptValue(TypeObject, resolve), rejectVal})
+		if completion.Type == Terminate {
+			return completion
+		}
+
+		if completion.Type == Throw {
+			return Call(runtime, rejectVal, NewUndefinedValue(), []*JavaScriptValue{completion.Value.(*JavaScriptValue)})
+		}
+
+		return completion
+	}
+
+	var thenRealm *Realm
+	completion := GetFunctionRealm(runtime, then.Value.(FunctionInterface))
+	if completion.Type == Normal {
+		thenRealm = completion.Value.(*Realm)
+	} else {
+		thenRealm = runtime.GetRunningRealm()
+	}
+
+	return job, thenRealm
+}
+
+func PromiseResolve(runtime *Runtime, constructor *JavaScriptValue, value *JavaScriptValue) *Completion {
+	if IsPromise(value) {
+		completion := value.Value.(ObjectInterface).Get(runtime, NewStringValue("constructor"), value)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		valueConstructor := completion.Value.(*JavaScriptValue)
+		if SameValue(valueConstructor, constructor).Value.(*JavaScriptValue).Value.(*Boolean).Value {
+			return NewNormalCompletion(value)
+		}
+	}
+
+	completion := NewPromiseCapability(runtime, constructor)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	capability := completion.Value.(*PromiseCapability)
+	completion = Call(runtime, capability.Resolve, NewUndefinedValue(), []*JavaScriptValue{value})
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return NewNormalCompletion(capability.Promise)
+}
+
+func PerformPromiseThen(
+	runtime *Runtime,
+	promise *Object,
+	onFulfilled *JavaScriptValue,
+	onRejected *JavaScriptValue,
+	resultCapability *PromiseCapability,
+) *JavaScriptValue {
+	var fulfillHandler *JavaScriptValue
+	if IsCallable(onFulfilled) {
+		fulfillHandler = onFulfilled
+	}
+
+	var rejectHandler *JavaScriptValue
+	if IsCallable(onRejected) {
+		rejectHandler = onRejected
+	}
+
+	fulfillReaction := &PromiseReaction{
+		Capability: resultCapability,
+		Type:       PromiseReactionTypeFulfill,
+		Handler:    fulfillHandler,
+	}
+
+	rejectReaction := &PromiseReaction{
+		Capability: resultCapability,
+		Type:       PromiseReactionTypeReject,
+		Handler:    rejectHandler,
+	}
+
+	switch promise.PromiseState {
+	case PromiseStatePending:
+		promise.PromiseFulfillReactions = append(promise.PromiseFulfillReactions, fulfillReaction)
+		promise.PromiseRejectReactions = append(promise.PromiseRejectReactions, rejectReaction)
+	case PromiseStateFulfilled:
+		job, realm := NewPromiseReactionJob(runtime, fulfillReaction, promise.PromiseResult)
+		runtime.HostEnqueuePromiseJob(job, realm)
+	case PromiseStateRejected:
+		job, realm := NewPromiseReactionJob(runtime, rejectReaction, promise.PromiseResult)
+		runtime.HostEnqueuePromiseJob(job, realm)
+	}
+
+	promise.PromiseIsHandled = true
+
+	if resultCapability == nil {
+		return NewUndefinedValue()
+	}
+
+	return resultCapability.Promise
+}
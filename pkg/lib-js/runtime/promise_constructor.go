@@ -0,0 +1,434 @@
+package runtime
+
+func NewPromiseConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		PromiseConstructor,
+		1,
+		NewStringValue("Promise"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// Promise.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicPromisePrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	// Promise.all
+	DefineBuiltinFunction(runtime, constructor, "all", PromiseAll, 1)
+
+	// Promise.allSettled
+	DefineBuiltinFunction(runtime, constructor, "allSettled", PromiseAllSettled, 1)
+
+	// Promise.race
+	DefineBuiltinFunction(runtime, constructor, "race", PromiseRace, 1)
+
+	// Promise.reject
+	DefineBuiltinFunction(runtime, constructor, "reject", PromiseReject, 1)
+
+	// Promise.resolve
+	DefineBuiltinFunction(runtime, constructor, "resolve", PromiseResolveFunction, 1)
+
+	// Promise.withResolvers
+	DefineBuiltinFunction(runtime, constructor, "withResolvers", PromiseWithResolvers, 0)
+
+	// Promise[%Symbol.species%]
+	DefineBuiltinSymbolAccessorFunction(runtime, constructor, runtime.SymbolSpecies, PromiseSpeciesGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	return constructor
+}
+
+func PromiseConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "Promise constructor requires 'new'"))
+	}
+
+	executor := arguments[0]
+	if !IsCallable(executor) {
+		return NewThrowCompletion(NewTypeError(runtime, "Promise resolver is not a function"))
+	}
+
+	completion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicPromisePrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	promiseVal := completion.Value.(*JavaScriptValue)
+	promise := promiseVal.Value.(*Object)
+	promise.IsPromise = true
+	promise.PromiseState = PromiseStatePending
+	promise.PromiseFulfillReactions = make([]*PromiseReaction, 0)
+	promise.PromiseRejectReactions = make([]*PromiseReaction, 0)
+	promise.PromiseIsHandled = false
+
+	resolve, reject := CreateResolvingFunctions(runtime, promise)
+	rejectVal := NewJavaScriptValue(TypeObject, reject)
+
+	completion = Call(runtime, executor, NewUndefinedValue(), []*JavaScriptValue{NewJavaScriptValue(TypeObject, resolve), rejectVal})
+	if completion.Type == Terminate {
+		return completion
+	}
+
+	if completion.Type == Throw {
+		completion = Call(runtime, rejectVal, NewUndefinedValue(), []*JavaScriptValue{completion.Value.(*JavaScriptValue)})
+		if completion.Type != Normal {
+			return completion
+		}
+	}
+
+	return NewNormalCompletion(promiseVal)
+}
+
+func GetPromiseResolve(runtime *Runtime, constructor *JavaScriptValue) *Completion {
+	completion := constructor.Value.(ObjectInterface).Get(runtime, NewStringValue("resolve"), constructor)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	promiseResolve := completion.Value.(*JavaScriptValue)
+	if !IsCallable(promiseResolve) {
+		return NewThrowCompletion(NewTypeError(runtime, "Promise resolve is not a function"))
+	}
+
+	return NewNormalCompletion(promiseResolve)
+}
+
+// promiseCombinatorFunction is the shared body of Promise.all, Promise.allSettled and Promise.race.
+type promiseCombinatorFunction func(
+	runtime *Runtime,
+	iterator *Iterator,
+	constructor *JavaScriptValue,
+	capability *PromiseCapability,
+	promiseResolve *JavaScriptValue,
+) *Completion
+
+func promiseCombinator(runtime *Runtime, thisArg *JavaScriptValue, iterable *JavaScriptValue, perform promiseCombinatorFunction) *Completion {
+	constructor := thisArg
+
+	completion := NewPromiseCapability(runtime, constructor)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	capability := completion.Value.(*PromiseCapability)
+
+	completion = GetPromiseResolve(runtime, constructor)
+	if result := IfAbruptRejectPromise(runtime, completion, capability); result != nil {
+		return result
+	}
+
+	promiseResolve := completion.Value.(*JavaScriptValue)
+
+	completion = GetIterator(runtime, iterable, IteratorKindSync)
+	if result := IfAbruptRejectPromise(runtime, completion, capability); result != nil {
+		return result
+	}
+
+	iterator := completion.Value.(*Iterator)
+
+	completion = perform(runtime, iterator, constructor, capability, promiseResolve)
+	if completion.Type == Throw {
+		if !iterator.Done {
+			completion = IteratorClose(runtime, iterator, completion)
+		}
+
+		if result := IfAbruptRejectPromise(runtime, completion, capability); result != nil {
+			return result
+		}
+	}
+
+	return completion
+}
+
+func PromiseAll(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	return promiseCombinator(runtime, thisArg, arguments[0], PerformPromiseAll)
+}
+
+func PerformPromiseAll(
+	runtime *Runtime,
+	iterator *Iterator,
+	constructor *JavaScriptValue,
+	capability *PromiseCapability,
+	promiseResolve *JavaScriptValue,
+) *Completion {
+	return performPromiseAllOrAllSettled(runtime, iterator, constructor, capability, promiseResolve, false)
+}
+
+func PromiseAllSettled(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	return promiseCombinator(runtime, thisArg, arguments[0], PerformPromiseAllSettled)
+}
+
+func PerformPromiseAllSettled(
+	runtime *Runtime,
+	iterator *Iterator,
+	constructor *JavaScriptValue,
+	capability *PromiseCapability,
+	promiseResolve *JavaScriptValue,
+) *Completion {
+	return performPromiseAllOrAllSettled(runtime, iterator, constructor, capability, promiseResolve, true)
+}
+
+func performPromiseAllOrAllSettled(
+	runtime *Runtime,
+	iterator *Iterator,
+	constructor *JavaScriptValue,
+	capability *PromiseCapability,
+	promiseResolve *JavaScriptValue,
+	settled bool,
+) *Completion {
+	values := make([]*JavaScriptValue, 0)
+	remainingElementsCount := 1
+	index := 0
+
+	resolveValues := func(runtime *Runtime) *Completion {
+		valuesArray := NewJavaScriptValue(TypeObject, CreateArrayFromList(runtime, values))
+		return Call(runtime, capability.Resolve, NewUndefinedValue(), []*JavaScriptValue{valuesArray})
+	}
+
+	// Creates an element function that stores its argument (or a settlement record) at the given index.
+	// For Promise.allSettled, the fulfilled and rejected functions of an element share alreadyCalled.
+	makeElementFunction := func(index int, alreadyCalled *bool, status string, key string) *JavaScriptValue {
+		closure := func(
+			runtime *Runtime,
+			function *FunctionObject,
+			thisArg *JavaScriptValue,
+			arguments []*JavaScriptValue,
+			newTarget *JavaScriptValue,
+		) *Completion {
+			x := NewUndefinedValue()
+			if len(arguments) > 0 {
+				x = arguments[0]
+			}
+
+			if *alreadyCalled {
+				return NewNormalCompletion(NewUndefinedValue())
+			}
+			*alreadyCalled = true
+
+			if settled {
+				object := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+				CreateDataProperty(runtime, object, NewStringValue("status"), NewStringValue(status))
+				CreateDataProperty(runtime, object, NewStringValue(key), x)
+				x = NewJavaScriptValue(TypeObject, object)
+			}
+
+			values[index] = x
+
+			remainingElementsCount--
+			if remainingElementsCount == 0 {
+				return resolveValues(runtime)
+			}
+
+			return NewNormalCompletion(NewUndefinedValue())
+		}
+
+		return NewJavaScriptValue(TypeObject, CreateBuiltinFunction(runtime, closure, 1, NewStringValue(""), nil, nil))
+	}
+
+	for {
+		completion := IteratorStepValue(runtime, iterator)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		if iterator.Done {
+			remainingElementsCount--
+			if remainingElementsCount == 0 {
+				completion = resolveValues(runtime)
+				if completion.Type != Normal {
+					return completion
+				}
+			}
+
+			return NewNormalCompletion(capability.Promise)
+		}
+
+		next := completion.Value.(*JavaScriptValue)
+		values = append(values, NewUndefinedValue())
+
+		completion = Call(runtime, promiseResolve, constructor, []*JavaScriptValue{next})
+		if completion.Type != Normal {
+			return completion
+		}
+
+		nextPromise := completion.Value.(*JavaScriptValue)
+
+		alreadyCalled := false
+		onFulfilled := makeElementFunction(index, &alreadyCalled, "fulfilled", "value")
+		onRejected := capability.Reject
+		if settled {
+			onRejected = makeElementFunction(index, &alreadyCalled, "rejected", "reason")
+		}
+
+		remainingElementsCount++
+
+		completion = Invoke(runtime, nextPromise, NewStringValue("then"), []*JavaScriptValue{onFulfilled, onRejected})
+		if completion.Type != Normal {
+			return completion
+		}
+
+		index++
+	}
+}
+
+func PromiseRace(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	return promiseCombinator(runtime, thisArg, arguments[0], PerformPromiseRace)
+}
+
+func PerformPromiseRace(
+	runtime *Runtime,
+	iterator *Iterator,
+	constructor *JavaScriptValue,
+	capability *PromiseCapability,
+	promiseResolve *JavaScriptValue,
+) *Completion {
+	for {
+		completion := IteratorStepValue(runtime, iterator)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		if iterator.Done {
+			return NewNormalCompletion(capability.Promise)
+		}
+
+		next := completion.Value.(*JavaScriptValue)
+
+		completion = Call(runtime, promiseResolve, constructor, []*JavaScriptValue{next})
+		if completion.Type != Normal {
+			return completion
+		}
+
+		nextPromise := completion.Value.(*JavaScriptValue)
+
+		completion = Invoke(runtime, nextPromise, NewStringValue("then"), []*JavaScriptValue{capability.Resolve, capability.Reject})
+		if completion.Type != Normal {
+			return completion
+		}
+	}
+}
+
+func PromiseReject(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	completion := NewPromiseCapability(runtime, thisArg)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	capability := completion.Value.(*PromiseCapability)
+
+	completion = Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{arguments[0]})
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return NewNormalCompletion(capability.Promise)
+}
+
+func PromiseResolveFunction(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	if thisArg.Type != TypeObject {
+		return NewThrowCompletion(NewTypeError(runtime, "Promise.resolve called on a non-object"))
+	}
+
+	return PromiseResolve(runtime, thisArg, arguments[0])
+}
+
+func PromiseWithResolvers(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	completion := NewPromiseCapability(runtime, thisArg)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	capability := completion.Value.(*PromiseCapability)
+
+	object := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+	CreateDataProperty(runtime, object, NewStringValue("promise"), capability.Promise)
+	CreateDataProperty(runtime, object, NewStringValue("resolve"), capability.Resolve)
+	CreateDataProperty(runtime, object, NewStringValue("reject"), capability.Reject)
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, object))
+}
+
+func PromiseSpeciesGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return NewNormalCompletion(thisArg)
+}
@@ -0,0 +1,170 @@
+package runtime
+
+func NewPromisePrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefinePromisePrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Promise.prototype.catch
+	DefineBuiltinFunction(runtime, prototype, "catch", PromisePrototypeCatch, 1)
+
+	// Promise.prototype.finally
+	DefineBuiltinFunction(runtime, prototype, "finally", PromisePrototypeFinally, 1)
+
+	// Promise.prototype.then
+	DefineBuiltinFunction(runtime, prototype, "then", PromisePrototypeThen, 2)
+
+	// Promise.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("Promise"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func PromisePrototypeCatch(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	return Invoke(runtime, thisArg, NewStringValue("then"), []*JavaScriptValue{NewUndefinedValue(), arguments[0]})
+}
+
+func PromisePrototypeFinally(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	if thisArg.Type != TypeObject {
+		return NewThrowCompletion(NewTypeError(runtime, "Promise.prototype.finally called on a non-object"))
+	}
+
+	onFinally := arguments[0]
+
+	defaultConstructor := runtime.GetRunningRealm().GetIntrinsic(IntrinsicPromiseConstructor).(FunctionInterface)
+	completion := SpeciesConstructor(runtime, thisArg.Value.(ObjectInterface), defaultConstructor)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	constructor := completion.Value.(*JavaScriptValue)
+
+	if !IsCallable(onFinally) {
+		return Invoke(runtime, thisArg, NewStringValue("then"), []*JavaScriptValue{onFinally, onFinally})
+	}
+
+	// Runs onFinally, waits for its result and then continues with the original settlement via next.
+	makeFinallyFunction := func(next NativeFunctionBehaviour) *JavaScriptValue {
+		closure := func(
+			runtime *Runtime,
+			function *FunctionObject,
+			thisArg *JavaScriptValue,
+			arguments []*JavaScriptValue,
+			newTarget *JavaScriptValue,
+		) *Completion {
+			value := NewUndefinedValue()
+			if len(arguments) > 0 {
+				value = arguments[0]
+			}
+
+			completion := Call(runtime, onFinally, NewUndefinedValue(), []*JavaScriptValue{})
+			if completion.Type != Normal {
+				return completion
+			}
+
+			completion = PromiseResolve(runtime, constructor, completion.Value.(*JavaScriptValue))
+			if completion.Type != Normal {
+				return completion
+			}
+
+			promise := completion.Value.(*JavaScriptValue)
+
+			thunkClosure := func(
+				runtime *Runtime,
+				function *FunctionObject,
+				thisArg *JavaScriptValue,
+				arguments []*JavaScriptValue,
+				newTarget *JavaScriptValue,
+			) *Completion {
+				return next(runtime, function, thisArg, []*JavaScriptValue{value}, newTarget)
+			}
+			thunk := CreateBuiltinFunction(runtime, thunkClosure, 0, NewStringValue(""), nil, nil)
+
+			return Invoke(runtime, promise, NewStringValue("then"), []*JavaScriptValue{NewJavaScriptValue(TypeObject, thunk)})
+		}
+
+		return NewJavaScriptValue(TypeObject, CreateBuiltinFunction(runtime, closure, 1, NewStringValue(""), nil, nil))
+	}
+
+	valueThunk := func(
+		runtime *Runtime,
+		function *FunctionObject,
+		thisArg *JavaScriptValue,
+		arguments []*JavaScriptValue,
+		newTarget *JavaScriptValue,
+	) *Completion {
+		return NewNormalCompletion(arguments[0])
+	}
+
+	thrower := func(
+		runtime *Runtime,
+		function *FunctionObject,
+		thisArg *JavaScriptValue,
+		arguments []*JavaScriptValue,
+		newTarget *JavaScriptValue,
+	) *Completion {
+		return NewThrowCompletion(arguments[0])
+	}
+
+	thenFinally := makeFinallyFunction(valueThunk)
+	catchFinally := makeFinallyFunction(thrower)
+
+	return Invoke(runtime, thisArg, NewStringValue("then"), []*JavaScriptValue{thenFinally, catchFinally})
+}
+
+func PromisePrototypeThen(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	if !IsPromise(thisArg) {
+		return NewThrowCompletion(NewTypeError(runtime, "Promise.prototype.then called on incompatible receiver"))
+	}
+
+	promise := thisArg.Value.(*Object)
+
+	defaultConstructor := runtime.GetRunningRealm().GetIntrinsic(IntrinsicPromiseConstructor).(FunctionInterface)
+	completion := SpeciesConstructor(runtime, promise, defaultConstructor)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = NewPromiseCapability(runtime, completion.Value.(*JavaScriptValue))
+	if completion.Type != Normal {
+		return completion
+	}
+
+	capability := completion.Value.(*PromiseCapability)
+	return NewNormalCompletion(PerformPromiseThen(runtime, promise, arguments[0], arguments[1], capability))
+}
@@ -0,0 +1,312 @@
+package runtime
+
+import (
+	"math"
+	"sort"
+	"strconv"
+)
+
+// PropertyStore holds the own properties of an object and remembers the order they were created in,
+// so that OwnPropertyKeys can return keys in the order the spec requires:
+// array indices in ascending order, then strings and then symbols, both in insertion order.
+//
+// Stores start out in shape mode, where the keys are described by a shared Shape and the descriptors
+// live in a slot array. Deleting a property, or adding more than maxShapeProperties of them, moves the
+// store to dictionary mode, which keeps its own maps and is never shared.
+type PropertyStore struct {
+	shape *Shape
+	slots []PropertyDescriptor
+
+	// Dictionary mode, used once shape is nil.
+	properties       map[string]*propertyEntry
+	symbolProperties map[*Symbol]*propertyEntry
+
+	// Entries in insertion order. Deleted entries are left as tombstones until the store is compacted.
+	entries []*propertyEntry
+	deleted int
+
+	// What the runtime charged for the properties of this store's object, see Runtime.SetResourceLimits.
+	account *storeAccount
+}
+
+type propertyEntry struct {
+	name       string
+	symbol     *Symbol
+	arrayIndex int64 // -1 if the key is not an array index.
+	descriptor PropertyDescriptor
+	deleted    bool
+}
+
+func (e *propertyEntry) key() *JavaScriptValue {
+	if e.symbol != nil {
+		return NewJavaScriptValue(TypeSymbol, e.symbol)
+	}
+	return NewStringValue(e.name)
+}
+
+func NewPropertyStore() *PropertyStore {
+	return &PropertyStore{
+		shape: emptyShape,
+	}
+}
+
+// Shape returns the layout of the store, or nil if it is in dictionary mode.
+func (s *PropertyStore) Shape() *Shape {
+	return s.shape
+}
+
+// Slot returns the descriptor held in a slot of a store in shape mode.
+func (s *PropertyStore) Slot(slot int) PropertyDescriptor {
+	return s.slots[slot]
+}
+
+// SetSlot replaces the descriptor held in a slot of a store in shape mode.
+func (s *PropertyStore) SetSlot(slot int, descriptor PropertyDescriptor) {
+	s.slots[slot] = descriptor
+}
+
+// toDictionary moves the store out of shape mode, keeping the existing insertion order.
+func (s *PropertyStore) toDictionary() {
+	s.properties = make(map[string]*propertyEntry, s.shape.Len())
+	s.symbolProperties = make(map[*Symbol]*propertyEntry)
+	s.entries = make([]*propertyEntry, 0, s.shape.Len())
+
+	for _, shape := range s.shape.keysInInsertionOrder() {
+		entry := &propertyEntry{
+			name:       shape.key.name,
+			symbol:     shape.key.symbol,
+			arrayIndex: -1,
+			descriptor: s.slots[shape.slot],
+		}
+
+		if entry.symbol != nil {
+			s.symbolProperties[entry.symbol] = entry
+		} else {
+			entry.arrayIndex, _ = ArrayIndex(entry.name)
+			s.properties[entry.name] = entry
+		}
+		s.entries = append(s.entries, entry)
+	}
+
+	s.shape = nil
+	s.slots = nil
+}
+
+func (s *PropertyStore) getFromShape(key shapeKey) (PropertyDescriptor, bool) {
+	slot, ok := s.shape.Lookup(key)
+	if !ok {
+		return nil, false
+	}
+	return s.slots[slot], true
+}
+
+// setInShape returns false if the store had to move to dictionary mode instead.
+func (s *PropertyStore) setInShape(key shapeKey, descriptor PropertyDescriptor) bool {
+	if slot, ok := s.shape.Lookup(key); ok {
+		s.slots[slot] = descriptor
+		return true
+	}
+
+	if s.shape.Len() >= maxShapeProperties {
+		s.toDictionary()
+		return false
+	}
+
+	s.shape = s.shape.Transition(key)
+	s.slots = append(s.slots, descriptor)
+	return true
+}
+
+// ArrayIndex returns the numeric value of key if it is an array index (a canonical numeric string below 2^32 - 1).
+func ArrayIndex(key string) (int64, bool) {
+	index, err := strconv.ParseUint(key, 10, 32)
+	if err != nil || index == math.MaxUint32 || strconv.FormatUint(index, 10) != key {
+		return -1, false
+	}
+	return int64(index), true
+}
+
+func (s *PropertyStore) Get(key *JavaScriptValue) (PropertyDescriptor, bool) {
+	if s.shape != nil {
+		return s.getFromShape(newShapeKey(key))
+	}
+
+	if key.Type == TypeSymbol {
+		entry, ok := s.symbolProperties[key.Value.(*Symbol)]
+		if !ok {
+			return nil, false
+		}
+		return entry.descriptor, true
+	}
+
+	if key.Type != TypeString {
+		panic("Assert failed: PropertyStore.Get key is not a string.")
+	}
+
+	return s.GetString(key.Value.(*String).Value)
+}
+
+func (s *PropertyStore) GetString(name string) (PropertyDescriptor, bool) {
+	if s.shape != nil {
+		return s.getFromShape(shapeKey{name: name})
+	}
+
+	entry, ok := s.properties[name]
+	if !ok {
+		return nil, false
+	}
+	return entry.descriptor, true
+}
+
+// Set updates the property in place if it exists, otherwise it is added after all existing properties.
+func (s *PropertyStore) Set(key *JavaScriptValue, descriptor PropertyDescriptor) {
+	if s.shape != nil && s.setInShape(newShapeKey(key), descriptor) {
+		return
+	}
+
+	if key.Type == TypeSymbol {
+		symbol := key.Value.(*Symbol)
+		if entry, ok := s.symbolProperties[symbol]; ok {
+			entry.descriptor = descriptor
+			return
+		}
+
+		entry := &propertyEntry{symbol: symbol, arrayIndex: -1, descriptor: descriptor}
+		s.symbolProperties[symbol] = entry
+		s.entries = append(s.entries, entry)
+		return
+	}
+
+	if key.Type != TypeString {
+		panic("Assert failed: PropertyStore.Set key is not a string.")
+	}
+
+	s.SetString(key.Value.(*String).Value, descriptor)
+}
+
+func (s *PropertyStore) SetString(name string, descriptor PropertyDescriptor) {
+	if s.shape != nil && s.setInShape(shapeKey{name: name}, descriptor) {
+		return
+	}
+
+	if entry, ok := s.properties[name]; ok {
+		entry.descriptor = descriptor
+		return
+	}
+
+	arrayIndex, _ := ArrayIndex(name)
+	entry := &propertyEntry{name: name, arrayIndex: arrayIndex, descriptor: descriptor}
+	s.properties[name] = entry
+	s.entries = append(s.entries, entry)
+}
+
+func (s *PropertyStore) Delete(key *JavaScriptValue) {
+	if s.shape != nil {
+		if _, ok := s.shape.Lookup(newShapeKey(key)); !ok {
+			return
+		}
+		s.toDictionary()
+	}
+
+	var entry *propertyEntry
+	var ok bool
+
+	if key.Type == TypeSymbol {
+		symbol := key.Value.(*Symbol)
+		if entry, ok = s.symbolProperties[symbol]; ok {
+			delete(s.symbolProperties, symbol)
+		}
+	} else if key.Type == TypeString {
+		name := key.Value.(*String).Value
+		if entry, ok = s.properties[name]; ok {
+			delete(s.properties, name)
+		}
+	} else {
+		panic("Assert failed: PropertyStore.Delete key is not a string.")
+	}
+
+	if !ok {
+		return
+	}
+
+	entry.deleted = true
+	s.deleted++
+
+	// Compact the entries once most of them are tombstones.
+	if s.deleted > 16 && s.deleted > len(s.entries)/2 {
+		entries := make([]*propertyEntry, 0, len(s.entries)-s.deleted)
+		for _, entry := range s.entries {
+			if !entry.deleted {
+				entries = append(entries, entry)
+			}
+		}
+		s.entries = entries
+		s.deleted = 0
+	}
+}
+
+func (s *PropertyStore) Len() int {
+	if s.shape != nil {
+		return s.shape.Len()
+	}
+	return len(s.properties) + len(s.symbolProperties)
+}
+
+// Keys returns the keys of the properties in the order defined by OrdinaryOwnPropertyKeys.
+func (s *PropertyStore) Keys() []*JavaScriptValue {
+	if s.shape != nil {
+		ordered := s.shape.ordered()
+		keys := make([]*JavaScriptValue, len(ordered))
+		for idx, shape := range ordered {
+			keys[idx] = shape.key.value()
+		}
+		return keys
+	}
+
+	ordered := s.orderedEntries()
+	keys := make([]*JavaScriptValue, len(ordered))
+	for idx, entry := range ordered {
+		keys[idx] = entry.key()
+	}
+	return keys
+}
+
+// ForEach calls fn for each property in the order defined by OrdinaryOwnPropertyKeys.
+func (s *PropertyStore) ForEach(fn func(key *JavaScriptValue, descriptor PropertyDescriptor)) {
+	if s.shape != nil {
+		for _, shape := range s.shape.ordered() {
+			fn(shape.key.value(), s.slots[shape.slot])
+		}
+		return
+	}
+
+	for _, entry := range s.orderedEntries() {
+		fn(entry.key(), entry.descriptor)
+	}
+}
+
+func (s *PropertyStore) orderedEntries() []*propertyEntry {
+	indices := make([]*propertyEntry, 0)
+	strings := make([]*propertyEntry, 0, len(s.properties))
+	symbols := make([]*propertyEntry, 0, len(s.symbolProperties))
+
+	for _, entry := range s.entries {
+		switch {
+		case entry.deleted:
+			continue
+		case entry.symbol != nil:
+			symbols = append(symbols, entry)
+		case entry.arrayIndex >= 0:
+			indices = append(indices, entry)
+		default:
+			strings = append(strings, entry)
+		}
+	}
+
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i].arrayIndex < indices[j].arrayIndex
+	})
+
+	ordered := append(indices, strings...)
+	return append(ordered, symbols...)
+}
@@ -46,10 +46,33 @@ func DefineBuiltinAccessorFunction(
 	descriptor *AccessorPropertyDescriptor,
 ) {
 	functionName := NewStringValue(name)
-	getFunctionObject := CreateBuiltinFunction(runtime, getBehaviour, 0, functionName, nil, nil)
-	setFunctionObject := CreateBuiltinFunction(runtime, setBehaviour, 1, functionName, nil, nil)
+	if getBehaviour != nil {
+		descriptor.Get = CreateBuiltinFunction(runtime, getBehaviour, 0, functionName, nil, nil)
+	}
+
+	// Accessors without a setter must leave [[Set]] undefined, so assignments are ignored instead of calling a nil behaviour.
+	if setBehaviour != nil {
+		descriptor.Set = CreateBuiltinFunction(runtime, setBehaviour, 1, functionName, nil, nil)
+	}
 
-	descriptor.Get = getFunctionObject
-	descriptor.Set = setFunctionObject
 	obj.DefineOwnProperty(runtime, functionName, descriptor)
 }
+
+func DefineBuiltinSymbolAccessorFunction(
+	runtime *Runtime,
+	obj ObjectInterface,
+	name *JavaScriptValue,
+	getBehaviour NativeFunctionBehaviour,
+	setBehaviour NativeFunctionBehaviour,
+	descriptor *AccessorPropertyDescriptor,
+) {
+	if getBehaviour != nil {
+		descriptor.Get = CreateBuiltinFunction(runtime, getBehaviour, 0, name, nil, nil)
+	}
+
+	if setBehaviour != nil {
+		descriptor.Set = CreateBuiltinFunction(runtime, setBehaviour, 1, name, nil, nil)
+	}
+
+	obj.DefineOwnProperty(runtime, name, descriptor)
+}
@@ -62,22 +62,11 @@ func (o *ProxyObject) SetPrototype(prototype ObjectInterface) {
 	panic("Assert failed: ProxyObject.SetPrototype called.")
 }
 
-func (o *ProxyObject) GetProperties() map[string]PropertyDescriptor {
+// Proxies have no properties of their own, every property operation is forwarded to the handler or target.
+func (o *ProxyObject) GetProperties() *PropertyStore {
 	panic("Assert failed: ProxyObject.GetProperties called.")
 }
 
-func (o *ProxyObject) SetProperties(properties map[string]PropertyDescriptor) {
-	panic("Assert failed: ProxyObject.SetProperties called.")
-}
-
-func (o *ProxyObject) GetSymbolProperties() map[*Symbol]PropertyDescriptor {
-	panic("Assert failed: ProxyObject.GetSymbolProperties called.")
-}
-
-func (o *ProxyObject) SetSymbolProperties(symbolProperties map[*Symbol]PropertyDescriptor) {
-	panic("Assert failed: ProxyObject.SetSymbolProperties called.")
-}
-
 func (o *ProxyObject) IsExtensible(runtime *Runtime) *Completion {
 	completion := ValidateNonRevokedProxy(runtime, o)
 	if completion.Type != Normal {
@@ -1,77 +1,137 @@
 package runtime
 
-import "math"
+import (
+	"math"
+	"slices"
+)
 
 type Intrinsic string
 
 const (
-	IntrinsicObjectConstructor            Intrinsic = "Object"
-	IntrinsicFunctionConstructor          Intrinsic = "Function"
-	IntrinsicArrayConstructor             Intrinsic = "Array"
-	IntrinsicStringConstructor            Intrinsic = "String"
-	IntrinsicNumberConstructor            Intrinsic = "Number"
-	IntrinsicBigIntConstructor            Intrinsic = "BigInt"
-	IntrinsicBooleanConstructor           Intrinsic = "Boolean"
-	IntrinsicErrorConstructor             Intrinsic = "Error"
-	IntrinsicSymbolConstructor            Intrinsic = "Symbol"
-	IntrinsicEvalErrorConstructor         Intrinsic = "EvalError"
-	IntrinsicRangeErrorConstructor        Intrinsic = "RangeError"
-	IntrinsicReferenceErrorConstructor    Intrinsic = "ReferenceError"
-	IntrinsicSyntaxErrorConstructor       Intrinsic = "SyntaxError"
-	IntrinsicTypeErrorConstructor         Intrinsic = "TypeError"
-	IntrinsicURIErrorConstructor          Intrinsic = "URIError"
-	IntrinsicMathObject                   Intrinsic = "Math"
-	IntrinsicArrayBufferConstructor       Intrinsic = "ArrayBuffer"
-	IntrinsicInt8ArrayConstructor         Intrinsic = "Int8Array"
-	IntrinsicUint8ArrayConstructor        Intrinsic = "Uint8Array"
-	IntrinsicUint8ClampedArrayConstructor Intrinsic = "Uint8ClampedArray"
-	IntrinsicInt16ArrayConstructor        Intrinsic = "Int16Array"
-	IntrinsicUint16ArrayConstructor       Intrinsic = "Uint16Array"
-	IntrinsicInt32ArrayConstructor        Intrinsic = "Int32Array"
-	IntrinsicUint32ArrayConstructor       Intrinsic = "Uint32Array"
-	IntrinsicBigInt64ArrayConstructor     Intrinsic = "BigInt64Array"
-	IntrinsicBigUint64ArrayConstructor    Intrinsic = "BigUint64Array"
-	IntrinsicFloat16ArrayConstructor      Intrinsic = "Float16Array"
-	IntrinsicFloat32ArrayConstructor      Intrinsic = "Float32Array"
-	IntrinsicFloat64ArrayConstructor      Intrinsic = "Float64Array"
-	IntrinsicProxyConstructor             Intrinsic = "Proxy"
-	IntrinsicObjectPrototype              Intrinsic = "Object.prototype"
-	IntrinsicArrayPrototype               Intrinsic = "Array.prototype"
-	IntrinsicFunctionPrototype            Intrinsic = "Function.prototype"
-	IntrinsicIteratorPrototype            Intrinsic = "Iterator.prototype"
-	IntrinsicArrayIteratorPrototype       Intrinsic = "ArrayIterator.prototype"
-	IntrinsicStringPrototype              Intrinsic = "String.prototype"
-	IntrinsicNumberPrototype              Intrinsic = "Number.prototype"
-	IntrinsicBigIntPrototype              Intrinsic = "BigInt.prototype"
-	IntrinsicBooleanPrototype             Intrinsic = "Boolean.prototype"
-	IntrinsicErrorPrototype               Intrinsic = "Error.prototype"
-	IntrinsicEvalErrorPrototype           Intrinsic = "EvalError.prototype"
-	IntrinsicRangeErrorPrototype          Intrinsic = "RangeError.prototype"
-	IntrinsicReferenceErrorPrototype      Intrinsic = "ReferenceError.prototype"
-	IntrinsicSyntaxErrorPrototype         Intrinsic = "SyntaxError.prototype"
-	IntrinsicTypeErrorPrototype           Intrinsic = "TypeError.prototype"
-	IntrinsicURIErrorPrototype            Intrinsic = "URIError.prototype"
-	IntrinsicArrayBufferPrototype         Intrinsic = "ArrayBuffer.prototype"
-	IntrinsicTypedArrayPrototype          Intrinsic = "TypedArray.prototype"
-	IntrinsicInt8ArrayPrototype           Intrinsic = "Int8Array.prototype"
-	IntrinsicUint8ArrayPrototype          Intrinsic = "Uint8Array.prototype"
-	IntrinsicUint8ClampedArrayPrototype   Intrinsic = "Uint8ClampedArray.prototype"
-	IntrinsicInt16ArrayPrototype          Intrinsic = "Int16Array.prototype"
-	IntrinsicUint16ArrayPrototype         Intrinsic = "Uint16Array.prototype"
-	IntrinsicInt32ArrayPrototype          Intrinsic = "Int32Array.prototype"
-	IntrinsicUint32ArrayPrototype         Intrinsic = "Uint32Array.prototype"
-	IntrinsicBigInt64ArrayPrototype       Intrinsic = "BigInt64Array.prototype"
-	IntrinsicBigUint64ArrayPrototype      Intrinsic = "BigUint64Array.prototype"
-	IntrinsicFloat16ArrayPrototype        Intrinsic = "Float16Array.prototype"
-	IntrinsicFloat32ArrayPrototype        Intrinsic = "Float32Array.prototype"
-	IntrinsicFloat64ArrayPrototype        Intrinsic = "Float64Array.prototype"
-	IntrinsicParseIntFunction             Intrinsic = "parseInt"
+	IntrinsicObjectConstructor                 Intrinsic = "Object"
+	IntrinsicFunctionConstructor               Intrinsic = "Function"
+	IntrinsicArrayConstructor                  Intrinsic = "Array"
+	IntrinsicStringConstructor                 Intrinsic = "String"
+	IntrinsicNumberConstructor                 Intrinsic = "Number"
+	IntrinsicBigIntConstructor                 Intrinsic = "BigInt"
+	IntrinsicBooleanConstructor                Intrinsic = "Boolean"
+	IntrinsicErrorConstructor                  Intrinsic = "Error"
+	IntrinsicSymbolConstructor                 Intrinsic = "Symbol"
+	IntrinsicEvalErrorConstructor              Intrinsic = "EvalError"
+	IntrinsicRangeErrorConstructor             Intrinsic = "RangeError"
+	IntrinsicReferenceErrorConstructor         Intrinsic = "ReferenceError"
+	IntrinsicSyntaxErrorConstructor            Intrinsic = "SyntaxError"
+	IntrinsicTypeErrorConstructor              Intrinsic = "TypeError"
+	IntrinsicURIErrorConstructor               Intrinsic = "URIError"
+	IntrinsicAggregateErrorConstructor         Intrinsic = "AggregateError"
+	IntrinsicSuppressedErrorConstructor        Intrinsic = "SuppressedError"
+	IntrinsicMathObject                        Intrinsic = "Math"
+	IntrinsicArrayBufferConstructor            Intrinsic = "ArrayBuffer"
+	IntrinsicInt8ArrayConstructor              Intrinsic = "Int8Array"
+	IntrinsicUint8ArrayConstructor             Intrinsic = "Uint8Array"
+	IntrinsicUint8ClampedArrayConstructor      Intrinsic = "Uint8ClampedArray"
+	IntrinsicInt16ArrayConstructor             Intrinsic = "Int16Array"
+	IntrinsicUint16ArrayConstructor            Intrinsic = "Uint16Array"
+	IntrinsicInt32ArrayConstructor             Intrinsic = "Int32Array"
+	IntrinsicUint32ArrayConstructor            Intrinsic = "Uint32Array"
+	IntrinsicBigInt64ArrayConstructor          Intrinsic = "BigInt64Array"
+	IntrinsicBigUint64ArrayConstructor         Intrinsic = "BigUint64Array"
+	IntrinsicFloat16ArrayConstructor           Intrinsic = "Float16Array"
+	IntrinsicFloat32ArrayConstructor           Intrinsic = "Float32Array"
+	IntrinsicFloat64ArrayConstructor           Intrinsic = "Float64Array"
+	IntrinsicProxyConstructor                  Intrinsic = "Proxy"
+	IntrinsicDataViewConstructor               Intrinsic = "DataView"
+	IntrinsicSharedArrayBufferConstructor      Intrinsic = "SharedArrayBuffer"
+	IntrinsicPromiseConstructor                Intrinsic = "Promise"
+	IntrinsicIteratorConstructor               Intrinsic = "Iterator"
+	IntrinsicWeakRefConstructor                Intrinsic = "WeakRef"
+	IntrinsicFinalizationRegistryConstructor   Intrinsic = "FinalizationRegistry"
+	IntrinsicShadowRealmConstructor            Intrinsic = "ShadowRealm"
+	IntrinsicTextEncoderConstructor            Intrinsic = "TextEncoder"
+	IntrinsicTextDecoderConstructor            Intrinsic = "TextDecoder"
+	IntrinsicURLConstructor                    Intrinsic = "URL"
+	IntrinsicURLSearchParamsConstructor        Intrinsic = "URLSearchParams"
+	IntrinsicHeadersConstructor                Intrinsic = "Headers"
+	IntrinsicRequestConstructor                Intrinsic = "Request"
+	IntrinsicResponseConstructor               Intrinsic = "Response"
+	IntrinsicAbortControllerConstructor        Intrinsic = "AbortController"
+	IntrinsicAbortSignalConstructor            Intrinsic = "AbortSignal"
+	IntrinsicAtomicsObject                     Intrinsic = "Atomics"
+	IntrinsicIntlObject                        Intrinsic = "Intl"
+	IntrinsicIntlCollatorConstructor           Intrinsic = "Intl.Collator"
+	IntrinsicIntlDateTimeFormatConstructor     Intrinsic = "Intl.DateTimeFormat"
+	IntrinsicIntlListFormatConstructor         Intrinsic = "Intl.ListFormat"
+	IntrinsicIntlNumberFormatConstructor       Intrinsic = "Intl.NumberFormat"
+	IntrinsicIntlPluralRulesConstructor        Intrinsic = "Intl.PluralRules"
+	IntrinsicIntlRelativeTimeFormatConstructor Intrinsic = "Intl.RelativeTimeFormat"
+	IntrinsicObjectPrototype                   Intrinsic = "Object.prototype"
+	IntrinsicArrayPrototype                    Intrinsic = "Array.prototype"
+	IntrinsicFunctionPrototype                 Intrinsic = "Function.prototype"
+	IntrinsicIteratorPrototype                 Intrinsic = "Iterator.prototype"
+	IntrinsicArrayIteratorPrototype            Intrinsic = "ArrayIterator.prototype"
+	IntrinsicStringPrototype                   Intrinsic = "String.prototype"
+	IntrinsicNumberPrototype                   Intrinsic = "Number.prototype"
+	IntrinsicBigIntPrototype                   Intrinsic = "BigInt.prototype"
+	IntrinsicBooleanPrototype                  Intrinsic = "Boolean.prototype"
+	IntrinsicErrorPrototype                    Intrinsic = "Error.prototype"
+	IntrinsicEvalErrorPrototype                Intrinsic = "EvalError.prototype"
+	IntrinsicRangeErrorPrototype               Intrinsic = "RangeError.prototype"
+	IntrinsicReferenceErrorPrototype           Intrinsic = "ReferenceError.prototype"
+	IntrinsicSyntaxErrorPrototype              Intrinsic = "SyntaxError.prototype"
+	IntrinsicTypeErrorPrototype                Intrinsic = "TypeError.prototype"
+	IntrinsicURIErrorPrototype                 Intrinsic = "URIError.prototype"
+	IntrinsicAggregateErrorPrototype           Intrinsic = "AggregateError.prototype"
+	IntrinsicSuppressedErrorPrototype          Intrinsic = "SuppressedError.prototype"
+	IntrinsicArrayBufferPrototype              Intrinsic = "ArrayBuffer.prototype"
+	IntrinsicTypedArrayPrototype               Intrinsic = "TypedArray.prototype"
+	IntrinsicInt8ArrayPrototype                Intrinsic = "Int8Array.prototype"
+	IntrinsicUint8ArrayPrototype               Intrinsic = "Uint8Array.prototype"
+	IntrinsicUint8ClampedArrayPrototype        Intrinsic = "Uint8ClampedArray.prototype"
+	IntrinsicInt16ArrayPrototype               Intrinsic = "Int16Array.prototype"
+	IntrinsicUint16ArrayPrototype              Intrinsic = "Uint16Array.prototype"
+	IntrinsicInt32ArrayPrototype               Intrinsic = "Int32Array.prototype"
+	IntrinsicUint32ArrayPrototype              Intrinsic = "Uint32Array.prototype"
+	IntrinsicBigInt64ArrayPrototype            Intrinsic = "BigInt64Array.prototype"
+	IntrinsicBigUint64ArrayPrototype           Intrinsic = "BigUint64Array.prototype"
+	IntrinsicFloat16ArrayPrototype             Intrinsic = "Float16Array.prototype"
+	IntrinsicFloat32ArrayPrototype             Intrinsic = "Float32Array.prototype"
+	IntrinsicFloat64ArrayPrototype             Intrinsic = "Float64Array.prototype"
+	IntrinsicDataViewPrototype                 Intrinsic = "DataView.prototype"
+	IntrinsicSharedArrayBufferPrototype        Intrinsic = "SharedArrayBuffer.prototype"
+	IntrinsicPromisePrototype                  Intrinsic = "Promise.prototype"
+	IntrinsicSymbolPrototype                   Intrinsic = "Symbol.prototype"
+	IntrinsicWeakRefPrototype                  Intrinsic = "WeakRef.prototype"
+	IntrinsicFinalizationRegistryPrototype     Intrinsic = "FinalizationRegistry.prototype"
+	IntrinsicShadowRealmPrototype              Intrinsic = "ShadowRealm.prototype"
+	IntrinsicTextEncoderPrototype              Intrinsic = "TextEncoder.prototype"
+	IntrinsicTextDecoderPrototype              Intrinsic = "TextDecoder.prototype"
+	IntrinsicURLPrototype                      Intrinsic = "URL.prototype"
+	IntrinsicURLSearchParamsPrototype          Intrinsic = "URLSearchParams.prototype"
+	IntrinsicURLSearchParamsIteratorPrototype  Intrinsic = "URLSearchParamsIterator.prototype"
+	IntrinsicHeadersPrototype                  Intrinsic = "Headers.prototype"
+	IntrinsicHeadersIteratorPrototype          Intrinsic = "HeadersIterator.prototype"
+	IntrinsicRequestPrototype                  Intrinsic = "Request.prototype"
+	IntrinsicResponsePrototype                 Intrinsic = "Response.prototype"
+	IntrinsicAbortControllerPrototype          Intrinsic = "AbortController.prototype"
+	IntrinsicAbortSignalPrototype              Intrinsic = "AbortSignal.prototype"
+	IntrinsicIntlCollatorPrototype             Intrinsic = "Intl.Collator.prototype"
+	IntrinsicIntlDateTimeFormatPrototype       Intrinsic = "Intl.DateTimeFormat.prototype"
+	IntrinsicIntlListFormatPrototype           Intrinsic = "Intl.ListFormat.prototype"
+	IntrinsicIntlNumberFormatPrototype         Intrinsic = "Intl.NumberFormat.prototype"
+	IntrinsicIntlPluralRulesPrototype          Intrinsic = "Intl.PluralRules.prototype"
+	IntrinsicIntlRelativeTimeFormatPrototype   Intrinsic = "Intl.RelativeTimeFormat.prototype"
+	IntrinsicIteratorHelperPrototype           Intrinsic = "IteratorHelper.prototype"
+	IntrinsicWrapForValidIteratorPrototype     Intrinsic = "WrapForValidIterator.prototype"
+	IntrinsicParseIntFunction                  Intrinsic = "parseInt"
 )
 
 type Realm struct {
 	GlobalEnv    *GlobalEnvironment
 	GlobalObject ObjectInterface
 	Intrinsics   map[Intrinsic]ObjectInterface
+
+	// The options the realm was created with. The ShadowRealms its scripts create can't have globals they exclude.
+	Options RealmOptions
 	// TODO: Other properties.
 }
 
@@ -243,6 +303,22 @@ func NewRealm(runtime *Runtime) *Realm {
 		Enumerable:   false,
 	})
 
+	// "AggregateError" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("AggregateError"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicAggregateErrorConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "SuppressedError" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("SuppressedError"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicSuppressedErrorConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
 	// "Infinity" property.
 	globalObject.DefineOwnProperty(runtime, NewStringValue("Infinity"), &DataPropertyDescriptor{
 		Value:        NewNumberValue(math.Inf(1), false),
@@ -259,6 +335,91 @@ func NewRealm(runtime *Runtime) *Realm {
 		Enumerable:   false,
 	})
 
+	// Timers run by the event loop.
+	DefineTimerFunctions(runtime, globalObject)
+
+	// structuredClone(value, options)
+	DefineBuiltinFunction(runtime, globalObject, "structuredClone", StructuredClone, 1)
+
+	// "TextEncoder" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("TextEncoder"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicTextEncoderConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "TextDecoder" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("TextDecoder"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicTextDecoderConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// atob(data) and btoa(data)
+	DefineBuiltinFunction(runtime, globalObject, "atob", Atob, 1)
+	DefineBuiltinFunction(runtime, globalObject, "btoa", Btoa, 1)
+
+	// "URL" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("URL"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicURLConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "URLSearchParams" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("URLSearchParams"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicURLSearchParamsConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// fetch(input, init)
+	DefineBuiltinFunction(runtime, globalObject, "fetch", Fetch, 1)
+
+	// "Headers" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("Headers"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicHeadersConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "Request" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("Request"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicRequestConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "Response" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("Response"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicResponseConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "AbortController" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("AbortController"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicAbortControllerConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "AbortSignal" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("AbortSignal"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicAbortSignalConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
 	// "Math" property.
 	globalObject.DefineOwnProperty(runtime, NewStringValue("Math"), &DataPropertyDescriptor{
 		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicMathObject)),
@@ -371,6 +532,78 @@ func NewRealm(runtime *Runtime) *Realm {
 		Enumerable:   false,
 	})
 
+	// "DataView" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("DataView"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicDataViewConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "SharedArrayBuffer" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("SharedArrayBuffer"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicSharedArrayBufferConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "Intl" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("Intl"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicIntlObject)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "Atomics" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("Atomics"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicAtomicsObject)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "Promise" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("Promise"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicPromiseConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "Iterator" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("Iterator"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicIteratorConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "WeakRef" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("WeakRef"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicWeakRefConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "FinalizationRegistry" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("FinalizationRegistry"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicFinalizationRegistryConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
+	// "ShadowRealm" property.
+	globalObject.DefineOwnProperty(runtime, NewStringValue("ShadowRealm"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicShadowRealmConstructor)),
+		Writable:     true,
+		Configurable: true,
+		Enumerable:   false,
+	})
+
 	// "Proxy" property.
 	globalObject.DefineOwnProperty(runtime, NewStringValue("Proxy"), &DataPropertyDescriptor{
 		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicProxyConstructor)),
@@ -382,6 +615,74 @@ func NewRealm(runtime *Runtime) *Realm {
 	return realm
 }
 
+// RealmOptions selects the global properties of a realm created by Runtime.NewRealm, e.g. to build a sandbox without
+// timers or SharedArrayBuffer. The intrinsics stay reachable through the globals that are installed, e.g.
+// Object.getPrototypeOf(function () {}) is still %Function.prototype% when "Function" isn't installed.
+type RealmOptions struct {
+	// The global properties to install, or nil to install all of them. undefined, NaN and Infinity are always
+	// installed.
+	Globals []string
+
+	// Global properties not to install, even if Globals lists them.
+	ExcludeGlobals []string
+}
+
+// within returns the options for a realm created inside a realm with the outer options, which only has the globals
+// both allow.
+func (o RealmOptions) within(outer RealmOptions) RealmOptions {
+	globals := o.Globals
+	switch {
+	case globals == nil:
+		globals = outer.Globals
+	case outer.Globals != nil:
+		globals = slices.DeleteFunc(slices.Clone(globals), func(name string) bool {
+			return !slices.Contains(outer.Globals, name)
+		})
+	}
+
+	return RealmOptions{
+		Globals:        globals,
+		ExcludeGlobals: slices.Concat(o.ExcludeGlobals, outer.ExcludeGlobals),
+	}
+}
+
+// NewRealm creates a realm whose global object only has the globals options selects. Unlike the NewRealm function, it
+// doesn't leave an execution context for the realm on the stack: scripts parsed for the realm run in it, and so does
+// code called through its objects, so a runtime can host several realms at once.
+func (r *Runtime) NewRealm(options RealmOptions) *Realm {
+	realm := NewRealm(r)
+	realm.Options = options
+	r.PopExecutionContext()
+
+	if options.Globals == nil && len(options.ExcludeGlobals) == 0 {
+		return realm
+	}
+
+	installed := func(name string) bool {
+		switch name {
+		case "undefined", "NaN", "Infinity":
+			return true
+		}
+		if slices.Contains(options.ExcludeGlobals, name) {
+			return false
+		}
+		return options.Globals == nil || slices.Contains(options.Globals, name)
+	}
+
+	globalObject := realm.GlobalObject
+	for _, key := range OrdinaryOwnPropertyKeys(globalObject) {
+		if key.Type == TypeString && !installed(key.Value.(*String).Value) {
+			// Delete even the globals that aren't configurable, such as the error constructors.
+			DeletePropertyFromObject(globalObject, key)
+			if r.resources != nil {
+				r.chargeProperties(globalObject, -1)
+			}
+		}
+	}
+
+	return realm
+}
+
 func (r *Realm) GetIntrinsic(intrinsic Intrinsic) ObjectInterface {
 	if intrinsic, ok := r.Intrinsics[intrinsic]; ok {
 		return intrinsic
@@ -408,6 +709,8 @@ func (r *Realm) CreateIntrinsics(runtime *Runtime) {
 	r.Intrinsics[IntrinsicSyntaxErrorPrototype] = NewNativeErrorPrototype(runtime)
 	r.Intrinsics[IntrinsicTypeErrorPrototype] = NewNativeErrorPrototype(runtime)
 	r.Intrinsics[IntrinsicURIErrorPrototype] = NewNativeErrorPrototype(runtime)
+	r.Intrinsics[IntrinsicAggregateErrorPrototype] = NewAggregateErrorPrototype(runtime)
+	r.Intrinsics[IntrinsicSuppressedErrorPrototype] = NewSuppressedErrorPrototype(runtime)
 	r.Intrinsics[IntrinsicArrayBufferPrototype] = NewArrayBufferPrototype(runtime)
 	r.Intrinsics[IntrinsicTypedArrayPrototype] = NewTypedArrayPrototype(runtime)
 	r.Intrinsics[IntrinsicInt8ArrayPrototype] = NewConcreteTypedArrayPrototype(runtime, TypedArrayNameInt8)
@@ -422,6 +725,32 @@ func (r *Realm) CreateIntrinsics(runtime *Runtime) {
 	r.Intrinsics[IntrinsicFloat16ArrayPrototype] = NewConcreteTypedArrayPrototype(runtime, TypedArrayNameFloat16)
 	r.Intrinsics[IntrinsicFloat32ArrayPrototype] = NewConcreteTypedArrayPrototype(runtime, TypedArrayNameFloat32)
 	r.Intrinsics[IntrinsicFloat64ArrayPrototype] = NewConcreteTypedArrayPrototype(runtime, TypedArrayNameFloat64)
+	r.Intrinsics[IntrinsicDataViewPrototype] = NewDataViewPrototype(runtime)
+	r.Intrinsics[IntrinsicSharedArrayBufferPrototype] = NewSharedArrayBufferPrototype(runtime)
+	r.Intrinsics[IntrinsicPromisePrototype] = NewPromisePrototype(runtime)
+	r.Intrinsics[IntrinsicSymbolPrototype] = NewSymbolPrototype(runtime)
+	r.Intrinsics[IntrinsicWeakRefPrototype] = NewWeakRefPrototype(runtime)
+	r.Intrinsics[IntrinsicFinalizationRegistryPrototype] = NewFinalizationRegistryPrototype(runtime)
+	r.Intrinsics[IntrinsicShadowRealmPrototype] = NewShadowRealmPrototype(runtime)
+	r.Intrinsics[IntrinsicTextEncoderPrototype] = NewTextEncoderPrototype(runtime)
+	r.Intrinsics[IntrinsicTextDecoderPrototype] = NewTextDecoderPrototype(runtime)
+	r.Intrinsics[IntrinsicURLPrototype] = NewURLPrototype(runtime)
+	r.Intrinsics[IntrinsicURLSearchParamsPrototype] = NewURLSearchParamsPrototype(runtime)
+	r.Intrinsics[IntrinsicURLSearchParamsIteratorPrototype] = NewURLSearchParamsIteratorPrototype(runtime)
+	r.Intrinsics[IntrinsicHeadersPrototype] = NewHeadersPrototype(runtime)
+	r.Intrinsics[IntrinsicHeadersIteratorPrototype] = NewHeadersIteratorPrototype(runtime)
+	r.Intrinsics[IntrinsicRequestPrototype] = NewRequestPrototype(runtime)
+	r.Intrinsics[IntrinsicResponsePrototype] = NewResponsePrototype(runtime)
+	r.Intrinsics[IntrinsicAbortControllerPrototype] = NewAbortControllerPrototype(runtime)
+	r.Intrinsics[IntrinsicAbortSignalPrototype] = NewAbortSignalPrototype(runtime)
+	r.Intrinsics[IntrinsicIntlCollatorPrototype] = NewIntlCollatorPrototype(runtime)
+	r.Intrinsics[IntrinsicIntlDateTimeFormatPrototype] = NewIntlDateTimeFormatPrototype(runtime)
+	r.Intrinsics[IntrinsicIntlListFormatPrototype] = NewIntlListFormatPrototype(runtime)
+	r.Intrinsics[IntrinsicIntlNumberFormatPrototype] = NewIntlNumberFormatPrototype(runtime)
+	r.Intrinsics[IntrinsicIntlPluralRulesPrototype] = NewIntlPluralRulesPrototype(runtime)
+	r.Intrinsics[IntrinsicIntlRelativeTimeFormatPrototype] = NewIntlRelativeTimeFormatPrototype(runtime)
+	r.Intrinsics[IntrinsicIteratorHelperPrototype] = NewIteratorHelperPrototype(runtime)
+	r.Intrinsics[IntrinsicWrapForValidIteratorPrototype] = NewWrapForValidIteratorPrototype(runtime)
 
 	// Intrinsic Constructors.
 	r.Intrinsics[IntrinsicObjectConstructor] = NewObjectConstructor(runtime)
@@ -439,6 +768,8 @@ func (r *Realm) CreateIntrinsics(runtime *Runtime) {
 	r.Intrinsics[IntrinsicSyntaxErrorConstructor] = NewNativeErrorConstructor(runtime, NativeErrorTypeSyntaxError, IntrinsicSyntaxErrorPrototype)
 	r.Intrinsics[IntrinsicTypeErrorConstructor] = NewNativeErrorConstructor(runtime, NativeErrorTypeTypeError, IntrinsicTypeErrorPrototype)
 	r.Intrinsics[IntrinsicURIErrorConstructor] = NewNativeErrorConstructor(runtime, NativeErrorTypeURIError, IntrinsicURIErrorPrototype)
+	r.Intrinsics[IntrinsicAggregateErrorConstructor] = NewAggregateErrorConstructor(runtime)
+	r.Intrinsics[IntrinsicSuppressedErrorConstructor] = NewSuppressedErrorConstructor(runtime)
 	r.Intrinsics[IntrinsicArrayBufferConstructor] = NewArrayBufferConstructor(runtime)
 	r.Intrinsics[IntrinsicInt8ArrayConstructor] = NewTypedArrayConstructor(runtime, TypedArrayNameInt8, IntrinsicInt8ArrayPrototype)
 	r.Intrinsics[IntrinsicUint8ArrayConstructor] = NewTypedArrayConstructor(runtime, TypedArrayNameUint8, IntrinsicUint8ArrayPrototype)
@@ -453,10 +784,34 @@ func (r *Realm) CreateIntrinsics(runtime *Runtime) {
 	r.Intrinsics[IntrinsicFloat32ArrayConstructor] = NewTypedArrayConstructor(runtime, TypedArrayNameFloat32, IntrinsicFloat32ArrayPrototype)
 	r.Intrinsics[IntrinsicFloat64ArrayConstructor] = NewTypedArrayConstructor(runtime, TypedArrayNameFloat64, IntrinsicFloat64ArrayPrototype)
 	r.Intrinsics[IntrinsicProxyConstructor] = NewProxyObjectConstructor(runtime)
+	r.Intrinsics[IntrinsicDataViewConstructor] = NewDataViewConstructor(runtime)
+	r.Intrinsics[IntrinsicSharedArrayBufferConstructor] = NewSharedArrayBufferConstructor(runtime)
+	r.Intrinsics[IntrinsicPromiseConstructor] = NewPromiseConstructor(runtime)
+	r.Intrinsics[IntrinsicIteratorConstructor] = NewIteratorConstructor(runtime)
+	r.Intrinsics[IntrinsicWeakRefConstructor] = NewWeakRefConstructor(runtime)
+	r.Intrinsics[IntrinsicFinalizationRegistryConstructor] = NewFinalizationRegistryConstructor(runtime)
+	r.Intrinsics[IntrinsicShadowRealmConstructor] = NewShadowRealmConstructor(runtime)
+	r.Intrinsics[IntrinsicTextEncoderConstructor] = NewTextEncoderConstructor(runtime)
+	r.Intrinsics[IntrinsicTextDecoderConstructor] = NewTextDecoderConstructor(runtime)
+	r.Intrinsics[IntrinsicURLConstructor] = NewURLConstructor(runtime)
+	r.Intrinsics[IntrinsicURLSearchParamsConstructor] = NewURLSearchParamsConstructor(runtime)
+	r.Intrinsics[IntrinsicHeadersConstructor] = NewHeadersConstructor(runtime)
+	r.Intrinsics[IntrinsicRequestConstructor] = NewRequestConstructor(runtime)
+	r.Intrinsics[IntrinsicResponseConstructor] = NewResponseConstructor(runtime)
+	r.Intrinsics[IntrinsicAbortControllerConstructor] = NewAbortControllerConstructor(runtime)
+	r.Intrinsics[IntrinsicAbortSignalConstructor] = NewAbortSignalConstructor(runtime)
+	r.Intrinsics[IntrinsicIntlCollatorConstructor] = NewIntlCollatorConstructor(runtime)
+	r.Intrinsics[IntrinsicIntlDateTimeFormatConstructor] = NewIntlDateTimeFormatConstructor(runtime)
+	r.Intrinsics[IntrinsicIntlListFormatConstructor] = NewIntlListFormatConstructor(runtime)
+	r.Intrinsics[IntrinsicIntlNumberFormatConstructor] = NewIntlNumberFormatConstructor(runtime)
+	r.Intrinsics[IntrinsicIntlPluralRulesConstructor] = NewIntlPluralRulesConstructor(runtime)
+	r.Intrinsics[IntrinsicIntlRelativeTimeFormatConstructor] = NewIntlRelativeTimeFormatConstructor(runtime)
 
 	// Intrinsic Objects.
 	r.Intrinsics[IntrinsicMathObject] = NewMathObject(runtime)
 	r.Intrinsics[IntrinsicParseIntFunction] = NewParseIntFunction(runtime)
+	r.Intrinsics[IntrinsicAtomicsObject] = NewAtomicsObject(runtime)
+	r.Intrinsics[IntrinsicIntlObject] = NewIntlObject(runtime)
 
 	// Define properties on the prototypes.
 	DefineObjectPrototypeProperties(runtime, r.Intrinsics[IntrinsicObjectPrototype].(*ObjectPrototype))
@@ -475,9 +830,37 @@ func (r *Realm) CreateIntrinsics(runtime *Runtime) {
 	DefineNativeErrorPrototypeProperties(runtime, NativeErrorTypeRangeError, r.Intrinsics[IntrinsicRangeErrorPrototype])
 	DefineNativeErrorPrototypeProperties(runtime, NativeErrorTypeURIError, r.Intrinsics[IntrinsicURIErrorPrototype])
 	DefineNativeErrorPrototypeProperties(runtime, NativeErrorTypeEvalError, r.Intrinsics[IntrinsicEvalErrorPrototype])
+	DefineAggregateErrorPrototypeProperties(runtime, r.Intrinsics[IntrinsicAggregateErrorPrototype])
+	DefineSuppressedErrorPrototypeProperties(runtime, r.Intrinsics[IntrinsicSuppressedErrorPrototype])
 	DefineNumberConstructorProperties(runtime, r.Intrinsics[IntrinsicNumberConstructor])
 	DefineArrayBufferPrototypeProperties(runtime, r.Intrinsics[IntrinsicArrayBufferPrototype])
 	DefineTypedArrayPrototypeProperties(runtime, r.Intrinsics[IntrinsicTypedArrayPrototype])
+	DefineDataViewPrototypeProperties(runtime, r.Intrinsics[IntrinsicDataViewPrototype])
+	DefineSharedArrayBufferPrototypeProperties(runtime, r.Intrinsics[IntrinsicSharedArrayBufferPrototype])
+	DefinePromisePrototypeProperties(runtime, r.Intrinsics[IntrinsicPromisePrototype])
+	DefineSymbolPrototypeProperties(runtime, r.Intrinsics[IntrinsicSymbolPrototype])
+	DefineWeakRefPrototypeProperties(runtime, r.Intrinsics[IntrinsicWeakRefPrototype])
+	DefineFinalizationRegistryPrototypeProperties(runtime, r.Intrinsics[IntrinsicFinalizationRegistryPrototype])
+	DefineShadowRealmPrototypeProperties(runtime, r.Intrinsics[IntrinsicShadowRealmPrototype])
+	DefineTextEncoderPrototypeProperties(runtime, r.Intrinsics[IntrinsicTextEncoderPrototype])
+	DefineTextDecoderPrototypeProperties(runtime, r.Intrinsics[IntrinsicTextDecoderPrototype])
+	DefineURLPrototypeProperties(runtime, r.Intrinsics[IntrinsicURLPrototype])
+	DefineURLSearchParamsPrototypeProperties(runtime, r.Intrinsics[IntrinsicURLSearchParamsPrototype])
+	DefineURLSearchParamsIteratorPrototypeProperties(runtime, r.Intrinsics[IntrinsicURLSearchParamsIteratorPrototype])
+	DefineHeadersPrototypeProperties(runtime, r.Intrinsics[IntrinsicHeadersPrototype])
+	DefineHeadersIteratorPrototypeProperties(runtime, r.Intrinsics[IntrinsicHeadersIteratorPrototype])
+	DefineRequestPrototypeProperties(runtime, r.Intrinsics[IntrinsicRequestPrototype])
+	DefineResponsePrototypeProperties(runtime, r.Intrinsics[IntrinsicResponsePrototype])
+	DefineAbortControllerPrototypeProperties(runtime, r.Intrinsics[IntrinsicAbortControllerPrototype])
+	DefineAbortSignalPrototypeProperties(runtime, r.Intrinsics[IntrinsicAbortSignalPrototype])
+	DefineIntlCollatorPrototypeProperties(runtime, r.Intrinsics[IntrinsicIntlCollatorPrototype])
+	DefineIntlDateTimeFormatPrototypeProperties(runtime, r.Intrinsics[IntrinsicIntlDateTimeFormatPrototype])
+	DefineIntlListFormatPrototypeProperties(runtime, r.Intrinsics[IntrinsicIntlListFormatPrototype])
+	DefineIntlNumberFormatPrototypeProperties(runtime, r.Intrinsics[IntrinsicIntlNumberFormatPrototype])
+	DefineIntlPluralRulesPrototypeProperties(runtime, r.Intrinsics[IntrinsicIntlPluralRulesPrototype])
+	DefineIntlRelativeTimeFormatPrototypeProperties(runtime, r.Intrinsics[IntrinsicIntlRelativeTimeFormatPrototype])
+	DefineIteratorHelperPrototypeProperties(runtime, r.Intrinsics[IntrinsicIteratorHelperPrototype])
+	DefineWrapForValidIteratorPrototypeProperties(runtime, r.Intrinsics[IntrinsicWrapForValidIteratorPrototype])
 
 	// Set constructors to the prototypes (needs to be done after both the constructors and the prototypes are created).
 	SetConstructor(runtime, r.Intrinsics[IntrinsicObjectPrototype], r.Intrinsics[IntrinsicObjectConstructor].(FunctionInterface))
@@ -494,6 +877,8 @@ func (r *Realm) CreateIntrinsics(runtime *Runtime) {
 	SetConstructor(runtime, r.Intrinsics[IntrinsicSyntaxErrorPrototype], r.Intrinsics[IntrinsicSyntaxErrorConstructor].(FunctionInterface))
 	SetConstructor(runtime, r.Intrinsics[IntrinsicTypeErrorPrototype], r.Intrinsics[IntrinsicTypeErrorConstructor].(FunctionInterface))
 	SetConstructor(runtime, r.Intrinsics[IntrinsicURIErrorPrototype], r.Intrinsics[IntrinsicURIErrorConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicAggregateErrorPrototype], r.Intrinsics[IntrinsicAggregateErrorConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicSuppressedErrorPrototype], r.Intrinsics[IntrinsicSuppressedErrorConstructor].(FunctionInterface))
 	SetConstructor(runtime, r.Intrinsics[IntrinsicArrayBufferPrototype], r.Intrinsics[IntrinsicArrayBufferConstructor].(FunctionInterface))
 	SetConstructor(runtime, r.Intrinsics[IntrinsicInt8ArrayPrototype], r.Intrinsics[IntrinsicInt8ArrayConstructor].(FunctionInterface))
 	SetConstructor(runtime, r.Intrinsics[IntrinsicUint8ArrayPrototype], r.Intrinsics[IntrinsicUint8ArrayConstructor].(FunctionInterface))
@@ -507,6 +892,28 @@ func (r *Realm) CreateIntrinsics(runtime *Runtime) {
 	SetConstructor(runtime, r.Intrinsics[IntrinsicFloat16ArrayPrototype], r.Intrinsics[IntrinsicFloat16ArrayConstructor].(FunctionInterface))
 	SetConstructor(runtime, r.Intrinsics[IntrinsicFloat32ArrayPrototype], r.Intrinsics[IntrinsicFloat32ArrayConstructor].(FunctionInterface))
 	SetConstructor(runtime, r.Intrinsics[IntrinsicFloat64ArrayPrototype], r.Intrinsics[IntrinsicFloat64ArrayConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicDataViewPrototype], r.Intrinsics[IntrinsicDataViewConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicSharedArrayBufferPrototype], r.Intrinsics[IntrinsicSharedArrayBufferConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicPromisePrototype], r.Intrinsics[IntrinsicPromiseConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicSymbolPrototype], r.Intrinsics[IntrinsicSymbolConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicWeakRefPrototype], r.Intrinsics[IntrinsicWeakRefConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicFinalizationRegistryPrototype], r.Intrinsics[IntrinsicFinalizationRegistryConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicShadowRealmPrototype], r.Intrinsics[IntrinsicShadowRealmConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicTextEncoderPrototype], r.Intrinsics[IntrinsicTextEncoderConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicTextDecoderPrototype], r.Intrinsics[IntrinsicTextDecoderConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicURLPrototype], r.Intrinsics[IntrinsicURLConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicURLSearchParamsPrototype], r.Intrinsics[IntrinsicURLSearchParamsConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicHeadersPrototype], r.Intrinsics[IntrinsicHeadersConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicRequestPrototype], r.Intrinsics[IntrinsicRequestConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicResponsePrototype], r.Intrinsics[IntrinsicResponseConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicAbortControllerPrototype], r.Intrinsics[IntrinsicAbortControllerConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicAbortSignalPrototype], r.Intrinsics[IntrinsicAbortSignalConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicIntlCollatorPrototype], r.Intrinsics[IntrinsicIntlCollatorConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicIntlDateTimeFormatPrototype], r.Intrinsics[IntrinsicIntlDateTimeFormatConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicIntlListFormatPrototype], r.Intrinsics[IntrinsicIntlListFormatConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicIntlNumberFormatPrototype], r.Intrinsics[IntrinsicIntlNumberFormatConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicIntlPluralRulesPrototype], r.Intrinsics[IntrinsicIntlPluralRulesConstructor].(FunctionInterface))
+	SetConstructor(runtime, r.Intrinsics[IntrinsicIntlRelativeTimeFormatPrototype], r.Intrinsics[IntrinsicIntlRelativeTimeFormatConstructor].(FunctionInterface))
 
 	// TODO: Create other intrinsics.
 }
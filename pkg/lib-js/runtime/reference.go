@@ -3,6 +3,8 @@ package runtime
 import (
 	"fmt"
 	"strings"
+
+	"zbrannelly.dev/go-js/pkg/lib-js/parser/ast"
 )
 
 type Reference struct {
@@ -11,6 +13,9 @@ type Reference struct {
 	ReferenceName *JavaScriptValue
 	Strict        bool
 	ThisValue     *JavaScriptValue
+
+	// The member expression this reference was created for, if its inline cache can be used.
+	CacheSite *ast.MemberExpressionNode
 }
 
 func NewReferenceValueForEnvironment(
@@ -104,6 +109,12 @@ func GetValue(runtime *Runtime, maybeRef *JavaScriptValue) *Completion {
 
 		baseObject := baseObjectCompletion.Value.(*JavaScriptValue).Value.(ObjectInterface)
 
+		if ref.CacheSite != nil {
+			if completion := InlineCacheGet(runtime, ref.CacheSite, baseObject, ref.GetThisValue()); completion != nil {
+				return completion
+			}
+		}
+
 		propertyKeyCompletion := ToPropertyKey(runtime, ref.ReferenceName)
 		if propertyKeyCompletion.Type != Normal {
 			return propertyKeyCompletion
@@ -176,6 +187,12 @@ func PutValue(runtime *Runtime, maybeRef *JavaScriptValue, value *JavaScriptValu
 			panic("TODO: Support setting private object properties.")
 		}
 
+		if ref.CacheSite != nil {
+			if completion := InlineCacheSet(runtime, ref.CacheSite, baseObject, value, ref.GetThisValue()); completion != nil {
+				return NewUnusedCompletion()
+			}
+		}
+
 		refNamePrimitive := ToPrimitive(runtime, ref.ReferenceName)
 		if refNamePrimitive.Type != Normal {
 			return refNamePrimitive
@@ -230,7 +247,7 @@ func PropertyKeyToString(value *JavaScriptValue) string {
 }
 
 func ToPropertyKey(runtime *Runtime, value *JavaScriptValue) *Completion {
-	key := ToPrimitive(runtime, value)
+	key := ToPrimitiveWithPreferredType(runtime, value, PreferredTypeString)
 	if key.Type != Normal {
 		return key
 	}
@@ -0,0 +1,65 @@
+package runtime
+
+func NewRequestConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		RequestConstructor,
+		1,
+		NewStringValue("Request"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// Request.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicRequestPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+// Request(input, init)
+func RequestConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "Request constructor requires 'new'"))
+	}
+
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	request, completion := newRequestState(runtime, arguments[0], arguments[1])
+	if completion != nil {
+		return completion
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicRequestPrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	requestVal := createCompletion.Value.(*JavaScriptValue)
+	requestVal.Value.(*Object).Request = request
+
+	return NewNormalCompletion(requestVal)
+}
+
+// NewRequestObject creates a Request object for a request.
+func NewRequestObject(runtime *Runtime, request *RequestState) *Object {
+	object := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicRequestPrototype)).(*Object)
+	object.Request = request
+	return object
+}
@@ -0,0 +1,268 @@
+package runtime
+
+func NewRequestPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineRequestPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Request.prototype.method
+	DefineBuiltinAccessorFunction(runtime, prototype, "method", RequestPrototypeMethodGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Request.prototype.url
+	DefineBuiltinAccessorFunction(runtime, prototype, "url", RequestPrototypeURLGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Request.prototype.headers
+	DefineBuiltinAccessorFunction(runtime, prototype, "headers", RequestPrototypeHeadersGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Request.prototype.redirect
+	DefineBuiltinAccessorFunction(runtime, prototype, "redirect", RequestPrototypeRedirectGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Request.prototype.signal
+	DefineBuiltinAccessorFunction(runtime, prototype, "signal", RequestPrototypeSignalGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Request.prototype.bodyUsed
+	DefineBuiltinAccessorFunction(runtime, prototype, "bodyUsed", RequestPrototypeBodyUsedGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Request.prototype.clone
+	DefineBuiltinFunction(runtime, prototype, "clone", RequestPrototypeClone, 0)
+
+	// Request.prototype.arrayBuffer
+	DefineBuiltinFunction(runtime, prototype, "arrayBuffer", RequestPrototypeArrayBuffer, 0)
+
+	// Request.prototype.bytes
+	DefineBuiltinFunction(runtime, prototype, "bytes", RequestPrototypeBytes, 0)
+
+	// Request.prototype.text
+	DefineBuiltinFunction(runtime, prototype, "text", RequestPrototypeText, 0)
+
+	// Request.prototype.json
+	DefineBuiltinFunction(runtime, prototype, "json", RequestPrototypeJSON, 0)
+
+	// Request.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("Request"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func thisRequest(runtime *Runtime, thisArg *JavaScriptValue, method string) (*RequestState, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.Request != nil {
+			return object.Request, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Request.prototype."+method+" called on incompatible receiver"))
+}
+
+// get Request.prototype.method
+func RequestPrototypeMethodGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "method")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(request.Method))
+}
+
+// get Request.prototype.url
+func RequestPrototypeURLGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "url")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(request.URL.String()))
+}
+
+// get Request.prototype.headers
+func RequestPrototypeHeadersGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "headers")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, request.Headers))
+}
+
+// get Request.prototype.redirect
+func RequestPrototypeRedirectGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "redirect")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(request.Redirect))
+}
+
+// get Request.prototype.signal
+func RequestPrototypeSignalGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "signal")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, request.Signal))
+}
+
+// get Request.prototype.bodyUsed
+func RequestPrototypeBodyUsedGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "bodyUsed")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(request.Body != nil && request.Body.Used))
+}
+
+// Request.prototype.clone()
+func RequestPrototypeClone(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "clone")
+	if completion != nil {
+		return completion
+	}
+
+	if request.Body != nil && request.Body.Used {
+		return NewThrowCompletion(NewTypeError(runtime, "Request.clone: Body has already been consumed."))
+	}
+
+	clone := &RequestState{
+		Method:   request.Method,
+		URL:      request.URL.Clone(),
+		Headers:  NewHeadersObject(runtime, request.Headers.Headers.Clone()),
+		Signal:   NewAbortSignal(runtime),
+		Redirect: request.Redirect,
+	}
+	if request.Body != nil {
+		clone.Body = request.Body.Clone()
+	}
+	FollowAbortSignal(runtime, clone.Signal, request.Signal)
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, NewRequestObject(runtime, clone)))
+}
+
+// Request.prototype.arrayBuffer()
+func RequestPrototypeArrayBuffer(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "arrayBuffer")
+	if completion != nil {
+		return completion
+	}
+
+	return consumeFetchBody(runtime, request.Body, fetchBodyKindArrayBuffer)
+}
+
+// Request.prototype.bytes()
+func RequestPrototypeBytes(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "bytes")
+	if completion != nil {
+		return completion
+	}
+
+	return consumeFetchBody(runtime, request.Body, fetchBodyKindBytes)
+}
+
+// Request.prototype.text()
+func RequestPrototypeText(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "text")
+	if completion != nil {
+		return completion
+	}
+
+	return consumeFetchBody(runtime, request.Body, fetchBodyKindText)
+}
+
+// Request.prototype.json()
+func RequestPrototypeJSON(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	request, completion := thisRequest(runtime, thisArg, "json")
+	if completion != nil {
+		return completion
+	}
+
+	return consumeFetchBody(runtime, request.Body, fetchBodyKindJSON)
+}
@@ -0,0 +1,293 @@
+package runtime
+
+import (
+	"fmt"
+	goruntime "runtime"
+	"sync/atomic"
+	"unsafe"
+)
+
+// ResourceLimits bounds the memory that scripts on a runtime may hold. Zero fields are unlimited.
+type ResourceLimits struct {
+	// The longest string a script may create, in bytes. Creating a longer one throws a RangeError.
+	MaxStringLength int
+
+	// The largest ArrayBuffer or SharedArrayBuffer a script may allocate, in bytes. Larger ones throw a RangeError.
+	MaxArrayBufferByteLength int
+
+	// Limits on what a single run may allocate, where a run is one outermost entry into the runtime, such as
+	// evaluating a script and the jobs it queues. They count the strings, objects and buffers the run creates and the
+	// properties it adds, less the properties it deletes, rather than what is still reachable, so they don't depend
+	// on when the garbage collector runs: a run that keeps creating garbage is stopped as if it held on to it, and the
+	// next run starts from zero even if earlier ones left objects in globals. Going over one terminates the running
+	// script with a *ResourceLimitError as the reason of its InterruptedError.
+	MaxStringBytes      int64
+	MaxObjects          int64
+	MaxProperties       int64
+	MaxArrayBufferBytes int64
+}
+
+// ResourceUsage is the memory held by scripts on a runtime. Only strings longer than trackedStringLength are counted,
+// shorter ones are small enough to be bounded by the properties that hold them. Memory is released when the garbage
+// collector frees it, so usage may lag behind what is actually reachable.
+type ResourceUsage struct {
+	StringBytes      int64
+	Objects          int64
+	Properties       int64
+	ArrayBufferBytes int64
+}
+
+// ResourceLimitError is the reason a script was terminated for going over one of its ResourceLimits.
+type ResourceLimitError struct {
+	Resource string
+	Limit    int64
+}
+
+func (e *ResourceLimitError) Error() string {
+	return fmt.Sprintf("resource limit exceeded: more than %d %s", e.Limit, e.Resource)
+}
+
+// Strings shorter than this aren't worth tracking individually.
+const trackedStringLength = 1024
+
+// maxStringLength is the longest string any script may create, in bytes, the same as V8's limit on 64-bit platforms.
+const maxStringLength = 1<<29 - 24
+
+type resourceCounter struct {
+	current atomic.Int64
+	peak    atomic.Int64
+
+	// What the current run charged, less what it released explicitly. Limits apply to it rather than to current,
+	// which only drops once the garbage collector frees memory. It is only used on the runtime's goroutine.
+	run int64
+}
+
+func (c *resourceCounter) add(amount int64) {
+	c.run += amount
+	current := c.current.Add(amount)
+	for {
+		peak := c.peak.Load()
+		if current <= peak || c.peak.CompareAndSwap(peak, current) {
+			return
+		}
+	}
+}
+
+// release is called by the cleanups of collected values, so it only lowers the usage, not what the run charged.
+func (c *resourceCounter) release(amount int64) {
+	c.current.Add(-amount)
+}
+
+// discharge releases amount that the running script freed itself, such as deleted properties.
+func (c *resourceCounter) discharge(amount int64) {
+	c.run -= amount
+	c.release(amount)
+}
+
+// fits reports whether amount more can be charged to the current run without going over limit.
+func (c *resourceCounter) fits(amount int64, limit int64) bool {
+	return limit <= 0 || c.run+amount <= limit
+}
+
+type resourceAccount struct {
+	limits ResourceLimits
+
+	strings      resourceCounter
+	objects      resourceCounter
+	properties   resourceCounter
+	arrayBuffers resourceCounter
+}
+
+// storeAccount records what an object was charged for, so it can be released when the object is collected.
+type storeAccount struct {
+	properties atomic.Int64
+}
+
+func (a *resourceAccount) counters() []*resourceCounter {
+	return []*resourceCounter{&a.strings, &a.objects, &a.properties, &a.arrayBuffers}
+}
+
+// startRun gives the next run the whole of each limit.
+func (a *resourceAccount) startRun() {
+	for _, counter := range a.counters() {
+		counter.run = 0
+	}
+}
+
+func (a *resourceAccount) releaseStore(account *storeAccount) {
+	a.objects.release(1)
+	a.properties.release(account.properties.Load())
+}
+
+func (a *resourceAccount) usage(peak bool) ResourceUsage {
+	load := func(c *resourceCounter) int64 {
+		if peak {
+			return c.peak.Load()
+		}
+		return c.current.Load()
+	}
+
+	return ResourceUsage{
+		StringBytes:      load(&a.strings),
+		Objects:          load(&a.objects),
+		Properties:       load(&a.properties),
+		ArrayBufferBytes: load(&a.arrayBuffers),
+	}
+}
+
+// SetResourceLimits starts counting what scripts allocate on this runtime and enforces limits. Objects created before
+// the first call, such as the intrinsics of existing realms, aren't counted. Pass a zero ResourceLimits to only
+// measure usage.
+func (r *Runtime) SetResourceLimits(limits ResourceLimits) {
+	if r.resources == nil {
+		r.resources = &resourceAccount{}
+	}
+	r.resources.limits = limits
+}
+
+// ResourceUsage returns what scripts currently hold, or zero if SetResourceLimits wasn't called.
+func (r *Runtime) ResourceUsage() ResourceUsage {
+	if r.resources == nil {
+		return ResourceUsage{}
+	}
+	return r.resources.usage(false)
+}
+
+// PeakResourceUsage returns the highest usage of each resource since SetResourceLimits or ResetPeakResourceUsage.
+func (r *Runtime) PeakResourceUsage() ResourceUsage {
+	if r.resources == nil {
+		return ResourceUsage{}
+	}
+	return r.resources.usage(true)
+}
+
+// ResetPeakResourceUsage lowers the peak usage to the current usage, e.g. to measure the next run on its own.
+func (r *Runtime) ResetPeakResourceUsage() {
+	if r.resources == nil {
+		return
+	}
+
+	for _, counter := range r.resources.counters() {
+		counter.peak.Store(counter.current.Load())
+	}
+}
+
+func (r *Runtime) terminateForResource(resource string, limit int64) *Completion {
+	return NewTerminateCompletion(&InterruptedError{Reason: &ResourceLimitError{Resource: resource, Limit: limit}})
+}
+
+// interruptForResource terminates the script at its next checkpoint, for resources that are charged where
+// failing isn't allowed.
+func (r *Runtime) interruptForResource(resource string, limit int64) {
+	r.interrupt(&InterruptedError{Reason: &ResourceLimitError{Resource: resource, Limit: limit}})
+}
+
+// CheckStringLength returns an abrupt completion if scripts may not create a string of length bytes: a RangeError when
+// it is longer than MaxStringLength, or a Terminate completion when it would go over MaxStringBytes. Otherwise it
+// returns nil, and the string should be created with trackString. Call it before building the string.
+func (r *Runtime) CheckStringLength(length int) *Completion {
+	if length > maxStringLength {
+		return NewThrowCompletion(NewRangeError(r, "Invalid string length"))
+	}
+
+	if r.resources == nil {
+		return nil
+	}
+
+	limits := r.resources.limits
+	if limits.MaxStringLength > 0 && length > limits.MaxStringLength {
+		return NewThrowCompletion(NewRangeError(r, "Invalid string length"))
+	}
+
+	if length >= trackedStringLength && !r.resources.strings.fits(int64(length), limits.MaxStringBytes) {
+		return r.terminateForResource("string bytes", limits.MaxStringBytes)
+	}
+
+	return nil
+}
+
+// trackString charges a string that passed CheckStringLength to the runtime until it is collected.
+func (r *Runtime) trackString(value *String) *String {
+	if r.resources == nil || len(value.Value) < trackedStringLength {
+		return value
+	}
+
+	length := int64(len(value.Value))
+	r.resources.strings.add(length)
+	goruntime.AddCleanup(value, r.resources.strings.release, length)
+	return value
+}
+
+// CheckArrayBufferLength returns an abrupt completion if scripts may not allocate a buffer of byteLength bytes:
+// a RangeError when it is larger than MaxArrayBufferByteLength, or a Terminate completion when it would go over
+// MaxArrayBufferBytes. Otherwise it returns nil, and the buffer's data should be passed to trackArrayBuffer.
+func (r *Runtime) CheckArrayBufferLength(byteLength uint) *Completion {
+	if r.resources == nil {
+		return nil
+	}
+
+	limits := r.resources.limits
+	if limits.MaxArrayBufferByteLength > 0 && byteLength > uint(limits.MaxArrayBufferByteLength) {
+		return NewThrowCompletion(NewRangeError(r, "Array buffer allocation failed"))
+	}
+
+	if !r.resources.arrayBuffers.fits(int64(byteLength), limits.MaxArrayBufferBytes) {
+		return r.terminateForResource("array buffer bytes", limits.MaxArrayBufferBytes)
+	}
+
+	return nil
+}
+
+// trackArrayBuffer charges the backing store of a buffer to the runtime until it is collected.
+func (r *Runtime) trackArrayBuffer(data []byte) {
+	if r.resources == nil || cap(data) == 0 {
+		return
+	}
+
+	length := int64(cap(data))
+	r.resources.arrayBuffers.add(length)
+	goruntime.AddCleanup(unsafe.SliceData(data), r.resources.arrayBuffers.release, length)
+}
+
+// trackObject starts charging an object to the runtime, if it isn't already.
+func (r *Runtime) trackObject(object ObjectInterface) *storeAccount {
+	store := object.GetProperties()
+	if r.resources == nil || store == nil {
+		return nil
+	}
+
+	if store.account != nil {
+		return store.account
+	}
+
+	store.account = &storeAccount{}
+	goruntime.AddCleanup(store, r.resources.releaseStore, store.account)
+
+	r.resources.objects.add(1)
+	if !r.resources.objects.fits(0, r.resources.limits.MaxObjects) {
+		r.interruptForResource("objects", r.resources.limits.MaxObjects)
+	}
+
+	return store.account
+}
+
+// chargeProperties records that count properties were added to (or removed from, when negative) an object. Adding
+// properties can't fail, so going over MaxProperties interrupts the runtime instead, and the script is terminated at
+// its next property assignment, loop iteration or function call.
+func (r *Runtime) chargeProperties(object ObjectInterface, count int64) {
+	account := r.trackObject(object)
+	if account == nil {
+		return
+	}
+
+	account.properties.Add(count)
+	if count < 0 {
+		r.resources.properties.discharge(-count)
+		return
+	}
+
+	r.resources.properties.add(count)
+	if !r.resources.properties.fits(0, r.resources.limits.MaxProperties) {
+		r.interruptForResource("properties", r.resources.limits.MaxProperties)
+	}
+}
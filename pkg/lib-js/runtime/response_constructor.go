@@ -0,0 +1,212 @@
+package runtime
+
+import "strconv"
+
+func NewResponseConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		ResponseConstructor,
+		0,
+		NewStringValue("Response"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// Response.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicResponsePrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	// Response.error
+	DefineBuiltinFunction(runtime, constructor, "error", ResponseError, 0)
+
+	// Response.redirect
+	DefineBuiltinFunction(runtime, constructor, "redirect", ResponseRedirect, 1)
+
+	return constructor
+}
+
+// Response(body, init)
+func ResponseConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "Response constructor requires 'new'"))
+	}
+
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	response := &ResponseState{Type: "default", Status: 200}
+	headers := &HeadersList{}
+
+	// The members of init, read in dictionary order.
+	init := arguments[1]
+	if init.Type == TypeObject {
+		members := map[string]*JavaScriptValue{}
+		for _, name := range []string{"headers", "status", "statusText"} {
+			completion := init.Value.(ObjectInterface).Get(runtime, NewStringValue(name), init)
+			if completion.Type != Normal {
+				return completion
+			}
+			members[name] = completion.Value.(*JavaScriptValue)
+		}
+
+		if status := members["status"]; status.Type != TypeUndefined {
+			completion := ToNumber(runtime, status)
+			if completion.Type != Normal {
+				return completion
+			}
+
+			number := completion.Value.(*JavaScriptValue).Value.(*Number)
+			if number.NaN || number.Value < 200 || number.Value >= 600 {
+				return NewThrowCompletion(NewRangeError(runtime, "init[\"status\"] must be in the range of 200 to 599, inclusive."))
+			}
+			response.Status = int(number.Value)
+		}
+
+		if statusText := members["statusText"]; statusText.Type != TypeUndefined {
+			text, completion := toByteString(runtime, statusText)
+			if completion != nil {
+				return completion
+			}
+
+			if !isReasonPhrase(text) {
+				return NewThrowCompletion(NewTypeError(runtime, "Invalid statusText"))
+			}
+			response.StatusText = text
+		}
+
+		if init := members["headers"]; init.Type != TypeUndefined {
+			if completion := fillHeaders(runtime, headers, init); completion != nil {
+				return completion
+			}
+		}
+	}
+
+	if body := arguments[0]; body.Type != TypeUndefined && body.Type != TypeNull {
+		if IsNullBodyStatus(response.Status) {
+			return NewThrowCompletion(NewTypeError(runtime, "Response with null body status cannot have body"))
+		}
+
+		extracted, contentType, completion := ExtractBody(runtime, body)
+		if completion != nil {
+			return completion
+		}
+
+		if contentType != "" && !headers.Has("content-type") {
+			headers.Append("content-type", contentType)
+		}
+		response.Body = extracted
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicResponsePrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	response.Headers = NewHeadersObject(runtime, headers)
+
+	responseVal := createCompletion.Value.(*JavaScriptValue)
+	responseVal.Value.(*Object).Response = response
+
+	return NewNormalCompletion(responseVal)
+}
+
+// Response.error()
+func ResponseError(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response := &ResponseState{
+		Type:    "error",
+		Status:  0,
+		Headers: NewHeadersObject(runtime, &HeadersList{Immutable: true}),
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, NewResponseObject(runtime, response)))
+}
+
+// Response.redirect(url, status)
+func ResponseRedirect(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	urlString, completion := toUSVString(runtime, arguments[0])
+	if completion != nil {
+		return completion
+	}
+
+	url, ok := ParseURL(urlString, nil)
+	if !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "Failed to parse URL from "+urlString))
+	}
+
+	status := 302
+	if arguments[1].Type != TypeUndefined {
+		numberCompletion := ToNumber(runtime, arguments[1])
+		if numberCompletion.Type != Normal {
+			return numberCompletion
+		}
+
+		number := numberCompletion.Value.(*JavaScriptValue).Value.(*Number).Value
+		if number != float64(int(number)) || !IsRedirectStatus(int(number)) {
+			return NewThrowCompletion(NewRangeError(runtime, "Invalid status code "+strconv.FormatFloat(number, 'f', -1, 64)))
+		}
+		status = int(number)
+	}
+
+	headers := &HeadersList{}
+	headers.Append("location", url.String())
+	headers.Immutable = true
+
+	response := &ResponseState{
+		Type:    "default",
+		Status:  status,
+		Headers: NewHeadersObject(runtime, headers),
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, NewResponseObject(runtime, response)))
+}
+
+// NewResponseObject creates a Response object for a response.
+func NewResponseObject(runtime *Runtime, response *ResponseState) *Object {
+	object := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicResponsePrototype)).(*Object)
+	object.Response = response
+	return object
+}
+
+// isReasonPhrase reports whether text can be the reason phrase of a status line, which has tabs, spaces and visible
+// characters only.
+func isReasonPhrase(text string) bool {
+	for _, char := range text {
+		if char != '\t' && (char < 0x20 || char == 0x7F) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,323 @@
+package runtime
+
+func NewResponsePrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineResponsePrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Response.prototype.type
+	DefineBuiltinAccessorFunction(runtime, prototype, "type", ResponsePrototypeTypeGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Response.prototype.url
+	DefineBuiltinAccessorFunction(runtime, prototype, "url", ResponsePrototypeURLGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Response.prototype.redirected
+	DefineBuiltinAccessorFunction(runtime, prototype, "redirected", ResponsePrototypeRedirectedGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Response.prototype.status
+	DefineBuiltinAccessorFunction(runtime, prototype, "status", ResponsePrototypeStatusGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Response.prototype.ok
+	DefineBuiltinAccessorFunction(runtime, prototype, "ok", ResponsePrototypeOkGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Response.prototype.statusText
+	DefineBuiltinAccessorFunction(runtime, prototype, "statusText", ResponsePrototypeStatusTextGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Response.prototype.headers
+	DefineBuiltinAccessorFunction(runtime, prototype, "headers", ResponsePrototypeHeadersGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Response.prototype.bodyUsed
+	DefineBuiltinAccessorFunction(runtime, prototype, "bodyUsed", ResponsePrototypeBodyUsedGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// Response.prototype.clone
+	DefineBuiltinFunction(runtime, prototype, "clone", ResponsePrototypeClone, 0)
+
+	// Response.prototype.arrayBuffer
+	DefineBuiltinFunction(runtime, prototype, "arrayBuffer", ResponsePrototypeArrayBuffer, 0)
+
+	// Response.prototype.bytes
+	DefineBuiltinFunction(runtime, prototype, "bytes", ResponsePrototypeBytes, 0)
+
+	// Response.prototype.text
+	DefineBuiltinFunction(runtime, prototype, "text", ResponsePrototypeText, 0)
+
+	// Response.prototype.json
+	DefineBuiltinFunction(runtime, prototype, "json", ResponsePrototypeJSON, 0)
+
+	// Response.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("Response"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func thisResponse(runtime *Runtime, thisArg *JavaScriptValue, method string) (*ResponseState, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.Response != nil {
+			return object.Response, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Response.prototype."+method+" called on incompatible receiver"))
+}
+
+// responseURLValue returns the URL of a response without its fragment, or an empty string if it has none.
+func responseURLValue(response *ResponseState) *JavaScriptValue {
+	if response.URL == nil {
+		return NewStringValue("")
+	}
+	return NewStringValue(response.URL.serialize(true))
+}
+
+// get Response.prototype.type
+func ResponsePrototypeTypeGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "type")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(response.Type))
+}
+
+// get Response.prototype.url
+func ResponsePrototypeURLGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "url")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(responseURLValue(response))
+}
+
+// get Response.prototype.redirected
+func ResponsePrototypeRedirectedGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "redirected")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(response.Redirected))
+}
+
+// get Response.prototype.status
+func ResponsePrototypeStatusGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "status")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewNumberValue(float64(response.Status), false))
+}
+
+// get Response.prototype.ok
+func ResponsePrototypeOkGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "ok")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(response.Status >= 200 && response.Status <= 299))
+}
+
+// get Response.prototype.statusText
+func ResponsePrototypeStatusTextGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "statusText")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(response.StatusText))
+}
+
+// get Response.prototype.headers
+func ResponsePrototypeHeadersGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "headers")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, response.Headers))
+}
+
+// get Response.prototype.bodyUsed
+func ResponsePrototypeBodyUsedGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "bodyUsed")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(response.Body != nil && response.Body.Used))
+}
+
+// Response.prototype.clone()
+func ResponsePrototypeClone(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "clone")
+	if completion != nil {
+		return completion
+	}
+
+	if response.Body != nil && response.Body.Used {
+		return NewThrowCompletion(NewTypeError(runtime, "Response.clone: Body has already been consumed."))
+	}
+
+	headers := response.Headers.Headers.Clone()
+	headers.Immutable = response.Headers.Headers.Immutable
+
+	clone := &ResponseState{
+		Type:       response.Type,
+		URL:        response.URL,
+		Redirected: response.Redirected,
+		Status:     response.Status,
+		StatusText: response.StatusText,
+		Headers:    NewHeadersObject(runtime, headers),
+	}
+	if response.Body != nil {
+		clone.Body = response.Body.Clone()
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, NewResponseObject(runtime, clone)))
+}
+
+// Response.prototype.arrayBuffer()
+func ResponsePrototypeArrayBuffer(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "arrayBuffer")
+	if completion != nil {
+		return completion
+	}
+
+	return consumeFetchBody(runtime, response.Body, fetchBodyKindArrayBuffer)
+}
+
+// Response.prototype.bytes()
+func ResponsePrototypeBytes(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "bytes")
+	if completion != nil {
+		return completion
+	}
+
+	return consumeFetchBody(runtime, response.Body, fetchBodyKindBytes)
+}
+
+// Response.prototype.text()
+func ResponsePrototypeText(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "text")
+	if completion != nil {
+		return completion
+	}
+
+	return consumeFetchBody(runtime, response.Body, fetchBodyKindText)
+}
+
+// Response.prototype.json()
+func ResponsePrototypeJSON(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	response, completion := thisResponse(runtime, thisArg, "json")
+	if completion != nil {
+		return completion
+	}
+
+	return consumeFetchBody(runtime, response.Body, fetchBodyKindJSON)
+}
@@ -1,8 +1,22 @@
 package runtime
 
+import (
+	"encoding/binary"
+	"math/rand/v2"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
 type Runtime struct {
 	ExecutionContextStack []*ExecutionContext
 
+	// Pending jobs of this agent, such as promise reactions.
+	Jobs *JobQueue
+
+	// Whether this agent may block in Atomics.wait. This corresponds to [[CanBlock]] in the spec.
+	CanBlock bool
+
 	// Well-known symbols.
 	SymbolToStringTag      *JavaScriptValue
 	SymbolIterator         *JavaScriptValue
@@ -11,11 +25,93 @@ type Runtime struct {
 	SymbolHasInstance      *JavaScriptValue
 	SymbolToPrimitive      *JavaScriptValue
 	SymbolConcatSpreadable *JavaScriptValue
+	SymbolAsyncIterator    *JavaScriptValue
+	SymbolMatch            *JavaScriptValue
+	SymbolMatchAll         *JavaScriptValue
+	SymbolReplace          *JavaScriptValue
+	SymbolSearch           *JavaScriptValue
+	SymbolSplit            *JavaScriptValue
+
+	// Symbols registered with Symbol.for, shared by all realms of this runtime.
+	SymbolRegistry *SymbolRegistry
+
+	// The maximum number of execution contexts on the stack. Calls beyond it throw a RangeError.
+	MaxCallDepth int
+
+	// The source of Math.random, which must return numbers in [0, 1).
+	Random func() float64
+
+	// The clock scripts observe.
+	Now func() time.Time
+
+	// The time zone Intl.DateTimeFormat formats in when none is given, or nil for UTC.
+	TimeZone *time.Location
+
+	// The clock the event loop runs timers by.
+	Clock Clock
+
+	// The globals of the realms scripts create with new ShadowRealm(). They are further limited to the globals of the
+	// realm creating the ShadowRealm.
+	ShadowRealmOptions RealmOptions
+
+	// Loads the module ShadowRealm.prototype.importValue imports from, in the realm of the ShadowRealm, returning its
+	// namespace object. The engine has no module loader of its own, so imports are rejected while it is nil.
+	ImportModule func(runtime *Runtime, realm *Realm, specifier string) *Completion
+
+	// Sends the requests made by fetch. Requests are made on other goroutines, so it must be safe for concurrent use.
+	// Scripts have no network access of their own, so fetch rejects while it is nil.
+	HTTPTransport http.RoundTripper
+
+	// Where the console namespace writes, or nil to discard its output.
+	Console ConsoleSink
+
+	// The group depth, counters and timers of the console namespace.
+	consoleState *consoleState
+
+	// The timers and immediates scheduled by scripts, run by RunEventLoop.
+	timerState *timerState
+
+	// Steps taken by scripts, and the count at which they are terminated, or 0 for no limit. See Step.
+	steps      uint64
+	stepLimit  uint64
+	stepBudget uint64
+
+	// How deeply Evaluate is nested on the Go stack, bounded by maxEvaluationDepth.
+	evaluationDepth int
+
+	// Set while a native error is created, so errors can still be created at MaxCallDepth. See CheckCallDepth.
+	callDepthExempt bool
+
+	// The objects Array.prototype.join is joining, so an array that contains itself isn't joined forever.
+	joining map[ObjectInterface]struct{}
+
+	// Set by Interrupt, possibly from another goroutine, and polled by loops and function calls.
+	interrupted atomic.Pointer[InterruptedError]
+
+	// Signalled by Interrupt, to wake natives that block, such as Atomics.wait.
+	interruptWake chan struct{}
+
+	// How many calls to Execute are on the Go stack.
+	executeDepth int
+
+	// What scripts hold and may hold, nil until SetResourceLimits is called.
+	resources *resourceAccount
+
+	// The objects WeakRefs must keep returning until the running job ends. This corresponds to [[KeptAlive]] in the
+	// spec.
+	keptObjects []*JavaScriptValue
+
+	// The FinalizationRegistries with registered cells, and whether a job to check them for collected targets is
+	// queued.
+	finalizationRegistries  map[*Object]struct{}
+	finalizationCheckQueued atomic.Bool
 }
 
 func NewRuntime() *Runtime {
 	return &Runtime{
 		ExecutionContextStack:  []*ExecutionContext{},
+		Jobs:                   NewJobQueue(),
+		CanBlock:               true,
 		SymbolToStringTag:      NewSymbolValue("Symbol.toStringTag"),
 		SymbolIterator:         NewSymbolValue("Symbol.iterator"),
 		SymbolSpecies:          NewSymbolValue("Symbol.species"),
@@ -23,10 +119,40 @@ func NewRuntime() *Runtime {
 		SymbolHasInstance:      NewSymbolValue("Symbol.hasInstance"),
 		SymbolToPrimitive:      NewSymbolValue("Symbol.toPrimitive"),
 		SymbolConcatSpreadable: NewSymbolValue("Symbol.isConcatSpreadable"),
-		// TODO: Add other well-known symbols.
+		SymbolAsyncIterator:    NewSymbolValue("Symbol.asyncIterator"),
+		SymbolMatch:            NewSymbolValue("Symbol.match"),
+		SymbolMatchAll:         NewSymbolValue("Symbol.matchAll"),
+		SymbolReplace:          NewSymbolValue("Symbol.replace"),
+		SymbolSearch:           NewSymbolValue("Symbol.search"),
+		SymbolSplit:            NewSymbolValue("Symbol.split"),
+		SymbolRegistry:         NewSymbolRegistry(),
+		MaxCallDepth:           DefaultMaxCallDepth,
+		Random:                 rand.Float64,
+		Now:                    time.Now,
+		Clock:                  SystemClock{},
+		Console:                NewStdioConsoleSink(),
+		interruptWake:          make(chan struct{}, 1),
 	}
 }
 
+// DefaultMaxCallDepth is the MaxCallDepth of new runtimes.
+const DefaultMaxCallDepth = 10000
+
+// maxEvaluationDepth bounds the nesting of Evaluate calls, including those of enclosing function calls, so deeply
+// nested code throws a RangeError instead of overflowing the Go stack.
+const maxEvaluationDepth = 1 << 18
+
+// CheckCallDepth returns a throw completion with a RangeError if pushing another execution context would exceed
+// MaxCallDepth, and nil otherwise. Native functions are checked too, as they can recurse without running any script,
+// such as Array.prototype.join on an array that contains itself. Creating a native error calls its native
+// constructor, so calls are not checked while one is being created.
+func (r *Runtime) CheckCallDepth() *Completion {
+	if len(r.ExecutionContextStack) >= r.MaxCallDepth && !r.callDepthExempt {
+		return NewThrowCompletion(NewRangeError(r, "Maximum call stack size exceeded"))
+	}
+	return nil
+}
+
 func (r *Runtime) PushExecutionContext(executionContext *ExecutionContext) {
 	r.ExecutionContextStack = append(r.ExecutionContextStack, executionContext)
 }
@@ -38,6 +164,9 @@ func (r *Runtime) PopExecutionContext() *ExecutionContext {
 	}
 
 	executionContext := r.ExecutionContextStack[len(r.ExecutionContextStack)-1]
+
+	// Clear the slot so the popped context and its environments can be collected.
+	r.ExecutionContextStack[len(r.ExecutionContextStack)-1] = nil
 	r.ExecutionContextStack = r.ExecutionContextStack[:len(r.ExecutionContextStack)-1]
 	return executionContext
 }
@@ -88,6 +217,8 @@ func (r *Runtime) GetRunningScript() *Script {
 }
 
 func (r *Runtime) IsLittleEndian() bool {
-	// TODO: Configure this based on the host architecture. For now assume little endian.
-	return true
+	return hostIsLittleEndian
 }
+
+// Byte order of the host architecture, used as the default when reading and writing buffer values.
+var hostIsLittleEndian = binary.NativeEndian.Uint16([]byte{0x01, 0x00}) == 0x0001
@@ -1,6 +1,7 @@
 package runtime
 
 import (
+	"context"
 	"fmt"
 	"slices"
 
@@ -35,6 +36,19 @@ func ParseScript(sourceText string, realm *Realm) (*Script, error) {
 }
 
 func (s *Script) Evaluate(runtime *Runtime) *Completion {
+	return runtime.Execute(func() *Completion {
+		return s.evaluate(runtime)
+	})
+}
+
+// EvaluateContext is like Evaluate, but stops the script with a Terminate completion when ctx is done.
+func (s *Script) EvaluateContext(ctx context.Context, runtime *Runtime) *Completion {
+	return runtime.ExecuteContext(ctx, func() *Completion {
+		return s.evaluate(runtime)
+	})
+}
+
+func (s *Script) evaluate(runtime *Runtime) *Completion {
 	globalEnv := s.Realm.GlobalEnv
 	scriptContext := &ExecutionContext{
 		Function:            nil,
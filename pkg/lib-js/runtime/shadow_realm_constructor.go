@@ -0,0 +1,48 @@
+package runtime
+
+func NewShadowRealmConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		ShadowRealmConstructor,
+		0,
+		NewStringValue("ShadowRealm"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// ShadowRealm.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicShadowRealmPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+func ShadowRealmConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "ShadowRealm constructor requires 'new'"))
+	}
+
+	completion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicShadowRealmPrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	shadowRealmVal := completion.Value.(*JavaScriptValue)
+	// The new realm can't have the globals the realm creating it was denied, or it would be a way around them.
+	options := runtime.ShadowRealmOptions.within(function.Realm.Options)
+	shadowRealmVal.Value.(*Object).ShadowRealm = runtime.NewRealm(options)
+
+	return NewNormalCompletion(shadowRealmVal)
+}
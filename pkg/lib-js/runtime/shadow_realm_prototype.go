@@ -0,0 +1,192 @@
+package runtime
+
+func NewShadowRealmPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineShadowRealmPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// ShadowRealm.prototype.evaluate
+	DefineBuiltinFunction(runtime, prototype, "evaluate", ShadowRealmPrototypeEvaluate, 1)
+
+	// ShadowRealm.prototype.importValue
+	DefineBuiltinFunction(runtime, prototype, "importValue", ShadowRealmPrototypeImportValue, 2)
+
+	// ShadowRealm.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("ShadowRealm"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func ShadowRealmPrototypeEvaluate(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	evalRealm, ok := thisShadowRealm(thisArg)
+	if !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "ShadowRealm.prototype.evaluate called on incompatible receiver"))
+	}
+
+	if len(arguments) == 0 || arguments[0].Type != TypeString {
+		return NewThrowCompletion(NewTypeError(runtime, "ShadowRealm.prototype.evaluate expects a string"))
+	}
+
+	sourceText := arguments[0].Value.(*String).Value
+	return PerformShadowRealmEval(runtime, sourceText, runtime.GetRunningRealm(), evalRealm)
+}
+
+func ShadowRealmPrototypeImportValue(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	evalRealm, ok := thisShadowRealm(thisArg)
+	if !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "ShadowRealm.prototype.importValue called on incompatible receiver"))
+	}
+
+	completion := ToString(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	specifier := completion.Value.(*JavaScriptValue).Value.(*String).Value
+
+	if arguments[1].Type != TypeString {
+		return NewThrowCompletion(NewTypeError(runtime, "ShadowRealm.prototype.importValue expects the export name to be a string"))
+	}
+
+	exportName := arguments[1]
+	return ShadowRealmImportValue(runtime, specifier, exportName, runtime.GetRunningRealm(), evalRealm)
+}
+
+func thisShadowRealm(thisArg *JavaScriptValue) (*Realm, bool) {
+	if thisArg.Type != TypeObject {
+		return nil, false
+	}
+
+	object, ok := thisArg.Value.(*Object)
+	if !ok || object.ShadowRealm == nil {
+		return nil, false
+	}
+
+	return object.ShadowRealm, true
+}
+
+func PerformShadowRealmEval(runtime *Runtime, sourceText string, callerRealm *Realm, evalRealm *Realm) *Completion {
+	script, err := ParseScript(sourceText, evalRealm)
+	if err != nil {
+		return NewThrowCompletion(NewSyntaxError(runtime, err.Error()))
+	}
+
+	completion := script.evaluate(runtime)
+	if completion.Type == Throw {
+		// The error of the other realm mustn't leak, only its description.
+		return NewThrowCompletion(NewTypeError(runtime, shadowRealmErrorMessage(runtime, completion.Value.(*JavaScriptValue))))
+	}
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return GetWrappedValue(runtime, callerRealm, completion.Value.(*JavaScriptValue))
+}
+
+func ShadowRealmImportValue(
+	runtime *Runtime,
+	specifier string,
+	exportName *JavaScriptValue,
+	callerRealm *Realm,
+	evalRealm *Realm,
+) *Completion {
+	capability := NewPromiseCapabilityFromIntrinsic(runtime)
+
+	reject := func(message string) *Completion {
+		completion := Call(runtime, capability.Reject, NewUndefinedValue(), []*JavaScriptValue{NewTypeError(runtime, message)})
+		if completion.Type != Normal {
+			return completion
+		}
+		return NewNormalCompletion(capability.Promise)
+	}
+
+	if runtime.ImportModule == nil {
+		return reject("Cannot import '" + specifier + "': modules are not supported")
+	}
+
+	runtime.PushExecutionContext(&ExecutionContext{
+		Realm: evalRealm,
+	})
+	completion := runtime.ImportModule(runtime, evalRealm, specifier)
+	runtime.PopExecutionContext()
+
+	if completion.Type == Throw {
+		return reject(shadowRealmErrorMessage(runtime, completion.Value.(*JavaScriptValue)))
+	}
+	if completion.Type != Normal {
+		return completion
+	}
+
+	namespace := completion.Value.(*JavaScriptValue)
+	if namespace.Type != TypeObject {
+		return reject("Cannot import '" + specifier + "': the module loader didn't return an object")
+	}
+
+	completion = namespace.Value.(ObjectInterface).HasProperty(runtime, exportName)
+	if completion.Type == Throw {
+		return reject(shadowRealmErrorMessage(runtime, completion.Value.(*JavaScriptValue)))
+	}
+	if completion.Type != Normal {
+		return completion
+	}
+
+	name := exportName.Value.(*String).Value
+	if !completion.Value.(*JavaScriptValue).Value.(*Boolean).Value {
+		return reject("'" + specifier + "' has no export named '" + name + "'")
+	}
+
+	completion = namespace.Value.(ObjectInterface).Get(runtime, exportName, namespace)
+	if completion.Type == Throw {
+		return reject(shadowRealmErrorMessage(runtime, completion.Value.(*JavaScriptValue)))
+	}
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = GetWrappedValue(runtime, callerRealm, completion.Value.(*JavaScriptValue))
+	if completion.Type == Throw {
+		return reject(shadowRealmErrorMessage(runtime, completion.Value.(*JavaScriptValue)))
+	}
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = Call(runtime, capability.Resolve, NewUndefinedValue(), []*JavaScriptValue{completion.Value.(*JavaScriptValue)})
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return NewNormalCompletion(capability.Promise)
+}
+
+// shadowRealmErrorMessage describes a value thrown in another realm, without running any of its code.
+func shadowRealmErrorMessage(runtime *Runtime, value *JavaScriptValue) string {
+	if value.Type == TypeObject {
+		if object, ok := value.Value.(*Object); ok && object.IsError {
+			return ErrorDescription(object)
+		}
+	}
+
+	return Inspect(runtime, value, &InspectOptions{Depth: 0})
+}
@@ -0,0 +1,158 @@
+package runtime
+
+import (
+	goruntime "runtime"
+	"sort"
+	"sync"
+	"weak"
+)
+
+// Objects with more properties than this are moved to dictionary mode, so that objects used as
+// hash maps don't grow the transition tree without bound.
+const maxShapeProperties = 64
+
+// Shapes with up to this many properties are searched by walking the transition chain instead of
+// building a lookup table.
+const shapeLinearLookupLimit = 8
+
+// Shape describes the layout of an object's properties: which keys it has and the slot each one is
+// stored in. Objects that gain the same keys in the same order share a Shape, which lets inline
+// caches recognise layouts they have seen before with a single pointer comparison.
+//
+// Shapes form a transition tree rooted at the empty shape. They are immutable once created and are
+// shared by every runtime in the process.
+type Shape struct {
+	parent *Shape
+	key    shapeKey
+	slot   int
+	count  int
+
+	mutex       sync.Mutex
+	transitions map[shapeKey]weak.Pointer[Shape]
+
+	tableOnce sync.Once
+	table     map[shapeKey]int
+
+	orderOnce sync.Once
+	order     []*Shape
+}
+
+type shapeKey struct {
+	name   string
+	symbol *Symbol
+}
+
+func newShapeKey(key *JavaScriptValue) shapeKey {
+	switch key.Type {
+	case TypeSymbol:
+		return shapeKey{symbol: key.Value.(*Symbol)}
+	case TypeString:
+		return shapeKey{name: key.Value.(*String).Value}
+	}
+	panic("Assert failed: Property key is not a string or symbol.")
+}
+
+func (k shapeKey) value() *JavaScriptValue {
+	if k.symbol != nil {
+		return NewJavaScriptValue(TypeSymbol, k.symbol)
+	}
+	return NewStringValue(k.name)
+}
+
+var emptyShape = &Shape{slot: -1}
+
+// Lookup returns the slot that holds key in objects with this shape.
+func (s *Shape) Lookup(key shapeKey) (int, bool) {
+	if s.count <= shapeLinearLookupLimit {
+		for shape := s; shape.parent != nil; shape = shape.parent {
+			if shape.key == key {
+				return shape.slot, true
+			}
+		}
+		return -1, false
+	}
+
+	s.tableOnce.Do(func() {
+		s.table = make(map[shapeKey]int, s.count)
+		for shape := s; shape.parent != nil; shape = shape.parent {
+			s.table[shape.key] = shape.slot
+		}
+	})
+
+	slot, ok := s.table[key]
+	return slot, ok
+}
+
+// Len returns the number of properties in objects with this shape.
+func (s *Shape) Len() int {
+	return s.count
+}
+
+// Transition returns the shape for objects with this shape after key is added to them.
+func (s *Shape) Transition(key shapeKey) *Shape {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if pointer, ok := s.transitions[key]; ok {
+		if child := pointer.Value(); child != nil {
+			return child
+		}
+	}
+
+	child := &Shape{parent: s, key: key, slot: s.count, count: s.count + 1}
+	if s.transitions == nil {
+		s.transitions = make(map[shapeKey]weak.Pointer[Shape])
+	}
+	s.transitions[key] = weak.Make(child)
+
+	// Transitions are weak so that layouts no object uses any more can be collected.
+	goruntime.AddCleanup(child, s.removeTransition, key)
+	return child
+}
+
+func (s *Shape) removeTransition(key shapeKey) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if pointer, ok := s.transitions[key]; ok && pointer.Value() == nil {
+		delete(s.transitions, key)
+	}
+}
+
+// keysInInsertionOrder returns the shapes that added each key, oldest first.
+// The shape at index i describes the key stored in slot i.
+func (s *Shape) keysInInsertionOrder() []*Shape {
+	shapes := make([]*Shape, s.count)
+	for shape := s; shape.parent != nil; shape = shape.parent {
+		shapes[shape.slot] = shape
+	}
+	return shapes
+}
+
+// ordered returns the shapes that added each key in the order defined by OrdinaryOwnPropertyKeys.
+func (s *Shape) ordered() []*Shape {
+	s.orderOnce.Do(func() {
+		indices := make([]*Shape, 0)
+		strings := make([]*Shape, 0, s.count)
+		symbols := make([]*Shape, 0)
+		arrayIndices := make(map[*Shape]int64)
+
+		for _, shape := range s.keysInInsertionOrder() {
+			if shape.key.symbol != nil {
+				symbols = append(symbols, shape)
+			} else if index, ok := ArrayIndex(shape.key.name); ok {
+				arrayIndices[shape] = index
+				indices = append(indices, shape)
+			} else {
+				strings = append(strings, shape)
+			}
+		}
+
+		sort.Slice(indices, func(i, j int) bool {
+			return arrayIndices[indices[i]] < arrayIndices[indices[j]]
+		})
+
+		s.order = append(append(indices, strings...), symbols...)
+	})
+	return s.order
+}
@@ -0,0 +1,295 @@
+package runtime
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// SharedDataBlock is the memory behind a SharedArrayBuffer. Unlike the objects that wrap it, a block is not tied to
+// a Runtime, so the same block can be exposed to several agents running on different goroutines.
+type SharedDataBlock struct {
+	// Data spans the maximum byte length of the block, so growing never moves it.
+	Data          []byte
+	MaxByteLength uint
+	Growable      bool
+
+	byteLength atomic.Uint64
+
+	// Critical section guarding the waiter lists used by Atomics.wait and Atomics.notify.
+	waitersMutex sync.Mutex
+	waiters      map[uint][]*atomicsWaiter
+}
+
+// NewSharedDataBlock allocates a zeroed block. When growable is true the block can later grow up to maxByteLength.
+func NewSharedDataBlock(byteLength uint, maxByteLength uint, growable bool) *SharedDataBlock {
+	if !growable {
+		maxByteLength = byteLength
+	}
+
+	// Back the block with 64-bit words so that every aligned element can be accessed with sync/atomic.
+	words := make([]uint64, (maxByteLength+7)/8)
+
+	var data []byte
+	if len(words) > 0 {
+		data = unsafe.Slice((*byte)(unsafe.Pointer(&words[0])), len(words)*8)[:maxByteLength]
+	} else {
+		data = []byte{}
+	}
+
+	block := &SharedDataBlock{
+		Data:          data,
+		MaxByteLength: maxByteLength,
+		Growable:      growable,
+		waiters:       make(map[uint][]*atomicsWaiter),
+	}
+	block.byteLength.Store(uint64(byteLength))
+
+	return block
+}
+
+// ByteLength returns the current length of the block, which may be changed concurrently by SharedArrayBuffer.prototype.grow.
+func (b *SharedDataBlock) ByteLength() uint {
+	return uint(b.byteLength.Load())
+}
+
+// Grow extends the block to newByteLength. It reports false if the block shrunk or reached the limit concurrently.
+func (b *SharedDataBlock) Grow(newByteLength uint) bool {
+	for {
+		current := b.byteLength.Load()
+		if uint64(newByteLength) < current || newByteLength > b.MaxByteLength {
+			return false
+		}
+
+		if b.byteLength.CompareAndSwap(current, uint64(newByteLength)) {
+			return true
+		}
+	}
+}
+
+// Bytes returns the currently visible part of the block. The slice shares memory with the block.
+func (b *SharedDataBlock) Bytes() []byte {
+	return b.Data[:b.ByteLength()]
+}
+
+func (b *SharedDataBlock) isAligned(byteIndex uint, elementSize uint) bool {
+	return byteIndex%elementSize == 0 && (elementSize == 1 || elementSize == 2 || elementSize == 4 || elementSize == 8)
+}
+
+func (b *SharedDataBlock) word32(byteIndex uint) *uint32 {
+	return (*uint32)(unsafe.Pointer(&b.Data[byteIndex&^3]))
+}
+
+func (b *SharedDataBlock) word64(byteIndex uint) *uint64 {
+	return (*uint64)(unsafe.Pointer(&b.Data[byteIndex]))
+}
+
+// subWordShift returns the bit offset of an element of elementSize bytes inside its containing 32-bit word.
+func subWordShift(byteIndex uint, elementSize uint) uint {
+	if hostIsLittleEndian {
+		return (byteIndex & 3) * 8
+	}
+	return (4 - elementSize - (byteIndex & 3)) * 8
+}
+
+// loadBits atomically reads an aligned element in host byte order.
+func (b *SharedDataBlock) loadBits(byteIndex uint, elementSize uint) uint64 {
+	switch elementSize {
+	case 8:
+		return atomic.LoadUint64(b.word64(byteIndex))
+	case 4:
+		return uint64(atomic.LoadUint32(b.word32(byteIndex)))
+	default:
+		word := atomic.LoadUint32(b.word32(byteIndex))
+		return uint64(word>>subWordShift(byteIndex, elementSize)) & elementMask(elementSize)
+	}
+}
+
+// modifyBits atomically replaces an aligned element with modify(old), returning the old bits.
+func (b *SharedDataBlock) modifyBits(byteIndex uint, elementSize uint, modify func(old uint64) uint64) uint64 {
+	mask := elementMask(elementSize)
+
+	switch elementSize {
+	case 8:
+		address := b.word64(byteIndex)
+		for {
+			old := atomic.LoadUint64(address)
+			if atomic.CompareAndSwapUint64(address, old, modify(old)) {
+				return old
+			}
+		}
+	case 4:
+		address := b.word32(byteIndex)
+		for {
+			old := atomic.LoadUint32(address)
+			if atomic.CompareAndSwapUint32(address, old, uint32(modify(uint64(old))&mask)) {
+				return uint64(old)
+			}
+		}
+	default:
+		address := b.word32(byteIndex)
+		shift := subWordShift(byteIndex, elementSize)
+		for {
+			word := atomic.LoadUint32(address)
+			old := uint64(word>>shift) & mask
+			newWord := word&^(uint32(mask)<<shift) | uint32(modify(old)&mask)<<shift
+			if atomic.CompareAndSwapUint32(address, word, newWord) {
+				return old
+			}
+		}
+	}
+}
+
+// Load reads elementSize bytes at byteIndex in memory order. Aligned accesses never tear.
+func (b *SharedDataBlock) Load(byteIndex uint, elementSize uint) []byte {
+	rawBytes := make([]byte, elementSize)
+	if !b.isAligned(byteIndex, elementSize) {
+		for idx := range rawBytes {
+			rawBytes[idx] = byte(atomic.LoadUint32(b.word32(byteIndex+uint(idx))) >> subWordShift(byteIndex+uint(idx), 1))
+		}
+		return rawBytes
+	}
+
+	putElementBits(rawBytes, b.loadBits(byteIndex, elementSize))
+	return rawBytes
+}
+
+// Store writes rawBytes at byteIndex in memory order. Aligned accesses never tear.
+func (b *SharedDataBlock) Store(byteIndex uint, rawBytes []byte) {
+	elementSize := uint(len(rawBytes))
+	if !b.isAligned(byteIndex, elementSize) {
+		for idx, value := range rawBytes {
+			b.modifyBits(byteIndex+uint(idx), 1, func(uint64) uint64 { return uint64(value) })
+		}
+		return
+	}
+
+	bits := elementBits(rawBytes)
+	b.modifyBits(byteIndex, elementSize, func(uint64) uint64 { return bits })
+}
+
+func elementMask(elementSize uint) uint64 {
+	if elementSize >= 8 {
+		return math.MaxUint64
+	}
+	return (uint64(1) << (elementSize * 8)) - 1
+}
+
+// elementBits interprets raw bytes in memory order as an unsigned integer in host byte order.
+func elementBits(rawBytes []byte) uint64 {
+	switch len(rawBytes) {
+	case 1:
+		return uint64(rawBytes[0])
+	case 2:
+		return uint64(binary.NativeEndian.Uint16(rawBytes))
+	case 4:
+		return uint64(binary.NativeEndian.Uint32(rawBytes))
+	case 8:
+		return binary.NativeEndian.Uint64(rawBytes)
+	}
+	panic("Assert failed: Unsupported element size.")
+}
+
+func putElementBits(rawBytes []byte, bits uint64) {
+	switch len(rawBytes) {
+	case 1:
+		rawBytes[0] = byte(bits)
+	case 2:
+		binary.NativeEndian.PutUint16(rawBytes, uint16(bits))
+	case 4:
+		binary.NativeEndian.PutUint32(rawBytes, uint32(bits))
+	case 8:
+		binary.NativeEndian.PutUint64(rawBytes, bits)
+	default:
+		panic("Assert failed: Unsupported element size.")
+	}
+}
+
+func AllocateSharedArrayBuffer(runtime *Runtime, constructor FunctionInterface, byteLength uint, maxByteLength *uint) *Completion {
+	allocatingGrowableBuffer := maxByteLength != nil
+	if allocatingGrowableBuffer && byteLength > *maxByteLength {
+		return NewThrowCompletion(NewRangeError(runtime, "SharedArrayBuffer length exceeds maxByteLength"))
+	}
+
+	completion := OrdinaryCreateFromConstructor(runtime, constructor, IntrinsicSharedArrayBufferPrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	objectVal := completion.Value.(*JavaScriptValue)
+	obj := objectVal.Value.(*Object)
+
+	if float64(byteLength) > math.Pow(2, 53)-1 {
+		return NewThrowCompletion(NewRangeError(runtime, "SharedArrayBuffer length too large"))
+	}
+
+	// A growable block is allocated at its maximum length up front.
+	allocatedLength := byteLength
+	if allocatingGrowableBuffer {
+		allocatedLength = *maxByteLength
+	}
+
+	if completion := runtime.CheckArrayBufferLength(allocatedLength); completion != nil {
+		return completion
+	}
+
+	var block *SharedDataBlock
+	if allocatingGrowableBuffer {
+		block = NewSharedDataBlock(byteLength, *maxByteLength, true)
+	} else {
+		block = NewSharedDataBlock(byteLength, byteLength, false)
+	}
+	runtime.trackArrayBuffer(block.Data)
+
+	attachSharedDataBlock(obj, block)
+	return NewNormalCompletion(objectVal)
+}
+
+// NewSharedArrayBufferFromDataBlock wraps an existing block in a SharedArrayBuffer of the running realm.
+// This is how a block created by one agent is handed to another agent.
+func NewSharedArrayBufferFromDataBlock(runtime *Runtime, block *SharedDataBlock) *JavaScriptValue {
+	prototype := runtime.GetRunningRealm().GetIntrinsic(IntrinsicSharedArrayBufferPrototype)
+	obj := OrdinaryObjectCreate(prototype).(*Object)
+	attachSharedDataBlock(obj, block)
+
+	return NewJavaScriptValue(TypeObject, obj)
+}
+
+// GetSharedDataBlock returns the block behind a SharedArrayBuffer value.
+func GetSharedDataBlock(value *JavaScriptValue) (*SharedDataBlock, bool) {
+	if value.Type != TypeObject {
+		return nil, false
+	}
+
+	obj, ok := value.Value.(*Object)
+	if !ok || !IsSharedArrayBuffer(obj) {
+		return nil, false
+	}
+
+	return obj.ArrayBufferSharedDataBlock, true
+}
+
+func attachSharedDataBlock(obj *Object, block *SharedDataBlock) {
+	obj.IsArrayBuffer = true
+	obj.ArrayBufferData = block.Data
+	obj.ArrayBufferDataIsShared = true
+	obj.ArrayBufferSharedDataBlock = block
+	obj.ArrayBufferHasMaxByteLength = block.Growable
+	obj.ArrayBufferMaxByteLength = block.MaxByteLength
+}
+
+func IsGrowableSharedArrayBuffer(object *Object) bool {
+	return IsSharedArrayBuffer(object) && !IsFixedLengthArrayBuffer(object)
+}
+
+func thisSharedArrayBufferValue(runtime *Runtime, value *JavaScriptValue, methodName string) (*Object, *Completion) {
+	if value.Type == TypeObject {
+		if object, ok := value.Value.(*Object); ok && IsSharedArrayBuffer(object) {
+			return object, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "SharedArrayBuffer.prototype."+methodName+" called on incompatible receiver"))
+}
@@ -0,0 +1,79 @@
+package runtime
+
+func NewSharedArrayBufferConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		SharedArrayBufferConstructor,
+		1,
+		NewStringValue("SharedArrayBuffer"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// SharedArrayBuffer.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicSharedArrayBufferPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	// SharedArrayBuffer[%Symbol.species%]
+	DefineBuiltinSymbolAccessorFunction(runtime, constructor, runtime.SymbolSpecies, SharedArrayBufferSpeciesGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	return constructor
+}
+
+func SharedArrayBufferConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "SharedArrayBuffer constructor requires 'new'"))
+	}
+
+	newTargetObj := newTarget.Value.(FunctionInterface)
+
+	completion := ToIndex(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	byteLength := uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+
+	completion = GetArrayBufferMaxByteLengthOption(runtime, arguments[1])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	if completion.Value != nil {
+		maxByteLength := uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+		return AllocateSharedArrayBuffer(runtime, newTargetObj, byteLength, &maxByteLength)
+	}
+
+	return AllocateSharedArrayBuffer(runtime, newTargetObj, byteLength, nil)
+}
+
+func SharedArrayBufferSpeciesGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return NewNormalCompletion(thisArg)
+}
@@ -0,0 +1,216 @@
+package runtime
+
+import "math"
+
+func NewSharedArrayBufferPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineSharedArrayBufferPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// SharedArrayBuffer.prototype.byteLength
+	DefineBuiltinAccessorFunction(runtime, prototype, "byteLength", SharedArrayBufferPrototypeByteLengthGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// SharedArrayBuffer.prototype.grow
+	DefineBuiltinFunction(runtime, prototype, "grow", SharedArrayBufferPrototypeGrow, 1)
+
+	// SharedArrayBuffer.prototype.growable
+	DefineBuiltinAccessorFunction(runtime, prototype, "growable", SharedArrayBufferPrototypeGrowableGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// SharedArrayBuffer.prototype.maxByteLength
+	DefineBuiltinAccessorFunction(runtime, prototype, "maxByteLength", SharedArrayBufferPrototypeMaxByteLengthGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// SharedArrayBuffer.prototype.slice
+	DefineBuiltinFunction(runtime, prototype, "slice", SharedArrayBufferPrototypeSlice, 2)
+
+	// SharedArrayBuffer.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("SharedArrayBuffer"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func SharedArrayBufferPrototypeByteLengthGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	obj, errCompletion := thisSharedArrayBufferValue(runtime, thisArg, "byteLength")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	return NewNormalCompletion(NewNumberValue(float64(ArrayBufferByteLength(obj, false)), false))
+}
+
+func SharedArrayBufferPrototypeGrow(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	obj, errCompletion := thisSharedArrayBufferValue(runtime, thisArg, "grow")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	if !IsGrowableSharedArrayBuffer(obj) {
+		return NewThrowCompletion(NewTypeError(runtime, "SharedArrayBuffer is not growable"))
+	}
+
+	completion := ToIndex(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	newByteLength := uint(completion.Value.(*JavaScriptValue).Value.(*Number).Value)
+	block := obj.ArrayBufferSharedDataBlock
+
+	if newByteLength > block.MaxByteLength {
+		return NewThrowCompletion(NewRangeError(runtime, "New length exceeds the maximum byte length"))
+	}
+
+	if !block.Grow(newByteLength) {
+		return NewThrowCompletion(NewRangeError(runtime, "SharedArrayBuffer cannot shrink"))
+	}
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+func SharedArrayBufferPrototypeGrowableGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	obj, errCompletion := thisSharedArrayBufferValue(runtime, thisArg, "growable")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(IsGrowableSharedArrayBuffer(obj)))
+}
+
+func SharedArrayBufferPrototypeMaxByteLengthGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	obj, errCompletion := thisSharedArrayBufferValue(runtime, thisArg, "maxByteLength")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	if IsGrowableSharedArrayBuffer(obj) {
+		return NewNormalCompletion(NewNumberValue(float64(obj.ArrayBufferMaxByteLength), false))
+	}
+
+	return NewNormalCompletion(NewNumberValue(float64(ArrayBufferByteLength(obj, false)), false))
+}
+
+func SharedArrayBufferPrototypeSlice(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	obj, errCompletion := thisSharedArrayBufferValue(runtime, thisArg, "slice")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	length := float64(ArrayBufferByteLength(obj, false))
+
+	completion := ToIntegerOrInfinity(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	relativeStart := completion.Value.(*JavaScriptValue).Value.(*Number).Value
+
+	var first float64
+	if relativeStart < 0 {
+		first = math.Max(length+relativeStart, 0)
+	} else {
+		first = math.Min(relativeStart, length)
+	}
+
+	relativeEnd := length
+	if arguments[1].Type != TypeUndefined {
+		completion = ToIntegerOrInfinity(runtime, arguments[1])
+		if completion.Type != Normal {
+			return completion
+		}
+		relativeEnd = completion.Value.(*JavaScriptValue).Value.(*Number).Value
+	}
+
+	var final float64
+	if relativeEnd < 0 {
+		final = math.Max(length+relativeEnd, 0)
+	} else {
+		final = math.Min(relativeEnd, length)
+	}
+
+	newLength := math.Max(final-first, 0)
+
+	defaultConstructor := runtime.GetRunningRealm().GetIntrinsic(IntrinsicSharedArrayBufferConstructor).(FunctionInterface)
+	completion = SpeciesConstructor(runtime, obj, defaultConstructor)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	constructor := completion.Value.(*JavaScriptValue).Value.(FunctionInterface)
+	completion = Construct(runtime, constructor, []*JavaScriptValue{NewNumberValue(newLength, false)}, nil)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	newVal := completion.Value.(*JavaScriptValue)
+	newObj, ok := newVal.Value.(*Object)
+	if !ok || !IsSharedArrayBuffer(newObj) {
+		return NewThrowCompletion(NewTypeError(runtime, "Species constructor did not return a SharedArrayBuffer"))
+	}
+
+	if newObj.ArrayBufferSharedDataBlock == obj.ArrayBufferSharedDataBlock {
+		return NewThrowCompletion(NewTypeError(runtime, "SharedArrayBuffer subclass returned this from species constructor"))
+	}
+
+	if float64(ArrayBufferByteLength(newObj, false)) < newLength {
+		return NewThrowCompletion(NewTypeError(runtime, "Species constructor returned a SharedArrayBuffer that is too small"))
+	}
+
+	fromBlock := obj.ArrayBufferSharedDataBlock
+	toBlock := newObj.ArrayBufferSharedDataBlock
+	for idx := uint(0); idx < uint(newLength); idx++ {
+		toBlock.Store(idx, fromBlock.Load(uint(first)+idx, 1))
+	}
+
+	return NewNormalCompletion(newVal)
+}
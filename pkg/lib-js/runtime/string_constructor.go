@@ -35,8 +35,8 @@ func StringConstructor(
 	if len(arguments) == 0 {
 		arguments = append(arguments, NewStringValue(""))
 	} else {
-		if newTarget != nil && newTarget.Type == TypeUndefined && arguments[0].Type == TypeSymbol {
-			panic("TODO: Support Symbol wrapping in String constructor.")
+		if (newTarget == nil || newTarget.Type == TypeUndefined) && arguments[0].Type == TypeSymbol {
+			return NewNormalCompletion(SymbolDescriptiveString(arguments[0].Value.(*Symbol)))
 		}
 		completion := ToString(runtime, arguments[0])
 		if completion.Type != Normal {
@@ -2,17 +2,15 @@ package runtime
 
 import (
 	"math"
-	"sort"
 	"strconv"
 	"unicode/utf8"
 )
 
 type StringObject struct {
-	Prototype        ObjectInterface
-	Properties       map[string]PropertyDescriptor
-	SymbolProperties map[*Symbol]PropertyDescriptor
-	Extensible       bool
-	PrivateElements  []*PrivateElement
+	Prototype       ObjectInterface
+	Properties      *PropertyStore
+	Extensible      bool
+	PrivateElements []*PrivateElement
 
 	StringData *JavaScriptValue
 }
@@ -23,12 +21,11 @@ func StringCreate(runtime *Runtime, value *JavaScriptValue, prototype ObjectInte
 	}
 
 	stringObject := &StringObject{
-		Prototype:        prototype,
-		Properties:       make(map[string]PropertyDescriptor),
-		SymbolProperties: make(map[*Symbol]PropertyDescriptor),
-		Extensible:       true,
-		PrivateElements:  make([]*PrivateElement, 0),
-		StringData:       value,
+		Prototype:       prototype,
+		Properties:      NewPropertyStore(),
+		Extensible:      true,
+		PrivateElements: make([]*PrivateElement, 0),
+		StringData:      value,
 	}
 
 	length := utf8.RuneCountInString(value.Value.(*String).Value)
@@ -50,22 +47,10 @@ func (o *StringObject) SetPrototype(prototype ObjectInterface) {
 	o.Prototype = prototype
 }
 
-func (o *StringObject) GetProperties() map[string]PropertyDescriptor {
+func (o *StringObject) GetProperties() *PropertyStore {
 	return o.Properties
 }
 
-func (o *StringObject) SetProperties(properties map[string]PropertyDescriptor) {
-	o.Properties = properties
-}
-
-func (o *StringObject) GetSymbolProperties() map[*Symbol]PropertyDescriptor {
-	return o.SymbolProperties
-}
-
-func (o *StringObject) SetSymbolProperties(symbolProperties map[*Symbol]PropertyDescriptor) {
-	o.SymbolProperties = symbolProperties
-}
-
 func (o *StringObject) IsExtensible(runtime *Runtime) *Completion {
 	return NewNormalCompletion(NewBooleanValue(o.Extensible))
 }
@@ -127,38 +112,15 @@ func (o *StringObject) OwnPropertyKeys(runtime *Runtime) *Completion {
 		keys = append(keys, NewStringValue(strconv.Itoa(i)))
 	}
 
-	arrayIndexKeys := make([]*JavaScriptValue, 0)
-	arrayIndices := make([]int, 0)
-	stringKeys := make([]*JavaScriptValue, 0)
-
-	for key := range o.Properties {
-		arrayKey, err := strconv.ParseInt(key, 10, 64)
-		if err != nil {
-			stringKeys = append(stringKeys, NewStringValue(key))
-			continue
-		}
-
-		if int(arrayKey) < len(stringData) {
-			continue
-		}
-
-		if arrayKey < 0 && float64(arrayKey) >= math.Pow(2, 32)-1 {
-			continue
+	// Indices of the string itself are listed first, so skip stored keys that would repeat them.
+	for _, key := range o.Properties.Keys() {
+		if key.Type == TypeString {
+			if index, ok := ArrayIndex(key.Value.(*String).Value); ok && index < int64(len(stringData)) {
+				continue
+			}
 		}
 
-		arrayIndexKeys = append(arrayIndexKeys, NewStringValue(key))
-		arrayIndices = append(arrayIndices, int(arrayKey))
-	}
-
-	sort.Slice(arrayIndexKeys, func(i, j int) bool {
-		return arrayIndices[i] < arrayIndices[j]
-	})
-
-	keys = append(keys, arrayIndexKeys...)
-	keys = append(keys, stringKeys...)
-
-	for key := range o.SymbolProperties {
-		keys = append(keys, NewJavaScriptValue(TypeSymbol, key))
+		keys = append(keys, key)
 	}
 
 	return NewNormalCompletion(keys)
@@ -1,13 +1,17 @@
 package runtime
 
+import (
+	"math"
+	"strings"
+)
+
 func NewStringPrototype(runtime *Runtime) ObjectInterface {
 	prototype := &StringObject{
-		Prototype:        runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype),
-		Properties:       make(map[string]PropertyDescriptor),
-		SymbolProperties: make(map[*Symbol]PropertyDescriptor),
-		StringData:       NewStringValue(""),
-		Extensible:       true,
-		PrivateElements:  make([]*PrivateElement, 0),
+		Prototype:       runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype),
+		Properties:      NewPropertyStore(),
+		StringData:      NewStringValue(""),
+		Extensible:      true,
+		PrivateElements: make([]*PrivateElement, 0),
 	}
 
 	DefinePropertyOrThrow(runtime, prototype, lengthStr, &DataPropertyDescriptor{
@@ -21,5 +25,104 @@ func NewStringPrototype(runtime *Runtime) ObjectInterface {
 }
 
 func DefineStringPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// String.prototype.localeCompare
+	DefineBuiltinFunction(runtime, prototype, "localeCompare", StringPrototypeLocaleCompare, 1)
+
+	// String.prototype.repeat
+	DefineBuiltinFunction(runtime, prototype, "repeat", StringPrototypeRepeat, 1)
+
 	// TODO: Define other properties.
 }
+
+// String.prototype.localeCompare(that, locales, options)
+func StringPrototypeLocaleCompare(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	completion := RequireObjectCoercible(runtime, thisArg)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = ToString(runtime, thisArg)
+	if completion.Type != Normal {
+		return completion
+	}
+	str := completion.Value.(*JavaScriptValue).Value.(*String).Value
+
+	completion = ToString(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+	that := completion.Value.(*JavaScriptValue).Value.(*String).Value
+
+	requested, options, completion := intlConstructorArguments(runtime, arguments[1:])
+	if completion != nil {
+		return completion
+	}
+
+	collator, completion := InitializeCollator(runtime, requested, options)
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewNumberValue(float64(CompareStrings(collator, str, that)), false))
+}
+
+func StringPrototypeRepeat(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	count := NewUndefinedValue()
+	if len(arguments) > 0 {
+		count = arguments[0]
+	}
+
+	completion := RequireObjectCoercible(runtime, thisArg)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = ToString(runtime, thisArg)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	str := completion.Value.(*JavaScriptValue).Value.(*String).Value
+
+	completion = ToIntegerOrInfinity(runtime, count)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	n := completion.Value.(*JavaScriptValue).Value.(*Number).Value
+	if n < 0 || math.IsInf(n, 1) {
+		return NewThrowCompletion(NewRangeError(runtime, "Invalid count value"))
+	}
+
+	if n == 0 || str == "" {
+		return NewNormalCompletion(NewStringValue(""))
+	}
+
+	if float64(len(str))*n > maxStringLength {
+		return NewThrowCompletion(NewRangeError(runtime, "Invalid string length"))
+	}
+
+	if completion := runtime.CheckStringLength(len(str) * int(n)); completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeString, runtime.trackString(&String{Value: strings.Repeat(str, int(n))})))
+}
@@ -0,0 +1,954 @@
+package runtime
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+	"reflect"
+	"slices"
+)
+
+// Every serialized value starts with a marker and the version of the format.
+const (
+	serializationMarker  byte = 0xFF
+	serializationVersion byte = 1
+)
+
+// The tags of serialized values. The format is stable: new kinds of values get new tags, existing tags never change
+// meaning, so data written by one version can be read by later ones.
+const (
+	serializedUndefined     byte = '_'
+	serializedNull          byte = '0'
+	serializedFalse         byte = 'F'
+	serializedTrue          byte = 'T'
+	serializedNumber        byte = 'N' // float64, little-endian.
+	serializedBigInt        byte = 'Z' // Sign byte, then the magnitude as a string of big-endian bytes.
+	serializedString        byte = 'S' // Length as a uvarint, then the bytes.
+	serializedReference     byte = 'R' // The uvarint index of an object serialized earlier, in the order they were met.
+	serializedObject        byte = 'o' // Key and value pairs, then serializedEnd.
+	serializedArray         byte = 'a' // Length as a uvarint, key and value pairs, then serializedEnd.
+	serializedEnd           byte = '}'
+	serializedBooleanObject byte = 'y' // serializedFalse or serializedTrue.
+	serializedNumberObject  byte = 'n' // Like serializedNumber.
+	serializedBigIntObject  byte = 'z' // Like serializedBigInt.
+	serializedStringObject  byte = 's' // Like serializedString.
+	serializedArrayBuffer   byte = 'B' // Flags, byte length, max byte length if resizable, then the bytes.
+	serializedTransferred   byte = 't' // The uvarint index of an ArrayBuffer in the transfer list.
+	serializedTypedArray    byte = 'V' // Constructor name, buffer, byte offset, flags, then the length unless it tracks the buffer.
+	serializedDataView      byte = 'D' // Buffer, byte offset, flags, then the byte length unless it tracks the buffer.
+	serializedError         byte = 'E' // Name, flags, then the message and the stack if the flags say so.
+)
+
+const (
+	serializedResizable  byte = 1 << 0
+	serializedLengthAuto byte = 1 << 0
+	serializedHasMessage byte = 1 << 0
+	serializedHasStack   byte = 1 << 1
+)
+
+// The error constructors whose instances keep their type when serialized. Other errors become plain Errors.
+var serializableErrorIntrinsics = map[string]Intrinsic{
+	"Error":          IntrinsicErrorConstructor,
+	"EvalError":      IntrinsicEvalErrorConstructor,
+	"RangeError":     IntrinsicRangeErrorConstructor,
+	"ReferenceError": IntrinsicReferenceErrorConstructor,
+	"SyntaxError":    IntrinsicSyntaxErrorConstructor,
+	"TypeError":      IntrinsicTypeErrorConstructor,
+	"URIError":       IntrinsicURIErrorConstructor,
+}
+
+// NewDataCloneError creates the error thrown for values that can't be serialized.
+func NewDataCloneError(runtime *Runtime, message string) *JavaScriptValue {
+	return NewNamedError(runtime, "DataCloneError", message)
+}
+
+// Serialize encodes a value with the structured serialize algorithm of HTML, in a stable binary format that
+// Deserialize decodes, in this or another runtime. Primitives other than symbols, plain objects, arrays, boxed
+// primitives, ArrayBuffers, typed arrays, DataViews and errors are supported, along with cycles and shared references
+// between them. Anything else throws a DataCloneError. The completion's value is the encoded []byte.
+func Serialize(runtime *Runtime, value *JavaScriptValue) *Completion {
+	return structuredSerialize(runtime, value, nil)
+}
+
+// Deserialize decodes data written by Serialize, creating the objects in the running realm. Malformed data throws a
+// DataCloneError.
+func Deserialize(runtime *Runtime, data []byte) *Completion {
+	return structuredDeserialize(runtime, data, nil)
+}
+
+// structuredClone(value, options)
+func StructuredClone(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	value := NewUndefinedValue()
+	if len(arguments) > 0 {
+		value = arguments[0]
+	}
+
+	transfer := []*Object{}
+	if len(arguments) > 1 && arguments[1].Type != TypeUndefined && arguments[1].Type != TypeNull {
+		if arguments[1].Type != TypeObject {
+			return NewThrowCompletion(NewTypeError(runtime, "The options argument must be an object."))
+		}
+
+		options := arguments[1].Value.(ObjectInterface)
+		completion := options.Get(runtime, NewStringValue("transfer"), arguments[1])
+		if completion.Type != Normal {
+			return completion
+		}
+
+		transferVal := completion.Value.(*JavaScriptValue)
+		if transferVal.Type != TypeUndefined {
+			completion = structuredCloneTransferList(runtime, transferVal)
+			if completion.Type != Normal {
+				return completion
+			}
+			transfer = completion.Value.([]*Object)
+		}
+	}
+
+	completion := structuredSerialize(runtime, value, transfer)
+	if completion.Type != Normal {
+		return completion
+	}
+	data := completion.Value.([]byte)
+
+	// Move the data of the transferred buffers into new ones, detaching the originals.
+	constructor := runtime.GetRunningRealm().GetIntrinsic(IntrinsicArrayBufferConstructor).(FunctionInterface)
+	transferred := make([]*JavaScriptValue, 0, len(transfer))
+	for _, buffer := range transfer {
+		byteData := buffer.ArrayBufferData[:buffer.ArrayBufferByteLength]
+		hasMaxByteLength := buffer.ArrayBufferHasMaxByteLength
+		maxByteLength := buffer.ArrayBufferMaxByteLength
+
+		completion := DetachArrayBuffer(runtime, buffer, NewUndefinedValue())
+		if completion.Type != Normal {
+			return completion
+		}
+
+		if hasMaxByteLength {
+			completion = AllocateArrayBufferWithMaxByteLength(runtime, constructor, uint(len(byteData)), maxByteLength)
+			if completion.Type != Normal {
+				return completion
+			}
+			copy(completion.Value.(*JavaScriptValue).Value.(*Object).ArrayBufferData, byteData)
+			transferred = append(transferred, completion.Value.(*JavaScriptValue))
+		} else {
+			transferred = append(transferred, NewArrayBufferFromBytes(runtime, byteData))
+		}
+	}
+
+	return structuredDeserialize(runtime, data, transferred)
+}
+
+// structuredCloneTransferList returns the ArrayBuffers of the transfer option of structuredClone.
+func structuredCloneTransferList(runtime *Runtime, transferVal *JavaScriptValue) *Completion {
+	completion := GetIterator(runtime, transferVal, IteratorKindSync)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = IteratorToList(runtime, completion.Value.(*Iterator))
+	if completion.Type != Normal {
+		return completion
+	}
+
+	transfer := []*Object{}
+	for _, value := range completion.Value.([]*JavaScriptValue) {
+		buffer, ok := value.Value.(*Object)
+		if value.Type != TypeObject || !ok || !buffer.IsArrayBuffer || IsSharedArrayBuffer(buffer) {
+			description := Inspect(runtime, value, &InspectOptions{Depth: 0})
+			return NewThrowCompletion(NewDataCloneError(runtime, description+" could not be transferred."))
+		}
+
+		if IsDetachedArrayBuffer(buffer) {
+			return NewThrowCompletion(NewDataCloneError(runtime, "An ArrayBuffer is detached and could not be transferred."))
+		}
+
+		if slices.Contains(transfer, buffer) {
+			return NewThrowCompletion(NewDataCloneError(runtime, "An ArrayBuffer is transferred more than once."))
+		}
+
+		transfer = append(transfer, buffer)
+	}
+
+	return NewNormalCompletion(transfer)
+}
+
+type serializer struct {
+	runtime *Runtime
+	data    []byte
+
+	// The objects serialized so far, with their index in the order they were met.
+	memory map[ObjectInterface]uint64
+
+	// The ArrayBuffers being transferred, with their index in the transfer list.
+	transfer map[*Object]uint64
+}
+
+func structuredSerialize(runtime *Runtime, value *JavaScriptValue, transfer []*Object) *Completion {
+	s := &serializer{
+		runtime:  runtime,
+		data:     []byte{serializationMarker, serializationVersion},
+		memory:   make(map[ObjectInterface]uint64),
+		transfer: make(map[*Object]uint64, len(transfer)),
+	}
+
+	for idx, buffer := range transfer {
+		s.transfer[buffer] = uint64(idx)
+	}
+
+	if completion := s.serialize(value); completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(s.data)
+}
+
+func (s *serializer) writeUvarint(value uint64) {
+	s.data = binary.AppendUvarint(s.data, value)
+}
+
+func (s *serializer) writeNumber(value *Number) {
+	bits := math.Float64bits(value.Value)
+	if value.NaN {
+		bits = math.Float64bits(math.NaN())
+	}
+	s.data = binary.LittleEndian.AppendUint64(s.data, bits)
+}
+
+func (s *serializer) writeBigInt(value *big.Int) {
+	if value.Sign() < 0 {
+		s.data = append(s.data, 1)
+	} else {
+		s.data = append(s.data, 0)
+	}
+	s.writeBytes(value.Bytes())
+}
+
+func (s *serializer) writeString(value string) {
+	s.writeUvarint(uint64(len(value)))
+	s.data = append(s.data, value...)
+}
+
+func (s *serializer) writeBytes(value []byte) {
+	s.writeUvarint(uint64(len(value)))
+	s.data = append(s.data, value...)
+}
+
+// serialize appends a value to the data. It returns nil, or the abrupt completion that stopped it.
+func (s *serializer) serialize(value *JavaScriptValue) *Completion {
+	switch value.Type {
+	case TypeUndefined:
+		s.data = append(s.data, serializedUndefined)
+		return nil
+	case TypeNull:
+		s.data = append(s.data, serializedNull)
+		return nil
+	case TypeBoolean:
+		if value.Value.(*Boolean).Value {
+			s.data = append(s.data, serializedTrue)
+		} else {
+			s.data = append(s.data, serializedFalse)
+		}
+		return nil
+	case TypeNumber:
+		s.data = append(s.data, serializedNumber)
+		s.writeNumber(value.Value.(*Number))
+		return nil
+	case TypeBigInt:
+		s.data = append(s.data, serializedBigInt)
+		s.writeBigInt(value.Value.(*BigInt).Value)
+		return nil
+	case TypeString:
+		s.data = append(s.data, serializedString)
+		s.writeString(value.Value.(*String).Value)
+		return nil
+	case TypeObject:
+		return s.serializeObject(value)
+	}
+
+	return s.cannotClone(value)
+}
+
+func (s *serializer) cannotClone(value *JavaScriptValue) *Completion {
+	description := Inspect(s.runtime, value, &InspectOptions{Depth: 0})
+	return NewThrowCompletion(NewDataCloneError(s.runtime, description+" could not be cloned."))
+}
+
+func (s *serializer) serializeObject(value *JavaScriptValue) *Completion {
+	object := value.Value.(ObjectInterface)
+	if index, ok := s.memory[object]; ok {
+		s.data = append(s.data, serializedReference)
+		s.writeUvarint(index)
+		return nil
+	}
+
+	if IsCallable(value) {
+		return s.cannotClone(value)
+	}
+
+	// Remember the object before serializing what it holds, so cycles become references to it.
+	s.memory[object] = uint64(len(s.memory))
+
+	switch o := object.(type) {
+	case *ArrayObject:
+		s.data = append(s.data, serializedArray)
+
+		completion := o.Get(s.runtime, lengthStr, value)
+		if completion.Type != Normal {
+			return completion
+		}
+		s.writeUvarint(uint64(completion.Value.(*JavaScriptValue).Value.(*Number).Value))
+
+		return s.serializeProperties(o)
+	case *StringObject:
+		s.data = append(s.data, serializedStringObject)
+		s.writeString(o.StringData.Value.(*String).Value)
+		return nil
+	case *TypedArrayObject:
+		return s.serializeTypedArray(value, o)
+	case *Object:
+		return s.serializeOrdinaryObject(value, o)
+	}
+
+	return s.cannotClone(value)
+}
+
+func (s *serializer) serializeOrdinaryObject(value *JavaScriptValue, object *Object) *Completion {
+	switch {
+	case object.BooleanData != nil:
+		s.data = append(s.data, serializedBooleanObject)
+		if object.BooleanData.Value.(*Boolean).Value {
+			s.data = append(s.data, serializedTrue)
+		} else {
+			s.data = append(s.data, serializedFalse)
+		}
+		return nil
+	case object.NumberData != nil:
+		s.data = append(s.data, serializedNumberObject)
+		s.writeNumber(object.NumberData.Value.(*Number))
+		return nil
+	case object.BigIntData != nil:
+		s.data = append(s.data, serializedBigIntObject)
+		s.writeBigInt(object.BigIntData.Value.(*BigInt).Value)
+		return nil
+	case object.IsArrayBuffer:
+		return s.serializeArrayBuffer(value, object)
+	case object.IsDataView:
+		return s.serializeDataView(value, object)
+	case object.IsError:
+		return s.serializeError(object)
+	case !isPlainObject(object):
+		return s.cannotClone(value)
+	}
+
+	s.data = append(s.data, serializedObject)
+	return s.serializeProperties(object)
+}
+
+// isPlainObject reports whether an object has no internal slots other than [[Prototype]], [[Extensible]] and
+// [[PrivateElements]], which are all its properties need to be cloned. Objects with other slots, including ones added
+// after this was written, can't be cloned unless they are handled explicitly.
+func isPlainObject(object *Object) bool {
+	slots := *object
+	slots.Prototype = nil
+	slots.Properties = nil
+	slots.Extensible = false
+	slots.PrivateElements = nil
+	return reflect.ValueOf(slots).IsZero()
+}
+
+// serializeProperties appends the enumerable own string-keyed properties of an object, followed by serializedEnd.
+func (s *serializer) serializeProperties(object ObjectInterface) *Completion {
+	completion := EnumerableOwnProperties(s.runtime, object, EnumerableOwnPropertiesKindKey)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	objectVal := NewJavaScriptValue(TypeObject, object)
+	for _, key := range completion.Value.([]*JavaScriptValue) {
+		// Getters of earlier properties may have deleted this one.
+		completion := HasOwnProperty(s.runtime, object, key)
+		if completion.Type != Normal {
+			return completion
+		}
+		if !completion.Value.(*JavaScriptValue).Value.(*Boolean).Value {
+			continue
+		}
+
+		completion = object.Get(s.runtime, key, objectVal)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		s.data = append(s.data, serializedString)
+		s.writeString(key.Value.(*String).Value)
+		if completion := s.serialize(completion.Value.(*JavaScriptValue)); completion != nil {
+			return completion
+		}
+	}
+
+	s.data = append(s.data, serializedEnd)
+	return nil
+}
+
+func (s *serializer) serializeArrayBuffer(value *JavaScriptValue, buffer *Object) *Completion {
+	if IsSharedArrayBuffer(buffer) {
+		return s.cannotClone(value)
+	}
+
+	if IsDetachedArrayBuffer(buffer) {
+		return NewThrowCompletion(NewDataCloneError(s.runtime, "An ArrayBuffer is detached and could not be cloned."))
+	}
+
+	if index, ok := s.transfer[buffer]; ok {
+		s.data = append(s.data, serializedTransferred)
+		s.writeUvarint(index)
+		return nil
+	}
+
+	s.data = append(s.data, serializedArrayBuffer)
+	if buffer.ArrayBufferHasMaxByteLength {
+		s.data = append(s.data, serializedResizable)
+		s.writeUvarint(uint64(buffer.ArrayBufferByteLength))
+		s.writeUvarint(uint64(buffer.ArrayBufferMaxByteLength))
+	} else {
+		s.data = append(s.data, 0)
+		s.writeUvarint(uint64(buffer.ArrayBufferByteLength))
+	}
+
+	s.data = append(s.data, buffer.ArrayBufferData[:buffer.ArrayBufferByteLength]...)
+	return nil
+}
+
+func (s *serializer) serializeTypedArray(value *JavaScriptValue, typedArray *TypedArrayObject) *Completion {
+	if MakeTypedArrayWithBufferWitness(typedArray, false).IsTypedArrayOutOfBounds() {
+		return NewThrowCompletion(NewDataCloneError(s.runtime, "A typed array is out of bounds and could not be cloned."))
+	}
+
+	s.data = append(s.data, serializedTypedArray)
+	s.writeString(string(typedArray.TypedArrayName))
+
+	if completion := s.serializeObject(NewJavaScriptValue(TypeObject, typedArray.ViewedArrayBuffer)); completion != nil {
+		return completion
+	}
+
+	s.writeUvarint(uint64(typedArray.ByteOffset))
+	if typedArray.ArrayLengthAuto {
+		s.data = append(s.data, serializedLengthAuto)
+	} else {
+		s.data = append(s.data, 0)
+		s.writeUvarint(uint64(typedArray.ArrayLength))
+	}
+
+	return nil
+}
+
+func (s *serializer) serializeDataView(value *JavaScriptValue, view *Object) *Completion {
+	if MakeDataViewWithBufferWitnessRecord(view, false).IsViewOutOfBounds() {
+		return NewThrowCompletion(NewDataCloneError(s.runtime, "A DataView is out of bounds and could not be cloned."))
+	}
+
+	s.data = append(s.data, serializedDataView)
+
+	if completion := s.serializeObject(NewJavaScriptValue(TypeObject, view.DataViewViewedArrayBuffer)); completion != nil {
+		return completion
+	}
+
+	s.writeUvarint(uint64(view.DataViewByteOffset))
+	if view.DataViewByteLengthAuto {
+		s.data = append(s.data, serializedLengthAuto)
+	} else {
+		s.data = append(s.data, 0)
+		s.writeUvarint(uint64(view.DataViewByteLength))
+	}
+
+	return nil
+}
+
+func (s *serializer) serializeError(object *Object) *Completion {
+	objectVal := NewJavaScriptValue(TypeObject, object)
+
+	completion := object.Get(s.runtime, nameStr, objectVal)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	name := "Error"
+	if nameVal := completion.Value.(*JavaScriptValue); nameVal.Type == TypeString {
+		if _, ok := serializableErrorIntrinsics[nameVal.Value.(*String).Value]; ok {
+			name = nameVal.Value.(*String).Value
+		}
+	}
+
+	flags := byte(0)
+
+	message := ""
+	if descriptor, ok := ownDataProperty(s.runtime, object, messageStr); ok {
+		completion := ToString(s.runtime, descriptor.Value)
+		if completion.Type != Normal {
+			return completion
+		}
+		message = completion.Value.(*JavaScriptValue).Value.(*String).Value
+		flags |= serializedHasMessage
+	}
+
+	stack := ""
+	if descriptor, ok := ownDataProperty(s.runtime, object, stackStr); ok && descriptor.Value.Type == TypeString {
+		stack = descriptor.Value.Value.(*String).Value
+		flags |= serializedHasStack
+	}
+
+	s.data = append(s.data, serializedError)
+	s.writeString(name)
+	s.data = append(s.data, flags)
+	if flags&serializedHasMessage != 0 {
+		s.writeString(message)
+	}
+	if flags&serializedHasStack != 0 {
+		s.writeString(stack)
+	}
+
+	return nil
+}
+
+// ownDataProperty returns an own data property of an ordinary object, without calling into scripts.
+func ownDataProperty(runtime *Runtime, object *Object, key *JavaScriptValue) (*DataPropertyDescriptor, bool) {
+	completion := OrdinaryGetOwnProperty(runtime, object, key)
+	if completion.Type != Normal || completion.Value == nil {
+		return nil, false
+	}
+
+	descriptor, ok := completion.Value.(*DataPropertyDescriptor)
+	return descriptor, ok
+}
+
+type deserializer struct {
+	runtime *Runtime
+	data    []byte
+	offset  int
+
+	// The objects created so far, in the order they were serialized.
+	memory []*JavaScriptValue
+
+	// The ArrayBuffers that were transferred, by their index in the transfer list.
+	transferred []*JavaScriptValue
+}
+
+// errMalformed is returned by the readers of the deserializer when the data ends early or doesn't make sense.
+var errMalformed = &Completion{}
+
+func structuredDeserialize(runtime *Runtime, data []byte, transferred []*JavaScriptValue) *Completion {
+	d := &deserializer{
+		runtime:     runtime,
+		data:        data,
+		transferred: transferred,
+	}
+
+	if len(data) < 2 || data[0] != serializationMarker || data[1] > serializationVersion {
+		return d.malformed()
+	}
+	d.offset = 2
+
+	value, completion := d.deserialize()
+	if completion == nil && d.offset != len(d.data) {
+		completion = errMalformed
+	}
+	if completion == errMalformed {
+		return d.malformed()
+	}
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(value)
+}
+
+func (d *deserializer) malformed() *Completion {
+	return NewThrowCompletion(NewDataCloneError(d.runtime, "Unable to deserialize cloned data."))
+}
+
+func (d *deserializer) readByte() (byte, *Completion) {
+	if d.offset >= len(d.data) {
+		return 0, errMalformed
+	}
+	d.offset++
+	return d.data[d.offset-1], nil
+}
+
+func (d *deserializer) readUvarint() (uint64, *Completion) {
+	value, n := binary.Uvarint(d.data[d.offset:])
+	if n <= 0 {
+		return 0, errMalformed
+	}
+	d.offset += n
+	return value, nil
+}
+
+// readLength reads a uvarint that must not be larger than limit.
+func (d *deserializer) readLength(limit uint64) (uint, *Completion) {
+	value, completion := d.readUvarint()
+	if completion != nil {
+		return 0, completion
+	}
+	if value > limit {
+		return 0, errMalformed
+	}
+	return uint(value), nil
+}
+
+func (d *deserializer) readBytes() ([]byte, *Completion) {
+	length, completion := d.readUvarint()
+	if completion != nil {
+		return nil, completion
+	}
+	if length > uint64(len(d.data)-d.offset) {
+		return nil, errMalformed
+	}
+	d.offset += int(length)
+	return d.data[d.offset-int(length) : d.offset], nil
+}
+
+func (d *deserializer) readString() (string, *Completion) {
+	value, completion := d.readBytes()
+	return string(value), completion
+}
+
+func (d *deserializer) readNumber() (*JavaScriptValue, *Completion) {
+	if len(d.data)-d.offset < 8 {
+		return nil, errMalformed
+	}
+
+	value := math.Float64frombits(binary.LittleEndian.Uint64(d.data[d.offset:]))
+	d.offset += 8
+
+	if math.IsNaN(value) {
+		return NewNaNNumberValue(), nil
+	}
+	return NewNumberValue(value, false), nil
+}
+
+func (d *deserializer) readBigInt() (*JavaScriptValue, *Completion) {
+	sign, completion := d.readByte()
+	if completion != nil {
+		return nil, completion
+	}
+
+	magnitude, completion := d.readBytes()
+	if completion != nil {
+		return nil, completion
+	}
+
+	value := new(big.Int).SetBytes(magnitude)
+	if sign == 1 {
+		value.Neg(value)
+	} else if sign != 0 {
+		return nil, errMalformed
+	}
+
+	return NewBigIntValue(value), nil
+}
+
+// remember records a deserialized object, so later references can refer to it.
+func (d *deserializer) remember(value *JavaScriptValue) *JavaScriptValue {
+	d.memory = append(d.memory, value)
+	return value
+}
+
+// deserialize reads a value. It returns errMalformed if the data is malformed, or the abrupt completion of creating
+// an object.
+func (d *deserializer) deserialize() (*JavaScriptValue, *Completion) {
+	if completion := d.runtime.CheckInterrupt(); completion != nil {
+		return nil, completion
+	}
+
+	tag, completion := d.readByte()
+	if completion != nil {
+		return nil, completion
+	}
+
+	switch tag {
+	case serializedUndefined:
+		return NewUndefinedValue(), nil
+	case serializedNull:
+		return NewNullValue(), nil
+	case serializedFalse:
+		return NewBooleanValue(false), nil
+	case serializedTrue:
+		return NewBooleanValue(true), nil
+	case serializedNumber:
+		return d.readNumber()
+	case serializedBigInt:
+		return d.readBigInt()
+	case serializedString:
+		value, completion := d.readString()
+		if completion != nil {
+			return nil, completion
+		}
+		return NewStringValue(value), nil
+	case serializedReference:
+		index, completion := d.readLength(uint64(len(d.memory)))
+		if completion != nil || index == uint(len(d.memory)) || d.memory[index] == nil {
+			return nil, errMalformed
+		}
+		return d.memory[index], nil
+	case serializedObject:
+		prototype := d.runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype)
+		object := d.remember(NewJavaScriptValue(TypeObject, OrdinaryObjectCreate(prototype)))
+		return object, d.deserializeProperties(object)
+	case serializedArray:
+		length, completion := d.readLength(math.MaxUint32)
+		if completion != nil {
+			return nil, completion
+		}
+
+		completion = ArrayCreate(d.runtime, length)
+		if completion.Type != Normal {
+			return nil, completion
+		}
+
+		array := d.remember(completion.Value.(*JavaScriptValue))
+		return array, d.deserializeProperties(array)
+	case serializedBooleanObject, serializedNumberObject, serializedBigIntObject, serializedStringObject:
+		return d.deserializeBoxedPrimitive(tag)
+	case serializedArrayBuffer:
+		return d.deserializeArrayBuffer()
+	case serializedTransferred:
+		index, completion := d.readLength(uint64(len(d.transferred)))
+		if completion != nil || index == uint(len(d.transferred)) {
+			return nil, errMalformed
+		}
+		return d.remember(d.transferred[index]), nil
+	case serializedTypedArray, serializedDataView:
+		return d.deserializeView(tag)
+	case serializedError:
+		return d.deserializeError()
+	}
+
+	return nil, errMalformed
+}
+
+func (d *deserializer) deserializeProperties(object *JavaScriptValue) *Completion {
+	for {
+		tag, completion := d.readByte()
+		if completion != nil {
+			return completion
+		}
+		if tag == serializedEnd {
+			return nil
+		}
+		if tag != serializedString {
+			return errMalformed
+		}
+
+		key, completion := d.readString()
+		if completion != nil {
+			return completion
+		}
+
+		value, completion := d.deserialize()
+		if completion != nil {
+			return completion
+		}
+
+		createCompletion := CreateDataProperty(d.runtime, object.Value.(ObjectInterface), NewStringValue(key), value)
+		if createCompletion.Type != Normal {
+			return createCompletion
+		}
+	}
+}
+
+func (d *deserializer) deserializeBoxedPrimitive(tag byte) (*JavaScriptValue, *Completion) {
+	var primitive *JavaScriptValue
+	var completion *Completion
+
+	switch tag {
+	case serializedBooleanObject:
+		var value byte
+		value, completion = d.readByte()
+		if completion == nil && value != serializedTrue && value != serializedFalse {
+			completion = errMalformed
+		}
+		primitive = NewBooleanValue(value == serializedTrue)
+	case serializedNumberObject:
+		primitive, completion = d.readNumber()
+	case serializedBigIntObject:
+		primitive, completion = d.readBigInt()
+	case serializedStringObject:
+		var value string
+		value, completion = d.readString()
+		primitive = NewStringValue(value)
+	}
+	if completion != nil {
+		return nil, completion
+	}
+
+	completion = ToObject(d.runtime, primitive)
+	if completion.Type != Normal {
+		return nil, completion
+	}
+
+	return d.remember(completion.Value.(*JavaScriptValue)), nil
+}
+
+func (d *deserializer) deserializeArrayBuffer() (*JavaScriptValue, *Completion) {
+	flags, completion := d.readByte()
+	if completion != nil {
+		return nil, completion
+	}
+
+	byteLength, completion := d.readLength(uint64(len(d.data)))
+	if completion != nil {
+		return nil, completion
+	}
+
+	constructor := d.runtime.GetRunningRealm().GetIntrinsic(IntrinsicArrayBufferConstructor).(FunctionInterface)
+
+	var bufferCompletion *Completion
+	switch flags {
+	case 0:
+		bufferCompletion = AllocateArrayBuffer(d.runtime, constructor, byteLength)
+	case serializedResizable:
+		maxByteLength, completion := d.readLength(math.MaxInt)
+		if completion != nil {
+			return nil, completion
+		}
+		bufferCompletion = AllocateArrayBufferWithMaxByteLength(d.runtime, constructor, byteLength, maxByteLength)
+	default:
+		return nil, errMalformed
+	}
+
+	if len(d.data)-d.offset < int(byteLength) {
+		return nil, errMalformed
+	}
+	if bufferCompletion.Type != Normal {
+		return nil, bufferCompletion
+	}
+
+	buffer := bufferCompletion.Value.(*JavaScriptValue)
+	copy(buffer.Value.(*Object).ArrayBufferData, d.data[d.offset:d.offset+int(byteLength)])
+	d.offset += int(byteLength)
+
+	return d.remember(buffer), nil
+}
+
+func (d *deserializer) deserializeView(tag byte) (*JavaScriptValue, *Completion) {
+	// The view is met before its buffer, so it takes its place in memory first.
+	index := len(d.memory)
+	d.memory = append(d.memory, nil)
+
+	var constructor Intrinsic
+	if tag == serializedTypedArray {
+		name, completion := d.readString()
+		if completion != nil {
+			return nil, completion
+		}
+
+		var ok bool
+		if constructor, ok = TypedArrayConstructorIntrinsics[TypedArrayName(name)]; !ok {
+			return nil, errMalformed
+		}
+	} else {
+		constructor = IntrinsicDataViewConstructor
+	}
+
+	buffer, completion := d.deserialize()
+	if completion != nil {
+		return nil, completion
+	}
+	if bufferObject, ok := buffer.Value.(*Object); !ok || !bufferObject.IsArrayBuffer {
+		return nil, errMalformed
+	}
+
+	byteOffset, completion := d.readLength(math.MaxInt)
+	if completion != nil {
+		return nil, completion
+	}
+
+	flags, completion := d.readByte()
+	if completion != nil {
+		return nil, completion
+	}
+
+	arguments := []*JavaScriptValue{buffer, NewNumberValue(float64(byteOffset), false)}
+	switch flags {
+	case 0:
+		length, completion := d.readLength(math.MaxInt)
+		if completion != nil {
+			return nil, completion
+		}
+		arguments = append(arguments, NewNumberValue(float64(length), false))
+	case serializedLengthAuto:
+	default:
+		return nil, errMalformed
+	}
+
+	realm := d.runtime.GetRunningRealm()
+	constructCompletion := Construct(d.runtime, realm.GetIntrinsic(constructor).(FunctionInterface), arguments, nil)
+	if constructCompletion.Type != Normal {
+		return nil, constructCompletion
+	}
+
+	view := constructCompletion.Value.(*JavaScriptValue)
+	d.memory[index] = view
+	return view, nil
+}
+
+func (d *deserializer) deserializeError() (*JavaScriptValue, *Completion) {
+	name, completion := d.readString()
+	if completion != nil {
+		return nil, completion
+	}
+
+	constructor, ok := serializableErrorIntrinsics[name]
+	if !ok {
+		return nil, errMalformed
+	}
+
+	flags, completion := d.readByte()
+	if completion != nil {
+		return nil, completion
+	}
+	if flags&^(serializedHasMessage|serializedHasStack) != 0 {
+		return nil, errMalformed
+	}
+
+	arguments := []*JavaScriptValue{}
+	if flags&serializedHasMessage != 0 {
+		message, completion := d.readString()
+		if completion != nil {
+			return nil, completion
+		}
+		arguments = append(arguments, NewStringValue(message))
+	}
+
+	realm := d.runtime.GetRunningRealm()
+	constructCompletion := Construct(d.runtime, realm.GetIntrinsic(constructor).(FunctionInterface), arguments, nil)
+	if constructCompletion.Type != Normal {
+		return nil, constructCompletion
+	}
+
+	errorVal := d.remember(constructCompletion.Value.(*JavaScriptValue))
+
+	if flags&serializedHasStack != 0 {
+		stack, completion := d.readString()
+		if completion != nil {
+			return nil, completion
+		}
+
+		// Keep the stack of the original error rather than where it was deserialized.
+		errorVal.Value.(*Object).DefineOwnProperty(d.runtime, stackStr, &DataPropertyDescriptor{
+			Value:        NewStringValue(stack),
+			Writable:     true,
+			Enumerable:   false,
+			Configurable: true,
+		})
+	}
+
+	return errorVal, nil
+}
@@ -0,0 +1,99 @@
+package runtime
+
+var (
+	errorStr      = NewStringValue("error")
+	suppressedStr = NewStringValue("suppressed")
+)
+
+func NewSuppressedErrorConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		SuppressedErrorConstructor,
+		3,
+		NewStringValue("SuppressedError"),
+		realm,
+		realm.GetIntrinsic(IntrinsicErrorConstructor),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// SuppressedError.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicSuppressedErrorPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+// SuppressedError is thrown when disposing of a resource fails while another error is already being thrown: "error"
+// is the error from the disposal and "suppressed" the one it replaced.
+func SuppressedErrorConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	for idx := range 3 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		newTarget = NewJavaScriptValue(TypeObject, function)
+	}
+
+	newTargetObj := newTarget.Value.(FunctionInterface)
+
+	completion := OrdinaryCreateFromConstructor(runtime, newTargetObj, IntrinsicSuppressedErrorPrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	objectVal := completion.Value.(*JavaScriptValue)
+	object := objectVal.Value.(*Object)
+
+	// Set [[ErrorData]] internal slot.
+	object.IsError = true
+
+	messageVal := arguments[2]
+
+	if messageVal.Type != TypeUndefined {
+		completion = ToString(runtime, messageVal)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		messageVal = completion.Value.(*JavaScriptValue)
+
+		completion = object.DefineOwnProperty(runtime, messageStr, &DataPropertyDescriptor{
+			Value:        messageVal,
+			Writable:     true,
+			Enumerable:   false,
+			Configurable: true,
+		})
+		if completion.Type != Normal {
+			panic("Assert failed: Failed to define 'message' property on SuppressedError object.")
+		}
+	}
+
+	for idx, key := range []*JavaScriptValue{errorStr, suppressedStr} {
+		completion = object.DefineOwnProperty(runtime, key, &DataPropertyDescriptor{
+			Value:        arguments[idx],
+			Writable:     true,
+			Enumerable:   false,
+			Configurable: true,
+		})
+		if completion.Type != Normal {
+			panic("Assert failed: Failed to define property on SuppressedError object.")
+		}
+	}
+
+	InstallErrorStack(runtime, object)
+
+	return NewNormalCompletion(objectVal)
+}
@@ -0,0 +1,23 @@
+package runtime
+
+func NewSuppressedErrorPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicErrorPrototype))
+}
+
+func DefineSuppressedErrorPrototypeProperties(runtime *Runtime, errorProto ObjectInterface) {
+	// SuppressedError.prototype.message
+	errorProto.DefineOwnProperty(runtime, messageStr, &DataPropertyDescriptor{
+		Value:        NewStringValue(""),
+		Writable:     true,
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// SuppressedError.prototype.name
+	errorProto.DefineOwnProperty(runtime, nameStr, &DataPropertyDescriptor{
+		Value:        NewStringValue("SuppressedError"),
+		Writable:     true,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
@@ -2,6 +2,9 @@ package runtime
 
 type Symbol struct {
 	Description string
+
+	// Whether [[Description]] is undefined, e.g. for Symbol() as opposed to Symbol("").
+	DescriptionIsUndefined bool
 }
 
 func NewSymbolValue(description string) *JavaScriptValue {
@@ -9,3 +12,66 @@ func NewSymbolValue(description string) *JavaScriptValue {
 		Description: description,
 	})
 }
+
+func NewSymbolValueWithoutDescription() *JavaScriptValue {
+	return NewJavaScriptValue(TypeSymbol, &Symbol{
+		DescriptionIsUndefined: true,
+	})
+}
+
+func (s *Symbol) DescriptionValue() *JavaScriptValue {
+	if s.DescriptionIsUndefined {
+		return NewUndefinedValue()
+	}
+	return NewStringValue(s.Description)
+}
+
+// SymbolRegistry corresponds to the GlobalSymbolRegistry in the spec.
+// It is shared by all realms of a Runtime, so Symbol.for returns the same symbol in each of them.
+type SymbolRegistry struct {
+	symbols map[string]*JavaScriptValue
+	keys    map[*Symbol]string
+}
+
+func NewSymbolRegistry() *SymbolRegistry {
+	return &SymbolRegistry{
+		symbols: map[string]*JavaScriptValue{},
+		keys:    map[*Symbol]string{},
+	}
+}
+
+// For returns the registered symbol for key, registering a new one if there isn't one yet.
+func (r *SymbolRegistry) For(key string) *JavaScriptValue {
+	if symbol, ok := r.symbols[key]; ok {
+		return symbol
+	}
+
+	symbol := NewSymbolValue(key)
+	r.symbols[key] = symbol
+	r.keys[symbol.Value.(*Symbol)] = key
+	return symbol
+}
+
+// KeyFor returns the key of a registered symbol, or false if the symbol is not registered.
+func (r *SymbolRegistry) KeyFor(symbol *Symbol) (string, bool) {
+	key, ok := r.keys[symbol]
+	return key, ok
+}
+
+func SymbolDescriptiveString(symbol *Symbol) *JavaScriptValue {
+	return NewStringValue("Symbol(" + symbol.Description + ")")
+}
+
+func ThisSymbolValue(runtime *Runtime, value *JavaScriptValue) *Completion {
+	if value.Type == TypeSymbol {
+		return NewNormalCompletion(value)
+	}
+
+	if value.Type == TypeObject {
+		if object, ok := value.Value.(*Object); ok && object.SymbolData != nil {
+			return NewNormalCompletion(object.SymbolData)
+		}
+	}
+
+	return NewThrowCompletion(NewTypeError(runtime, "Symbol.prototype method called on incompatible receiver"))
+}
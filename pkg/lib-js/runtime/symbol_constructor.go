@@ -12,6 +12,20 @@ func NewSymbolConstructor(runtime *Runtime) *FunctionObject {
 	)
 	MakeConstructor(runtime, constructor)
 
+	// Symbol.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicSymbolPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	// Symbol.for
+	DefineBuiltinFunction(runtime, constructor, "for", SymbolFor, 1)
+
+	// Symbol.keyFor
+	DefineBuiltinFunction(runtime, constructor, "keyFor", SymbolKeyFor, 1)
+
 	// Define well-known symbols.
 	DefineWellKnownSymbols(runtime, constructor, "toStringTag", runtime.SymbolToStringTag)
 	DefineWellKnownSymbols(runtime, constructor, "iterator", runtime.SymbolIterator)
@@ -20,8 +34,12 @@ func NewSymbolConstructor(runtime *Runtime) *FunctionObject {
 	DefineWellKnownSymbols(runtime, constructor, "hasInstance", runtime.SymbolHasInstance)
 	DefineWellKnownSymbols(runtime, constructor, "toPrimitive", runtime.SymbolToPrimitive)
 	DefineWellKnownSymbols(runtime, constructor, "isConcatSpreadable", runtime.SymbolConcatSpreadable)
-
-	// TODO: Define other properties.
+	DefineWellKnownSymbols(runtime, constructor, "asyncIterator", runtime.SymbolAsyncIterator)
+	DefineWellKnownSymbols(runtime, constructor, "match", runtime.SymbolMatch)
+	DefineWellKnownSymbols(runtime, constructor, "matchAll", runtime.SymbolMatchAll)
+	DefineWellKnownSymbols(runtime, constructor, "replace", runtime.SymbolReplace)
+	DefineWellKnownSymbols(runtime, constructor, "search", runtime.SymbolSearch)
+	DefineWellKnownSymbols(runtime, constructor, "split", runtime.SymbolSplit)
 
 	return constructor
 }
@@ -53,7 +71,7 @@ func SymbolConstructor(
 	description := arguments[0]
 
 	if description.Type == TypeUndefined {
-		return NewNormalCompletion(NewSymbolValue(""))
+		return NewNormalCompletion(NewSymbolValueWithoutDescription())
 	}
 
 	completion := ToString(runtime, description)
@@ -65,3 +83,46 @@ func SymbolConstructor(
 	descriptionString := description.Value.(*String).Value
 	return NewNormalCompletion(NewSymbolValue(descriptionString))
 }
+
+func SymbolFor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	completion := ToString(runtime, arguments[0])
+	if completion.Type != Normal {
+		return completion
+	}
+
+	key := completion.Value.(*JavaScriptValue).Value.(*String).Value
+	return NewNormalCompletion(runtime.SymbolRegistry.For(key))
+}
+
+func SymbolKeyFor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 {
+		arguments = append(arguments, NewUndefinedValue())
+	}
+
+	sym := arguments[0]
+	if sym.Type != TypeSymbol {
+		return NewThrowCompletion(NewTypeError(runtime, "Symbol.keyFor argument is not a symbol"))
+	}
+
+	if key, ok := runtime.SymbolRegistry.KeyFor(sym.Value.(*Symbol)); ok {
+		return NewNormalCompletion(NewStringValue(key))
+	}
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
@@ -0,0 +1,88 @@
+package runtime
+
+func NewSymbolPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineSymbolPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// Symbol.prototype.description
+	DefineBuiltinAccessorFunction(runtime, prototype, "description", SymbolPrototypeDescription, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// Symbol.prototype.toString
+	DefineBuiltinFunction(runtime, prototype, "toString", SymbolPrototypeToString, 0)
+
+	// Symbol.prototype.valueOf
+	DefineBuiltinFunction(runtime, prototype, "valueOf", SymbolPrototypeValueOf, 0)
+
+	// Symbol.prototype[%Symbol.toPrimitive%]
+	toPrimitive := CreateBuiltinFunction(runtime, SymbolPrototypeToPrimitive, 1, runtime.SymbolToPrimitive, nil, nil)
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToPrimitive, &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, toPrimitive),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// Symbol.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("Symbol"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func SymbolPrototypeDescription(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	completion := ThisSymbolValue(runtime, thisArg)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	symbol := completion.Value.(*JavaScriptValue).Value.(*Symbol)
+	return NewNormalCompletion(symbol.DescriptionValue())
+}
+
+func SymbolPrototypeToString(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	completion := ThisSymbolValue(runtime, thisArg)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return NewNormalCompletion(SymbolDescriptiveString(completion.Value.(*JavaScriptValue).Value.(*Symbol)))
+}
+
+func SymbolPrototypeValueOf(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return ThisSymbolValue(runtime, thisArg)
+}
+
+func SymbolPrototypeToPrimitive(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	// The hint argument is ignored.
+	return ThisSymbolValue(runtime, thisArg)
+}
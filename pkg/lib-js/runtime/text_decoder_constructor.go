@@ -0,0 +1,95 @@
+package runtime
+
+func NewTextDecoderConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		TextDecoderConstructor,
+		0,
+		NewStringValue("TextDecoder"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// TextDecoder.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicTextDecoderPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+// TextDecoder(label, options)
+func TextDecoderConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "TextDecoder constructor requires 'new'"))
+	}
+
+	label := string(TextEncodingUTF8)
+	if len(arguments) > 0 && arguments[0].Type != TypeUndefined {
+		completion := ToString(runtime, arguments[0])
+		if completion.Type != Normal {
+			return completion
+		}
+		label = completion.Value.(*JavaScriptValue).Value.(*String).Value
+	}
+
+	options := NewUndefinedValue()
+	if len(arguments) > 1 {
+		options = arguments[1]
+	}
+
+	fatal, completion := getBooleanOption(runtime, options, "fatal")
+	if completion != nil {
+		return completion
+	}
+
+	ignoreBOM, completion := getBooleanOption(runtime, options, "ignoreBOM")
+	if completion != nil {
+		return completion
+	}
+
+	encoding, ok := GetTextEncoding(label)
+	if !ok {
+		return NewThrowCompletion(NewRangeError(runtime, "The encoding label provided ('"+label+"') is invalid."))
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicTextDecoderPrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	decoderVal := createCompletion.Value.(*JavaScriptValue)
+	decoderVal.Value.(*Object).TextDecoder = NewTextDecoderState(encoding, fatal, ignoreBOM)
+
+	return NewNormalCompletion(decoderVal)
+}
+
+// getBooleanOption reads a boolean member of an options dictionary, which may be undefined or null to use the
+// defaults. It returns nil unless reading the member threw.
+func getBooleanOption(runtime *Runtime, options *JavaScriptValue, name string) (bool, *Completion) {
+	switch options.Type {
+	case TypeUndefined, TypeNull:
+		return false, nil
+	case TypeObject:
+	default:
+		return false, NewThrowCompletion(NewTypeError(runtime, "The options argument must be an object."))
+	}
+
+	completion := options.Value.(ObjectInterface).Get(runtime, NewStringValue(name), options)
+	if completion.Type != Normal {
+		return false, completion
+	}
+
+	return ToBoolean(completion.Value.(*JavaScriptValue)).Value.(*JavaScriptValue).Value.(*Boolean).Value, nil
+}
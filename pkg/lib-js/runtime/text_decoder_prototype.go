@@ -0,0 +1,139 @@
+package runtime
+
+func NewTextDecoderPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineTextDecoderPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// TextDecoder.prototype.encoding
+	DefineBuiltinAccessorFunction(runtime, prototype, "encoding", TextDecoderPrototypeEncodingGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// TextDecoder.prototype.fatal
+	DefineBuiltinAccessorFunction(runtime, prototype, "fatal", TextDecoderPrototypeFatalGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// TextDecoder.prototype.ignoreBOM
+	DefineBuiltinAccessorFunction(runtime, prototype, "ignoreBOM", TextDecoderPrototypeIgnoreBOMGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// TextDecoder.prototype.decode
+	DefineBuiltinFunction(runtime, prototype, "decode", TextDecoderPrototypeDecode, 0)
+
+	// TextDecoder.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("TextDecoder"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func thisTextDecoder(runtime *Runtime, thisArg *JavaScriptValue, method string) (*TextDecoderState, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.TextDecoder != nil {
+			return object.TextDecoder, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "TextDecoder.prototype."+method+" called on incompatible receiver"))
+}
+
+// get TextDecoder.prototype.encoding
+func TextDecoderPrototypeEncodingGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	decoder, errCompletion := thisTextDecoder(runtime, thisArg, "encoding")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	return NewNormalCompletion(NewStringValue(string(decoder.Encoding)))
+}
+
+// get TextDecoder.prototype.fatal
+func TextDecoderPrototypeFatalGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	decoder, errCompletion := thisTextDecoder(runtime, thisArg, "fatal")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(decoder.Fatal))
+}
+
+// get TextDecoder.prototype.ignoreBOM
+func TextDecoderPrototypeIgnoreBOMGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	decoder, errCompletion := thisTextDecoder(runtime, thisArg, "ignoreBOM")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(decoder.IgnoreBOM))
+}
+
+// TextDecoder.prototype.decode(input, options)
+func TextDecoderPrototypeDecode(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	decoder, errCompletion := thisTextDecoder(runtime, thisArg, "decode")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	input := []byte{}
+	if len(arguments) > 0 && arguments[0].Type != TypeUndefined {
+		var ok bool
+		if input, ok = BufferSourceBytes(arguments[0]); !ok {
+			return NewThrowCompletion(NewTypeError(runtime, "The input argument must be an ArrayBuffer or an ArrayBuffer view."))
+		}
+	}
+
+	options := NewUndefinedValue()
+	if len(arguments) > 1 {
+		options = arguments[1]
+	}
+
+	stream, errCompletion := getBooleanOption(runtime, options, "stream")
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	output, ok := decoder.Decode(input, stream)
+	if !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "The encoded data was not valid for encoding "+string(decoder.Encoding)+"."))
+	}
+
+	// The length of the output is only known once it is decoded, but it is at most a few times that of the input, which
+	// was already allowed.
+	if completion := runtime.CheckStringLength(len(output)); completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeString, runtime.trackString(&String{Value: output})))
+}
@@ -0,0 +1,46 @@
+package runtime
+
+func NewTextEncoderConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		TextEncoderConstructor,
+		0,
+		NewStringValue("TextEncoder"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// TextEncoder.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicTextEncoderPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+func TextEncoderConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "TextEncoder constructor requires 'new'"))
+	}
+
+	completion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicTextEncoderPrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	encoderVal := completion.Value.(*JavaScriptValue)
+	encoderVal.Value.(*Object).IsTextEncoder = true
+
+	return NewNormalCompletion(encoderVal)
+}
@@ -0,0 +1,135 @@
+package runtime
+
+import (
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+func NewTextEncoderPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineTextEncoderPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// TextEncoder.prototype.encoding
+	DefineBuiltinAccessorFunction(runtime, prototype, "encoding", TextEncoderPrototypeEncodingGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   false,
+		Configurable: true,
+	})
+
+	// TextEncoder.prototype.encode
+	DefineBuiltinFunction(runtime, prototype, "encode", TextEncoderPrototypeEncode, 0)
+
+	// TextEncoder.prototype.encodeInto
+	DefineBuiltinFunction(runtime, prototype, "encodeInto", TextEncoderPrototypeEncodeInto, 2)
+
+	// TextEncoder.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("TextEncoder"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func thisTextEncoder(thisArg *JavaScriptValue) bool {
+	if thisArg.Type != TypeObject {
+		return false
+	}
+
+	object, ok := thisArg.Value.(*Object)
+	return ok && object.IsTextEncoder
+}
+
+// get TextEncoder.prototype.encoding
+func TextEncoderPrototypeEncodingGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if !thisTextEncoder(thisArg) {
+		return NewThrowCompletion(NewTypeError(runtime, "TextEncoder.prototype.encoding called on incompatible receiver"))
+	}
+
+	return NewNormalCompletion(NewStringValue(string(TextEncodingUTF8)))
+}
+
+// TextEncoder.prototype.encode(input)
+func TextEncoderPrototypeEncode(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if !thisTextEncoder(thisArg) {
+		return NewThrowCompletion(NewTypeError(runtime, "TextEncoder.prototype.encode called on incompatible receiver"))
+	}
+
+	input := ""
+	if len(arguments) > 0 && arguments[0].Type != TypeUndefined {
+		completion := ToString(runtime, arguments[0])
+		if completion.Type != Normal {
+			return completion
+		}
+		input = completion.Value.(*JavaScriptValue).Value.(*String).Value
+	}
+
+	return NewNormalCompletion(NewUint8ArrayFromBytes(runtime, []byte(strings.ToValidUTF8(input, "\uFFFD"))))
+}
+
+// TextEncoder.prototype.encodeInto(source, destination)
+func TextEncoderPrototypeEncodeInto(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if !thisTextEncoder(thisArg) {
+		return NewThrowCompletion(NewTypeError(runtime, "TextEncoder.prototype.encodeInto called on incompatible receiver"))
+	}
+
+	sourceVal := NewUndefinedValue()
+	if len(arguments) > 0 {
+		sourceVal = arguments[0]
+	}
+
+	completion := ToString(runtime, sourceVal)
+	if completion.Type != Normal {
+		return completion
+	}
+	source := strings.ToValidUTF8(completion.Value.(*JavaScriptValue).Value.(*String).Value, "\uFFFD")
+
+	var destination []byte
+	if len(arguments) > 1 && arguments[1].Type == TypeObject {
+		if typedArray, ok := arguments[1].Value.(*TypedArrayObject); ok && typedArray.TypedArrayName == TypedArrayNameUint8 {
+			destination, _ = BufferSourceBytes(arguments[1])
+		}
+	}
+	if destination == nil {
+		return NewThrowCompletion(NewTypeError(runtime, "The destination argument must be a Uint8Array."))
+	}
+
+	// Whole characters are written until the next one doesn't fit. read counts UTF-16 code units, as the Encoding
+	// Standard says.
+	read, written := 0, 0
+	for _, char := range source {
+		size := utf8.RuneLen(char)
+		if written+size > len(destination) {
+			break
+		}
+
+		utf8.EncodeRune(destination[written:], char)
+		written += size
+		read += utf16.RuneLen(char)
+	}
+
+	result := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+	CreateDataProperty(runtime, result, NewStringValue("read"), NewNumberValue(float64(read), false))
+	CreateDataProperty(runtime, result, NewStringValue("written"), NewNumberValue(float64(written), false))
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, result))
+}
@@ -0,0 +1,240 @@
+package runtime
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// TextEncoding is an encoding of the Encoding Standard that TextDecoder supports, named by its canonical name.
+type TextEncoding string
+
+const (
+	TextEncodingUTF8    TextEncoding = "utf-8"
+	TextEncodingUTF16LE TextEncoding = "utf-16le"
+	TextEncodingUTF16BE TextEncoding = "utf-16be"
+)
+
+// The labels of the supported encodings.
+var textEncodingLabels = map[string]TextEncoding{
+	"unicode-1-1-utf-8": TextEncodingUTF8,
+	"unicode11utf8":     TextEncodingUTF8,
+	"unicode20utf8":     TextEncodingUTF8,
+	"utf-8":             TextEncodingUTF8,
+	"utf8":              TextEncodingUTF8,
+	"x-unicode20utf8":   TextEncodingUTF8,
+	"csunicode":         TextEncodingUTF16LE,
+	"iso-10646-ucs-2":   TextEncodingUTF16LE,
+	"ucs-2":             TextEncodingUTF16LE,
+	"unicode":           TextEncodingUTF16LE,
+	"unicodefeff":       TextEncodingUTF16LE,
+	"utf-16":            TextEncodingUTF16LE,
+	"utf-16le":          TextEncodingUTF16LE,
+	"unicodefffe":       TextEncodingUTF16BE,
+	"utf-16be":          TextEncodingUTF16BE,
+}
+
+// GetTextEncoding returns the encoding of a label, ignoring case and surrounding ASCII whitespace.
+func GetTextEncoding(label string) (TextEncoding, bool) {
+	encoding, ok := textEncodingLabels[strings.ToLower(strings.Trim(label, "\t\n\f\r "))]
+	return encoding, ok
+}
+
+// TextDecoderState is what a TextDecoder keeps between calls to decode made with the stream option.
+type TextDecoderState struct {
+	Encoding  TextEncoding
+	Fatal     bool
+	IgnoreBOM bool
+
+	// The bytes at the end of the last call that don't make up a whole character yet.
+	pending    []byte
+	bomSeen    bool
+	doNotFlush bool
+}
+
+func NewTextDecoderState(encoding TextEncoding, fatal bool, ignoreBOM bool) *TextDecoderState {
+	return &TextDecoderState{
+		Encoding:  encoding,
+		Fatal:     fatal,
+		IgnoreBOM: ignoreBOM,
+	}
+}
+
+// Decode decodes data, following on from the bytes left by the last call if it was made with stream set. Unless
+// stream is set, an incomplete character at the end is an error. It reports false if the data was invalid and the
+// decoder is fatal, otherwise invalid bytes are replaced with U+FFFD.
+func (d *TextDecoderState) Decode(data []byte, stream bool) (string, bool) {
+	if !d.doNotFlush {
+		d.pending = nil
+		d.bomSeen = false
+	}
+	d.doNotFlush = stream
+
+	if len(d.pending) > 0 {
+		data = append(d.pending, data...)
+	}
+
+	var output string
+	var rest []byte
+	var invalid bool
+	switch d.Encoding {
+	case TextEncodingUTF8:
+		output, rest, invalid = decodeUTF8(data, !stream)
+	case TextEncodingUTF16LE:
+		output, rest, invalid = decodeUTF16(data, false, !stream)
+	case TextEncodingUTF16BE:
+		output, rest, invalid = decodeUTF16(data, true, !stream)
+	}
+
+	// The data may belong to a buffer that scripts write to before the next call.
+	d.pending = slices.Clone(rest)
+
+	if invalid && d.Fatal {
+		d.pending = nil
+		d.doNotFlush = false
+		return "", false
+	}
+
+	if !d.IgnoreBOM && !d.bomSeen && len(output) > 0 {
+		output = strings.TrimPrefix(output, "\uFEFF")
+		d.bomSeen = true
+	}
+
+	return output, true
+}
+
+// decodeUTF8 decodes UTF-8, replacing each maximal subpart of an invalid sequence with U+FFFD like the Encoding
+// Standard does. An incomplete sequence at the end is invalid if flush is set, and is returned as the rest otherwise.
+// It reports whether the data was invalid.
+func decodeUTF8(data []byte, flush bool) (string, []byte, bool) {
+	var builder strings.Builder
+	invalid := false
+
+	idx := 0
+outer:
+	for idx < len(data) {
+		first := data[idx]
+		if first < utf8.RuneSelf {
+			builder.WriteByte(first)
+			idx++
+			continue
+		}
+
+		// The number of continuation bytes and the range of the first of them.
+		var needed int
+		lower, upper := byte(0x80), byte(0xBF)
+		switch {
+		case first >= 0xC2 && first <= 0xDF:
+			needed = 1
+		case first == 0xE0:
+			needed, lower = 2, 0xA0
+		case first == 0xED:
+			needed, upper = 2, 0x9F
+		case first >= 0xE1 && first <= 0xEF:
+			needed = 2
+		case first == 0xF0:
+			needed, lower = 3, 0x90
+		case first == 0xF4:
+			needed, upper = 3, 0x8F
+		case first >= 0xF1 && first <= 0xF3:
+			needed = 3
+		default:
+			builder.WriteRune(utf8.RuneError)
+			invalid = true
+			idx++
+			continue
+		}
+
+		end := idx + 1
+		for range needed {
+			if end >= len(data) {
+				if !flush {
+					return builder.String(), data[idx:], invalid
+				}
+
+				builder.WriteRune(utf8.RuneError)
+				invalid = true
+				break outer
+			}
+
+			if data[end] < lower || data[end] > upper {
+				// The byte that ended the sequence starts the next one.
+				builder.WriteRune(utf8.RuneError)
+				invalid = true
+				idx = end
+				continue outer
+			}
+
+			lower, upper = 0x80, 0xBF
+			end++
+		}
+
+		builder.Write(data[idx:end])
+		idx = end
+	}
+
+	return builder.String(), nil, invalid
+}
+
+// decodeUTF16 decodes UTF-16, replacing unpaired surrogates with U+FFFD. An incomplete code unit or surrogate pair at
+// the end is invalid if flush is set, and is returned as the rest otherwise. It reports whether the data was invalid.
+func decodeUTF16(data []byte, bigEndian bool, flush bool) (string, []byte, bool) {
+	var builder strings.Builder
+	invalid := false
+
+	codeUnit := func(idx int) rune {
+		if bigEndian {
+			return rune(data[idx])<<8 | rune(data[idx+1])
+		}
+		return rune(data[idx+1])<<8 | rune(data[idx])
+	}
+
+	idx := 0
+	for idx+1 < len(data) {
+		unit := codeUnit(idx)
+
+		switch {
+		case unit >= 0xD800 && unit <= 0xDBFF:
+			if idx+3 >= len(data) {
+				if !flush {
+					return builder.String(), data[idx:], invalid
+				}
+
+				builder.WriteRune(utf8.RuneError)
+				invalid = true
+				idx = len(data)
+				continue
+			}
+
+			trail := codeUnit(idx + 2)
+			if trail >= 0xDC00 && trail <= 0xDFFF {
+				builder.WriteRune(utf16.DecodeRune(unit, trail))
+				idx += 4
+			} else {
+				// The unit after the lead surrogate is decoded on its own.
+				builder.WriteRune(utf8.RuneError)
+				invalid = true
+				idx += 2
+			}
+		case unit >= 0xDC00 && unit <= 0xDFFF:
+			builder.WriteRune(utf8.RuneError)
+			invalid = true
+			idx += 2
+		default:
+			builder.WriteRune(unit)
+			idx += 2
+		}
+	}
+
+	if idx < len(data) {
+		if !flush {
+			return builder.String(), data[idx:], invalid
+		}
+
+		builder.WriteRune(utf8.RuneError)
+		invalid = true
+	}
+
+	return builder.String(), nil, invalid
+}
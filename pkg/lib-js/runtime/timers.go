@@ -0,0 +1,169 @@
+package runtime
+
+import (
+	"math"
+	"time"
+)
+
+// The longest timeout, in milliseconds. Longer ones run after 1ms, like in Node.
+const maxTimerDelay = math.MaxInt32
+
+// DefineTimerFunctions defines setTimeout, setInterval, setImmediate, queueMicrotask and the functions that cancel
+// them on a global object. The timers are run by Runtime.RunEventLoop.
+func DefineTimerFunctions(runtime *Runtime, globalObject ObjectInterface) {
+	DefineBuiltinFunction(runtime, globalObject, "setTimeout", SetTimeout, 1)
+	DefineBuiltinFunction(runtime, globalObject, "setInterval", SetInterval, 1)
+	DefineBuiltinFunction(runtime, globalObject, "clearTimeout", ClearTimer, 0)
+	DefineBuiltinFunction(runtime, globalObject, "clearInterval", ClearTimer, 0)
+	DefineBuiltinFunction(runtime, globalObject, "setImmediate", SetImmediate, 1)
+	DefineBuiltinFunction(runtime, globalObject, "clearImmediate", ClearImmediate, 0)
+	DefineBuiltinFunction(runtime, globalObject, "queueMicrotask", QueueMicrotask, 1)
+}
+
+// setTimeout(callback, delay, ...args)
+func SetTimeout(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return scheduleTimer(runtime, arguments, false)
+}
+
+// setInterval(callback, delay, ...args)
+func SetInterval(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	return scheduleTimer(runtime, arguments, true)
+}
+
+func scheduleTimer(runtime *Runtime, arguments []*JavaScriptValue, repeat bool) *Completion {
+	callback := NewUndefinedValue()
+	if len(arguments) > 0 {
+		callback = arguments[0]
+	}
+
+	if !IsCallable(callback) {
+		return NewThrowCompletion(NewTypeError(runtime, "Callback is not a function."))
+	}
+
+	delay := time.Duration(0)
+	if len(arguments) > 1 {
+		completion := ToNumber(runtime, arguments[1])
+		if completion.Type != Normal {
+			return completion
+		}
+
+		// NaN and negative delays run as soon as possible.
+		milliseconds := completion.Value.(*JavaScriptValue).Value.(*Number).Value
+		if milliseconds > maxTimerDelay {
+			milliseconds = 1
+		}
+		if milliseconds > 0 {
+			delay = time.Duration(milliseconds * float64(time.Millisecond))
+		}
+	}
+
+	var callbackArguments []*JavaScriptValue
+	if len(arguments) > 2 {
+		callbackArguments = arguments[2:]
+	}
+
+	id := runtime.addTimer(&timer{
+		when:      runtime.Clock.Now().Add(delay),
+		callback:  callback,
+		arguments: callbackArguments,
+		realm:     runtime.GetRunningRealm(),
+		repeat:    repeat,
+		interval:  delay,
+	})
+
+	return NewNormalCompletion(NewNumberValue(float64(id), false))
+}
+
+// clearTimeout(id) and clearInterval(id)
+func ClearTimer(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if id, ok := timerID(arguments); ok {
+		runtime.removeTimer(id)
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// setImmediate(callback, ...args)
+func SetImmediate(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 || !IsCallable(arguments[0]) {
+		return NewThrowCompletion(NewTypeError(runtime, "Callback is not a function."))
+	}
+
+	id := runtime.addImmediate(&immediate{
+		callback:  arguments[0],
+		arguments: arguments[1:],
+		realm:     runtime.GetRunningRealm(),
+	})
+
+	return NewNormalCompletion(NewNumberValue(float64(id), false))
+}
+
+// clearImmediate(id)
+func ClearImmediate(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if id, ok := timerID(arguments); ok {
+		runtime.removeImmediate(id)
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// timerID returns the id passed to one of the clear functions. Anything but a timer's id is ignored.
+func timerID(arguments []*JavaScriptValue) (int, bool) {
+	if len(arguments) == 0 || arguments[0].Type != TypeNumber {
+		return 0, false
+	}
+
+	id := arguments[0].Value.(*Number).Value
+	if id != math.Trunc(id) || id < 1 || id > math.MaxInt32 {
+		return 0, false
+	}
+	return int(id), true
+}
+
+// queueMicrotask(callback)
+func QueueMicrotask(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if len(arguments) == 0 || !IsCallable(arguments[0]) {
+		return NewThrowCompletion(NewTypeError(runtime, "Callback is not a function."))
+	}
+
+	callback := arguments[0]
+	runtime.HostEnqueueGenericJob(func(runtime *Runtime) *Completion {
+		return Call(runtime, callback, NewUndefinedValue(), []*JavaScriptValue{})
+	}, runtime.GetRunningRealm())
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
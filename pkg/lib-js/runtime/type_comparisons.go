@@ -10,30 +10,28 @@ func IsLessThan(runtime *Runtime, x *JavaScriptValue, y *JavaScriptValue, leftFi
 	var primitiveY *JavaScriptValue
 
 	if leftFirst {
-		// TODO: Prefer NUMBER primitive when that is supported.
-		primitiveXCompletion := ToPrimitive(runtime, x)
+		primitiveXCompletion := ToPrimitiveWithPreferredType(runtime, x, PreferredTypeNumber)
 		if primitiveXCompletion.Type != Normal {
 			return primitiveXCompletion
 		}
 
 		primitiveX = primitiveXCompletion.Value.(*JavaScriptValue)
 
-		// TODO: Prefer NUMBER primitive when that is supported.
-		primitiveYCompletion := ToPrimitive(runtime, y)
+		primitiveYCompletion := ToPrimitiveWithPreferredType(runtime, y, PreferredTypeNumber)
 		if primitiveYCompletion.Type != Normal {
 			return primitiveYCompletion
 		}
 
 		primitiveY = primitiveYCompletion.Value.(*JavaScriptValue)
 	} else {
-		primitiveYCompletion := ToPrimitive(runtime, y)
+		primitiveYCompletion := ToPrimitiveWithPreferredType(runtime, y, PreferredTypeNumber)
 		if primitiveYCompletion.Type != Normal {
 			return primitiveYCompletion
 		}
 
 		primitiveY = primitiveYCompletion.Value.(*JavaScriptValue)
 
-		primitiveXCompletion := ToPrimitive(runtime, x)
+		primitiveXCompletion := ToPrimitiveWithPreferredType(runtime, x, PreferredTypeNumber)
 		if primitiveXCompletion.Type != Normal {
 			return primitiveXCompletion
 		}
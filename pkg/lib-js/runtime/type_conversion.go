@@ -8,7 +8,12 @@ import (
 
 func ToNumeric(runtime *Runtime, value *JavaScriptValue) *Completion {
 	if value.Type == TypeObject {
-		panic("TODO: ToNumeric for Object values is not implemented.")
+		completion := ToPrimitiveWithPreferredType(runtime, value, PreferredTypeNumber)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		value = completion.Value.(*JavaScriptValue)
 	}
 
 	if value.Type == TypeBigInt {
@@ -55,7 +60,7 @@ func ToNumber(runtime *Runtime, value *JavaScriptValue) *Completion {
 	}
 
 	if value.Type == TypeObject {
-		completion := ToPrimitive(runtime, value)
+		completion := ToPrimitiveWithPreferredType(runtime, value, PreferredTypeNumber)
 		if completion.Type != Normal {
 			return completion
 		}
@@ -84,12 +89,32 @@ func ToString(runtime *Runtime, value *JavaScriptValue) *Completion {
 		return NewNormalCompletion(NewStringValue("null"))
 	}
 
+	if value.Type == TypeBoolean {
+		if value.Value.(*Boolean).Value {
+			return NewNormalCompletion(NewStringValue("true"))
+		}
+		return NewNormalCompletion(NewStringValue("false"))
+	}
+
 	if value.Type == TypeNumber {
 		return NewNormalCompletion(NumberToString(value.Value.(*Number), 10))
 	}
 
+	if value.Type == TypeBigInt {
+		return NewNormalCompletion(NewStringValue(value.Value.(*BigInt).Value.Text(10)))
+	}
+
+	if value.Type == TypeSymbol {
+		return NewThrowCompletion(NewTypeError(runtime, "Cannot convert a Symbol to a string"))
+	}
+
 	if value.Type == TypeObject {
-		return ToPrimitiveWithPreferredType(runtime, value, PreferredTypeString)
+		completion := ToPrimitiveWithPreferredType(runtime, value, PreferredTypeString)
+		if completion.Type != Normal {
+			return completion
+		}
+
+		return ToString(runtime, completion.Value.(*JavaScriptValue))
 	}
 
 	panic("TODO: ToString for non-String values is not implemented.")
@@ -116,7 +141,23 @@ func ToPrimitiveWithPreferredType(runtime *Runtime, value *JavaScriptValue, pref
 
 		method := completion.Value.(*JavaScriptValue)
 		if method.Type != TypeUndefined {
-			panic("TODO: ToPrimitive for Object values with Symbol.toPrimitive is not implemented.")
+			hint := NewStringValue("default")
+			if preferredType == PreferredTypeString {
+				hint = NewStringValue("string")
+			} else if preferredType == PreferredTypeNumber {
+				hint = NewStringValue("number")
+			}
+
+			completion := Call(runtime, method, value, []*JavaScriptValue{hint})
+			if completion.Type != Normal {
+				return completion
+			}
+
+			if completion.Value.(*JavaScriptValue).Type == TypeObject {
+				return NewThrowCompletion(NewTypeError(runtime, "Cannot convert object to primitive value."))
+			}
+
+			return completion
 		}
 
 		if preferredType == PreferredTypeUndefined {
@@ -227,6 +268,12 @@ func ToObject(runtime *Runtime, value *JavaScriptValue) *Completion {
 		return NewNormalCompletion(NewJavaScriptValue(TypeObject, object))
 	}
 
+	if value.Type == TypeSymbol {
+		object := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicSymbolPrototype))
+		object.(*Object).SymbolData = value
+		return NewNormalCompletion(NewJavaScriptValue(TypeObject, object))
+	}
+
 	panic("TODO: ToObject for non-Object values is not implemented.")
 }
 
@@ -236,10 +283,13 @@ func ToUint32(runtime *Runtime, value *JavaScriptValue) *Completion {
 		return numberCompletion
 	}
 
-	finalValue := math.Trunc(numberCompletion.Value.(*JavaScriptValue).Value.(*Number).Value)
-	finalValueUint64 := uint64(finalValue) % uint64(math.Pow(2, 32))
+	numberVal := numberCompletion.Value.(*JavaScriptValue).Value.(*Number)
+	if numberVal.NaN || math.IsInf(numberVal.Value, 0) {
+		return NewNormalCompletion(NewNumberValue(0, false))
+	}
 
-	return NewNormalCompletion(NewNumberValue(float64(finalValueUint64), false))
+	int32bit := integerModulo(truncate(numberVal.Value), 1<<32)
+	return NewNormalCompletion(NewNumberValue(int32bit, false))
 }
 
 func ToLength(runtime *Runtime, value *JavaScriptValue) *Completion {
@@ -268,14 +318,12 @@ func ToInt8(runtime *Runtime, value *JavaScriptValue) *Completion {
 		return NewNormalCompletion(NewNumberValue(0, false))
 	}
 
-	numberVal.Value = truncate(numberVal.Value)
-
-	int8bit := int(numberVal.Value) % 256
-	if int8bit >= 128 {
-		int8bit -= 256
+	int8bit := integerModulo(truncate(numberVal.Value), 1<<8)
+	if int8bit >= 1<<7 {
+		int8bit -= 1 << 8
 	}
 
-	return NewNormalCompletion(NewNumberValue(float64(int8bit), false))
+	return NewNormalCompletion(NewNumberValue(int8bit, false))
 }
 
 func ToUint8(runtime *Runtime, value *JavaScriptValue) *Completion {
@@ -290,11 +338,9 @@ func ToUint8(runtime *Runtime, value *JavaScriptValue) *Completion {
 		return NewNormalCompletion(NewNumberValue(0, false))
 	}
 
-	numberVal.Value = truncate(numberVal.Value)
-
-	int8bit := int(numberVal.Value) % 256
+	int8bit := integerModulo(truncate(numberVal.Value), 1<<8)
 
-	return NewNormalCompletion(NewNumberValue(float64(int8bit), false))
+	return NewNormalCompletion(NewNumberValue(int8bit, false))
 }
 
 func ToUint8Clamped(runtime *Runtime, value *JavaScriptValue) *Completion {
@@ -347,18 +393,12 @@ func ToInt16(runtime *Runtime, value *JavaScriptValue) *Completion {
 		return NewNormalCompletion(NewNumberValue(0, false))
 	}
 
-	numberVal.Value = truncate(numberVal.Value)
-
-	int16bit := int(numberVal.Value) % 65536
-	if int16bit < 0 {
-		int16bit += 65536
-	}
-
-	if int16bit >= 32768 {
-		return NewNormalCompletion(NewNumberValue(float64(int16bit-65536), false))
+	int16bit := integerModulo(truncate(numberVal.Value), 1<<16)
+	if int16bit >= 1<<15 {
+		int16bit -= 1 << 16
 	}
 
-	return NewNormalCompletion(NewNumberValue(float64(int16bit), false))
+	return NewNormalCompletion(NewNumberValue(int16bit, false))
 }
 
 func ToUint16(runtime *Runtime, value *JavaScriptValue) *Completion {
@@ -373,10 +413,9 @@ func ToUint16(runtime *Runtime, value *JavaScriptValue) *Completion {
 		return NewNormalCompletion(NewNumberValue(0, false))
 	}
 
-	numberVal.Value = truncate(numberVal.Value)
-	int16bit := int(numberVal.Value) % 65536
+	int16bit := integerModulo(truncate(numberVal.Value), 1<<16)
 
-	return NewNormalCompletion(NewNumberValue(float64(int16bit), false))
+	return NewNormalCompletion(NewNumberValue(int16bit, false))
 }
 
 func ToInt32(runtime *Runtime, value *JavaScriptValue) *Completion {
@@ -391,15 +430,12 @@ func ToInt32(runtime *Runtime, value *JavaScriptValue) *Completion {
 		return NewNormalCompletion(NewNumberValue(0, false))
 	}
 
-	numberVal.Value = truncate(numberVal.Value)
-
-	int32bit := int64(numberVal.Value) % (1 << 32)
-
-	if int32bit >= (1 << 31) {
-		return NewNormalCompletion(NewNumberValue(float64(int32bit-(1<<32)), false))
+	int32bit := integerModulo(truncate(numberVal.Value), 1<<32)
+	if int32bit >= 1<<31 {
+		int32bit -= 1 << 32
 	}
 
-	return NewNormalCompletion(NewNumberValue(float64(int32bit), false))
+	return NewNormalCompletion(NewNumberValue(int32bit, false))
 }
 
 func ToIntegerOrInfinity(runtime *Runtime, value *JavaScriptValue) *Completion {
@@ -431,6 +467,15 @@ func truncate(value float64) float64 {
 	return math.Floor(value)
 }
 
+// integerModulo computes "value modulo modulus" as defined by the spec, where the result always has the sign of the modulus.
+func integerModulo(value float64, modulus float64) float64 {
+	result := math.Mod(value, modulus)
+	if result < 0 {
+		result += modulus
+	}
+	return result
+}
+
 func CanonicalNumericIndexString(runtime *Runtime, value *JavaScriptValue) *JavaScriptValue {
 	if value.Type != TypeString {
 		panic("Assert failed: CanonicalNumericIndexString value is not a string.")
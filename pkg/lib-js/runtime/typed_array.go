@@ -44,6 +44,21 @@ var TypedArrayElementSizes = map[TypedArrayName]uint{
 	TypedArrayNameFloat64:      8,
 }
 
+var TypedArrayConstructorIntrinsics = map[TypedArrayName]Intrinsic{
+	TypedArrayNameInt8:         IntrinsicInt8ArrayConstructor,
+	TypedArrayNameUint8:        IntrinsicUint8ArrayConstructor,
+	TypedArrayNameUint8Clamped: IntrinsicUint8ClampedArrayConstructor,
+	TypedArrayNameInt16:        IntrinsicInt16ArrayConstructor,
+	TypedArrayNameUint16:       IntrinsicUint16ArrayConstructor,
+	TypedArrayNameInt32:        IntrinsicInt32ArrayConstructor,
+	TypedArrayNameUint32:       IntrinsicUint32ArrayConstructor,
+	TypedArrayNameBigInt64:     IntrinsicBigInt64ArrayConstructor,
+	TypedArrayNameBigUint64:    IntrinsicBigUint64ArrayConstructor,
+	TypedArrayNameFloat16:      IntrinsicFloat16ArrayConstructor,
+	TypedArrayNameFloat32:      IntrinsicFloat32ArrayConstructor,
+	TypedArrayNameFloat64:      IntrinsicFloat64ArrayConstructor,
+}
+
 type TypedArrayConversionFunction func(runtime *Runtime, value *JavaScriptValue) *Completion
 
 var TypedArrayConversionFunctions = map[TypedArrayName]TypedArrayConversionFunction{
@@ -59,11 +74,10 @@ var TypedArrayConversionFunctions = map[TypedArrayName]TypedArrayConversionFunct
 }
 
 type TypedArrayObject struct {
-	Prototype        ObjectInterface
-	Properties       map[string]PropertyDescriptor
-	SymbolProperties map[*Symbol]PropertyDescriptor
-	Extensible       bool
-	PrivateElements  []*PrivateElement
+	Prototype       ObjectInterface
+	Properties      *PropertyStore
+	Extensible      bool
+	PrivateElements []*PrivateElement
 
 	ViewedArrayBuffer *Object
 	ArrayLengthAuto   bool
@@ -257,11 +271,25 @@ func InitializeTypedArrayFromArrayBuffer(
 	return NewUnusedCompletion()
 }
 
+// NewUint8ArrayFromBytes creates a Uint8Array over a new ArrayBuffer that uses data as its backing store, without
+// copying it.
+func NewUint8ArrayFromBytes(runtime *Runtime, data []byte) *JavaScriptValue {
+	buffer := NewArrayBufferFromBytes(runtime, data).Value.(*Object)
+
+	obj := TypedArrayCreate(runtime, runtime.GetRunningRealm().GetIntrinsic(IntrinsicUint8ArrayPrototype))
+	obj.TypedArrayName = TypedArrayNameUint8
+	obj.ContentType = TypedArrayContentTypeNumber
+	obj.ViewedArrayBuffer = buffer
+	obj.ByteLength = buffer.ArrayBufferByteLength
+	obj.ArrayLength = buffer.ArrayBufferByteLength
+
+	return NewJavaScriptValue(TypeObject, obj)
+}
+
 func TypedArrayCreate(runtime *Runtime, prototype ObjectInterface) *TypedArrayObject {
 	return &TypedArrayObject{
 		Prototype:         prototype,
-		Properties:        make(map[string]PropertyDescriptor),
-		SymbolProperties:  make(map[*Symbol]PropertyDescriptor),
+		Properties:        NewPropertyStore(),
 		Extensible:        true,
 		PrivateElements:   make([]*PrivateElement, 0),
 		ViewedArrayBuffer: nil,
@@ -278,22 +306,10 @@ func (o *TypedArrayObject) SetPrototype(prototype ObjectInterface) {
 	o.Prototype = prototype
 }
 
-func (o *TypedArrayObject) GetProperties() map[string]PropertyDescriptor {
+func (o *TypedArrayObject) GetProperties() *PropertyStore {
 	return o.Properties
 }
 
-func (o *TypedArrayObject) SetProperties(properties map[string]PropertyDescriptor) {
-	o.Properties = properties
-}
-
-func (o *TypedArrayObject) GetSymbolProperties() map[*Symbol]PropertyDescriptor {
-	return o.SymbolProperties
-}
-
-func (o *TypedArrayObject) SetSymbolProperties(symbolProperties map[*Symbol]PropertyDescriptor) {
-	o.SymbolProperties = symbolProperties
-}
-
 func (o *TypedArrayObject) IsExtensible(runtime *Runtime) *Completion {
 	return NewNormalCompletion(NewBooleanValue(o.Extensible))
 }
@@ -433,13 +449,7 @@ func (o *TypedArrayObject) OwnPropertyKeys(runtime *Runtime) *Completion {
 		}
 	}
 
-	for key := range o.Properties {
-		keys = append(keys, NewStringValue(key))
-	}
-
-	for key := range o.SymbolProperties {
-		keys = append(keys, NewJavaScriptValue(TypeSymbol, key))
-	}
+	keys = append(keys, o.Properties.Keys()...)
 
 	return NewNormalCompletion(keys)
 }
@@ -88,7 +88,7 @@ func TypedArrayConstructor(
 
 		if _, ok := firstArg.Value.(*TypedArrayObject); ok {
 			panic("TODO: Implement TypedArray constructor with typed array argument.")
-		} else if firstArgObj, ok := firstArg.Value.(*Object); ok && firstArgObj.ArrayBufferData != nil {
+		} else if firstArgObj, ok := firstArg.Value.(*Object); ok && firstArgObj.IsArrayBuffer {
 			var byteOffset *JavaScriptValue
 			var length *JavaScriptValue
 
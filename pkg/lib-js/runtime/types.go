@@ -1,11 +1,5 @@
 package runtime
 
-import (
-	"fmt"
-	"strconv"
-	"strings"
-)
-
 type JavaScriptType int
 
 const (
@@ -41,100 +35,18 @@ type JavaScriptValue struct {
 	Value any
 }
 
-func ArrayToString(runtime *Runtime, v *JavaScriptValue) (string, *JavaScriptValue) {
-	array := v.Value.(*ArrayObject)
-	length := array.GetLength()
-	elements := []string{}
-	for i := range length {
-		element := array.Get(runtime, NewStringValue(strconv.Itoa(i)), v)
-		if element.Type != Normal {
-			return "error", element.Value.(*JavaScriptValue)
-		}
-
-		elementVal := element.Value.(*JavaScriptValue)
-		elementStr, err := elementVal.ToString(runtime)
-		if err != nil {
-			return "error", err
-		}
-
-		elements = append(elements, elementStr)
-	}
-	return fmt.Sprintf("[%s]", strings.Join(elements, ", ")), nil
-}
-
-func ObjectToString(runtime *Runtime, v *JavaScriptValue) (string, *JavaScriptValue) {
-	if _, ok := v.Value.(*ArrayObject); ok {
-		return ArrayToString(runtime, v)
-	}
-
-	object := v.Value.(ObjectInterface)
-	properties := []string{}
-
-	propertyToString := func(key string, value PropertyDescriptor) *JavaScriptValue {
-		// Skip the constructor property to avoid infinite recursion.
-		if key == "constructor" {
-			return nil
-		}
-
-		if dataDescriptor, ok := value.(*DataPropertyDescriptor); ok {
-			valueString, err := dataDescriptor.Value.ToString(runtime)
-			if err != nil {
-				return err
-			}
-			properties = append(properties, fmt.Sprintf("%s: %s", key, valueString))
-		} else {
-			// TODO: Support accessor property descriptors.
-		}
-
-		return nil
-	}
-
-	for key, value := range object.GetProperties() {
-		err := propertyToString(key, value)
-		if err != nil {
-			return "error", err
-		}
-	}
-
-	for key, value := range object.GetSymbolProperties() {
-		err := propertyToString(key.Description, value)
-		if err != nil {
-			return "error", err
+// ToString describes the value for humans with Inspect, e.g. to print the result of a script. References are resolved
+// first, in which case the error is returned if that throws.
+func (v *JavaScriptValue) ToString(runtime *Runtime) (string, *JavaScriptValue) {
+	if v.Type == TypeReference {
+		completion := GetValue(runtime, v)
+		if completion.Type != Normal {
+			return "error", completion.Value.(*JavaScriptValue)
 		}
+		v = completion.Value.(*JavaScriptValue)
 	}
 
-	return fmt.Sprintf("{%s}", strings.Join(properties, ", ")), nil
-}
-
-func ReferenceToString(runtime *Runtime, v *JavaScriptValue) (string, *JavaScriptValue) {
-	referenceVal := GetValue(runtime, v)
-	if referenceVal.Type != Normal {
-		return "error", referenceVal.Value.(*JavaScriptValue)
-	}
-	return referenceVal.Value.(*JavaScriptValue).ToString(runtime)
-}
-
-func (v *JavaScriptValue) ToString(runtime *Runtime) (string, *JavaScriptValue) {
-	switch v.Type {
-	case TypeString:
-		return fmt.Sprintf("'%s'", v.Value.(*String).Value), nil
-	case TypeSymbol:
-		return fmt.Sprintf("Symbol(%s)", v.Value.(*Symbol).Description), nil
-	case TypeNumber:
-		return fmt.Sprintf("%f", v.Value.(*Number).Value), nil
-	case TypeBoolean:
-		return fmt.Sprintf("%t", v.Value.(*Boolean).Value), nil
-	case TypeNull:
-		return "null", nil
-	case TypeUndefined:
-		return "undefined", nil
-	case TypeObject:
-		return ObjectToString(runtime, v)
-	case TypeReference:
-		return ReferenceToString(runtime, v)
-	default:
-		return "unknown", nil
-	}
+	return Inspect(runtime, v, nil), nil
 }
 
 func ErrorToString(runtime *Runtime, error *JavaScriptValue) string {
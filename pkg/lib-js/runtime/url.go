@@ -0,0 +1,1241 @@
+package runtime
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// URLRecord is a URL as the URL Standard parses it. ParseURL creates them, and String serializes them.
+type URLRecord struct {
+	Scheme   string
+	Username string
+	Password string
+
+	// The serialized host, e.g. "example.com", "127.0.0.1" or "[::1]". HasHost is false when the host is null, and
+	// an empty host is the empty string.
+	Host    string
+	HasHost bool
+
+	// The port, or -1 for none.
+	Port int
+
+	// The path segments. A URL with an opaque path, such as "mailto:someone@example.com", has a single segment
+	// holding all of it.
+	Path       []string
+	OpaquePath bool
+
+	// The query and the fragment, nil when they are null.
+	Query    *string
+	Fragment *string
+}
+
+// urlParserState is a state of the basic URL parser.
+type urlParserState int
+
+const (
+	urlStateNone urlParserState = iota
+	urlStateSchemeStart
+	urlStateScheme
+	urlStateNoScheme
+	urlStateSpecialRelativeOrAuthority
+	urlStatePathOrAuthority
+	urlStateRelative
+	urlStateRelativeSlash
+	urlStateSpecialAuthoritySlashes
+	urlStateSpecialAuthorityIgnoreSlashes
+	urlStateAuthority
+	urlStateHost
+	urlStateHostname
+	urlStatePort
+	urlStateFile
+	urlStateFileSlash
+	urlStateFileHost
+	urlStatePathStart
+	urlStatePath
+	urlStateOpaquePath
+	urlStateQuery
+	urlStateFragment
+)
+
+// The special schemes with their default ports, -1 for file.
+var specialSchemes = map[string]int{
+	"ftp":   21,
+	"file":  -1,
+	"http":  80,
+	"https": 443,
+	"ws":    80,
+	"wss":   443,
+}
+
+// urlEOF stands for the end of the input in the basic URL parser.
+const urlEOF = rune(-1)
+
+// ParseURL parses input against an optional base URL, as the URL constructor does. It reports false if input isn't
+// a valid URL.
+func ParseURL(input string, base *URLRecord) (*URLRecord, bool) {
+	return basicURLParse(input, base, nil, urlStateNone)
+}
+
+// IsSpecial reports whether the URL's scheme is one of the special schemes, whose URLs always have a host and a path.
+func (u *URLRecord) IsSpecial() bool {
+	_, ok := specialSchemes[u.Scheme]
+	return ok
+}
+
+// IncludesCredentials reports whether the URL has a username or a password.
+func (u *URLRecord) IncludesCredentials() bool {
+	return u.Username != "" || u.Password != ""
+}
+
+// CannotHaveUsernamePasswordPort reports whether the URL's host can't have credentials or a port.
+func (u *URLRecord) CannotHaveUsernamePasswordPort() bool {
+	return !u.HasHost || u.Host == "" || u.Scheme == "file"
+}
+
+// Clone returns a copy of the URL that doesn't share its path.
+func (u *URLRecord) Clone() *URLRecord {
+	clone := *u
+	clone.Path = slices.Clone(u.Path)
+	clone.Query = cloneURLComponent(u.Query)
+	clone.Fragment = cloneURLComponent(u.Fragment)
+	return &clone
+}
+
+// String serializes the URL.
+func (u *URLRecord) String() string {
+	return u.serialize(false)
+}
+
+func (u *URLRecord) serialize(excludeFragment bool) string {
+	var builder strings.Builder
+	builder.WriteString(u.Scheme)
+	builder.WriteByte(':')
+
+	if u.HasHost {
+		builder.WriteString("//")
+		if u.IncludesCredentials() {
+			builder.WriteString(u.Username)
+			if u.Password != "" {
+				builder.WriteByte(':')
+				builder.WriteString(u.Password)
+			}
+			builder.WriteByte('@')
+		}
+		builder.WriteString(u.Host)
+		if u.Port >= 0 {
+			builder.WriteByte(':')
+			builder.WriteString(strconv.Itoa(u.Port))
+		}
+	} else if !u.OpaquePath && len(u.Path) > 1 && u.Path[0] == "" {
+		// Keep a path starting with "//" from being read back as a host.
+		builder.WriteString("/.")
+	}
+
+	builder.WriteString(u.PathString())
+
+	if u.Query != nil {
+		builder.WriteByte('?')
+		builder.WriteString(*u.Query)
+	}
+
+	if !excludeFragment && u.Fragment != nil {
+		builder.WriteByte('#')
+		builder.WriteString(*u.Fragment)
+	}
+
+	return builder.String()
+}
+
+// PathString serializes the URL's path.
+func (u *URLRecord) PathString() string {
+	if u.OpaquePath {
+		return u.Path[0]
+	}
+
+	var builder strings.Builder
+	for _, segment := range u.Path {
+		builder.WriteByte('/')
+		builder.WriteString(segment)
+	}
+	return builder.String()
+}
+
+// HostString serializes the URL's host and port, or returns the empty string if it has no host.
+func (u *URLRecord) HostString() string {
+	if !u.HasHost {
+		return ""
+	}
+	if u.Port < 0 {
+		return u.Host
+	}
+	return u.Host + ":" + strconv.Itoa(u.Port)
+}
+
+// Origin serializes the URL's origin, which is "null" for opaque origins.
+func (u *URLRecord) Origin() string {
+	switch u.Scheme {
+	case "blob":
+		pathURL, ok := ParseURL(u.PathString(), nil)
+		if ok && (pathURL.Scheme == "http" || pathURL.Scheme == "https") {
+			return pathURL.Origin()
+		}
+	case "ftp", "http", "https", "ws", "wss":
+		return u.Scheme + "://" + u.HostString()
+	}
+	return "null"
+}
+
+func (u *URLRecord) shortenPath() {
+	if u.Scheme == "file" && len(u.Path) == 1 && isNormalizedWindowsDriveLetter(u.Path[0]) {
+		return
+	}
+	if len(u.Path) > 0 {
+		u.Path = u.Path[:len(u.Path)-1]
+	}
+}
+
+// stripTrailingSpacesFromOpaquePath removes the spaces at the end of an opaque path once nothing follows it, since
+// they would be lost when the URL is parsed again.
+func (u *URLRecord) stripTrailingSpacesFromOpaquePath() {
+	if !u.OpaquePath || u.Fragment != nil || u.Query != nil {
+		return
+	}
+	u.Path[0] = strings.TrimRight(u.Path[0], " ")
+}
+
+// basicURLParse is the basic URL parser of the URL Standard. Given a url and a state override, it modifies url in
+// place for the setters of the URL class, and reports false if the input was invalid, though url may have been
+// modified by then.
+func basicURLParse(input string, base *URLRecord, url *URLRecord, stateOverride urlParserState) (*URLRecord, bool) {
+	if url == nil {
+		url = &URLRecord{Port: -1}
+		input = strings.TrimFunc(input, func(char rune) bool {
+			return char <= ' '
+		})
+	}
+
+	input = strings.Map(func(char rune) rune {
+		if char == '\t' || char == '\n' || char == '\r' {
+			return -1
+		}
+		return char
+	}, input)
+
+	state := stateOverride
+	if state == urlStateNone {
+		state = urlStateSchemeStart
+	}
+
+	codePoints := []rune(input)
+	buffer := []rune{}
+	atSignSeen, insideBrackets, passwordTokenSeen := false, false, false
+
+	remainingStartsWith := func(pointer int, prefix string) bool {
+		return strings.HasPrefix(string(codePoints[min(pointer+1, len(codePoints)):]), prefix)
+	}
+
+	for pointer := 0; ; pointer++ {
+		c := urlEOF
+		if pointer < len(codePoints) {
+			c = codePoints[pointer]
+		}
+
+		switch state {
+		case urlStateSchemeStart:
+			if isASCIIAlpha(c) {
+				buffer = append(buffer, toASCIILower(c))
+				state = urlStateScheme
+			} else if stateOverride == urlStateNone {
+				state = urlStateNoScheme
+				pointer--
+			} else {
+				return url, false
+			}
+
+		case urlStateScheme:
+			if isASCIIAlphanumeric(c) || c == '+' || c == '-' || c == '.' {
+				buffer = append(buffer, toASCIILower(c))
+			} else if c == ':' {
+				scheme := string(buffer)
+				_, special := specialSchemes[scheme]
+				if stateOverride != urlStateNone {
+					if url.IsSpecial() != special {
+						return url, true
+					}
+					if (url.IncludesCredentials() || url.Port >= 0) && scheme == "file" {
+						return url, true
+					}
+					if url.Scheme == "file" && url.HasHost && url.Host == "" {
+						return url, true
+					}
+				}
+
+				url.Scheme = scheme
+				if stateOverride != urlStateNone {
+					if defaultPort, ok := specialSchemes[url.Scheme]; ok && url.Port == defaultPort {
+						url.Port = -1
+					}
+					return url, true
+				}
+
+				buffer = buffer[:0]
+				switch {
+				case url.Scheme == "file":
+					state = urlStateFile
+				case special && base != nil && base.Scheme == url.Scheme:
+					state = urlStateSpecialRelativeOrAuthority
+				case special:
+					state = urlStateSpecialAuthoritySlashes
+				case remainingStartsWith(pointer, "/"):
+					state = urlStatePathOrAuthority
+					pointer++
+				default:
+					url.Path = []string{""}
+					url.OpaquePath = true
+					state = urlStateOpaquePath
+				}
+			} else if stateOverride == urlStateNone {
+				// Start over, without a scheme.
+				buffer = buffer[:0]
+				state = urlStateNoScheme
+				pointer = -1
+			} else {
+				return url, false
+			}
+
+		case urlStateNoScheme:
+			if base == nil || (base.OpaquePath && c != '#') {
+				return url, false
+			} else if base.OpaquePath && c == '#' {
+				url.Scheme = base.Scheme
+				url.Path = slices.Clone(base.Path)
+				url.OpaquePath = true
+				url.Query = cloneURLComponent(base.Query)
+				url.Fragment = new(string)
+				state = urlStateFragment
+			} else if base.Scheme != "file" {
+				state = urlStateRelative
+				pointer--
+			} else {
+				state = urlStateFile
+				pointer--
+			}
+
+		case urlStateSpecialRelativeOrAuthority:
+			if c == '/' && remainingStartsWith(pointer, "/") {
+				state = urlStateSpecialAuthorityIgnoreSlashes
+				pointer++
+			} else {
+				state = urlStateRelative
+				pointer--
+			}
+
+		case urlStatePathOrAuthority:
+			if c == '/' {
+				state = urlStateAuthority
+			} else {
+				state = urlStatePath
+				pointer--
+			}
+
+		case urlStateRelative:
+			url.Scheme = base.Scheme
+			if c == '/' || (url.IsSpecial() && c == '\\') {
+				state = urlStateRelativeSlash
+			} else {
+				url.Username, url.Password = base.Username, base.Password
+				url.Host, url.HasHost, url.Port = base.Host, base.HasHost, base.Port
+				url.Path = slices.Clone(base.Path)
+				url.Query = cloneURLComponent(base.Query)
+
+				if c == '?' {
+					url.Query = new(string)
+					state = urlStateQuery
+				} else if c == '#' {
+					url.Fragment = new(string)
+					state = urlStateFragment
+				} else if c != urlEOF {
+					url.Query = nil
+					url.shortenPath()
+					state = urlStatePath
+					pointer--
+				}
+			}
+
+		case urlStateRelativeSlash:
+			if url.IsSpecial() && (c == '/' || c == '\\') {
+				state = urlStateSpecialAuthorityIgnoreSlashes
+			} else if c == '/' {
+				state = urlStateAuthority
+			} else {
+				url.Username, url.Password = base.Username, base.Password
+				url.Host, url.HasHost, url.Port = base.Host, base.HasHost, base.Port
+				state = urlStatePath
+				pointer--
+			}
+
+		case urlStateSpecialAuthoritySlashes:
+			if c == '/' && remainingStartsWith(pointer, "/") {
+				pointer++
+			} else {
+				pointer--
+			}
+			state = urlStateSpecialAuthorityIgnoreSlashes
+
+		case urlStateSpecialAuthorityIgnoreSlashes:
+			if c != '/' && c != '\\' {
+				state = urlStateAuthority
+				pointer--
+			}
+
+		case urlStateAuthority:
+			if c == '@' {
+				if atSignSeen {
+					buffer = append([]rune("%40"), buffer...)
+				}
+				atSignSeen = true
+
+				for _, char := range buffer {
+					if char == ':' && !passwordTokenSeen {
+						passwordTokenSeen = true
+						continue
+					}
+
+					encoded := percentEncodeRune(char, userinfoPercentEncodeSet)
+					if passwordTokenSeen {
+						url.Password += encoded
+					} else {
+						url.Username += encoded
+					}
+				}
+				buffer = buffer[:0]
+			} else if c == urlEOF || c == '/' || c == '?' || c == '#' || (url.IsSpecial() && c == '\\') {
+				if atSignSeen && len(buffer) == 0 {
+					return url, false
+				}
+				pointer -= len(buffer) + 1
+				buffer = buffer[:0]
+				state = urlStateHost
+			} else {
+				buffer = append(buffer, c)
+			}
+
+		case urlStateHost, urlStateHostname:
+			if stateOverride != urlStateNone && url.Scheme == "file" {
+				pointer--
+				state = urlStateFileHost
+			} else if c == ':' && !insideBrackets {
+				if len(buffer) == 0 || stateOverride == urlStateHostname {
+					return url, false
+				}
+
+				host, ok := parseHost(string(buffer), !url.IsSpecial())
+				if !ok {
+					return url, false
+				}
+				url.Host, url.HasHost = host, true
+				buffer = buffer[:0]
+				state = urlStatePort
+			} else if c == urlEOF || c == '/' || c == '?' || c == '#' || (url.IsSpecial() && c == '\\') {
+				pointer--
+				if url.IsSpecial() && len(buffer) == 0 {
+					return url, false
+				} else if stateOverride != urlStateNone && len(buffer) == 0 && (url.IncludesCredentials() || url.Port >= 0) {
+					return url, false
+				}
+
+				host, ok := parseHost(string(buffer), !url.IsSpecial())
+				if !ok {
+					return url, false
+				}
+				url.Host, url.HasHost = host, true
+				buffer = buffer[:0]
+				state = urlStatePathStart
+				if stateOverride != urlStateNone {
+					return url, true
+				}
+			} else {
+				if c == '[' {
+					insideBrackets = true
+				} else if c == ']' {
+					insideBrackets = false
+				}
+				buffer = append(buffer, c)
+			}
+
+		case urlStatePort:
+			if isASCIIDigit(c) {
+				buffer = append(buffer, c)
+			} else if c == urlEOF || c == '/' || c == '?' || c == '#' || (url.IsSpecial() && c == '\\') || stateOverride != urlStateNone {
+				if len(buffer) > 0 {
+					port := 0
+					for _, digit := range buffer {
+						port = port*10 + int(digit-'0')
+						if port > math.MaxUint16 {
+							return url, false
+						}
+					}
+
+					if defaultPort, ok := specialSchemes[url.Scheme]; ok && port == defaultPort {
+						url.Port = -1
+					} else {
+						url.Port = port
+					}
+					buffer = buffer[:0]
+
+					if stateOverride != urlStateNone {
+						return url, true
+					}
+				}
+
+				if stateOverride != urlStateNone {
+					return url, false
+				}
+				state = urlStatePathStart
+				pointer--
+			} else {
+				return url, false
+			}
+
+		case urlStateFile:
+			url.Scheme = "file"
+			url.Host, url.HasHost = "", true
+
+			if c == '/' || c == '\\' {
+				state = urlStateFileSlash
+			} else if base != nil && base.Scheme == "file" {
+				url.Host, url.HasHost = base.Host, base.HasHost
+				url.Path = slices.Clone(base.Path)
+				url.Query = cloneURLComponent(base.Query)
+
+				if c == '?' {
+					url.Query = new(string)
+					state = urlStateQuery
+				} else if c == '#' {
+					url.Fragment = new(string)
+					state = urlStateFragment
+				} else if c != urlEOF {
+					url.Query = nil
+					if !startsWithWindowsDriveLetter(codePoints[pointer:]) {
+						url.shortenPath()
+					} else {
+						url.Path = []string{}
+					}
+					state = urlStatePath
+					pointer--
+				}
+			} else {
+				state = urlStatePath
+				pointer--
+			}
+
+		case urlStateFileSlash:
+			if c == '/' || c == '\\' {
+				state = urlStateFileHost
+			} else {
+				if base != nil && base.Scheme == "file" {
+					url.Host, url.HasHost = base.Host, base.HasHost
+					if !startsWithWindowsDriveLetter(codePoints[pointer:]) && len(base.Path) > 0 && isNormalizedWindowsDriveLetter(base.Path[0]) {
+						url.Path = append(url.Path, base.Path[0])
+					}
+				}
+				state = urlStatePath
+				pointer--
+			}
+
+		case urlStateFileHost:
+			if c == urlEOF || c == '/' || c == '\\' || c == '?' || c == '#' {
+				pointer--
+				if stateOverride == urlStateNone && isWindowsDriveLetter(string(buffer)) {
+					// The buffer is kept, it is the first segment of the path.
+					state = urlStatePath
+				} else if len(buffer) == 0 {
+					url.Host, url.HasHost = "", true
+					if stateOverride != urlStateNone {
+						return url, true
+					}
+					state = urlStatePathStart
+				} else {
+					host, ok := parseHost(string(buffer), !url.IsSpecial())
+					if !ok {
+						return url, false
+					}
+					if host == "localhost" {
+						host = ""
+					}
+					url.Host, url.HasHost = host, true
+					if stateOverride != urlStateNone {
+						return url, true
+					}
+					buffer = buffer[:0]
+					state = urlStatePathStart
+				}
+			} else {
+				buffer = append(buffer, c)
+			}
+
+		case urlStatePathStart:
+			if url.IsSpecial() {
+				state = urlStatePath
+				if c != '/' && c != '\\' {
+					pointer--
+				}
+			} else if stateOverride == urlStateNone && c == '?' {
+				url.Query = new(string)
+				state = urlStateQuery
+			} else if stateOverride == urlStateNone && c == '#' {
+				url.Fragment = new(string)
+				state = urlStateFragment
+			} else if c != urlEOF {
+				state = urlStatePath
+				if c != '/' {
+					pointer--
+				}
+			} else if stateOverride != urlStateNone && !url.HasHost {
+				url.Path = append(url.Path, "")
+			}
+
+		case urlStatePath:
+			slash := c == '/' || (url.IsSpecial() && c == '\\')
+			if c == urlEOF || slash || (stateOverride == urlStateNone && (c == '?' || c == '#')) {
+				segment := string(buffer)
+				if isDoubleDotPathSegment(segment) {
+					url.shortenPath()
+					if !slash {
+						url.Path = append(url.Path, "")
+					}
+				} else if isSingleDotPathSegment(segment) && !slash {
+					url.Path = append(url.Path, "")
+				} else if !isSingleDotPathSegment(segment) {
+					if url.Scheme == "file" && len(url.Path) == 0 && isWindowsDriveLetter(segment) {
+						segment = segment[:1] + ":"
+					}
+					url.Path = append(url.Path, segment)
+				}
+				buffer = buffer[:0]
+
+				if c == '?' {
+					url.Query = new(string)
+					state = urlStateQuery
+				} else if c == '#' {
+					url.Fragment = new(string)
+					state = urlStateFragment
+				}
+			} else {
+				buffer = append(buffer, []rune(percentEncodeRune(c, pathPercentEncodeSet))...)
+			}
+
+		case urlStateOpaquePath:
+			if c == '?' {
+				url.Query = new(string)
+				state = urlStateQuery
+			} else if c == '#' {
+				url.Fragment = new(string)
+				state = urlStateFragment
+			} else if c != urlEOF {
+				url.Path[0] += percentEncodeRune(c, c0ControlPercentEncodeSet)
+			}
+
+		case urlStateQuery:
+			if (stateOverride == urlStateNone && c == '#') || c == urlEOF {
+				encodeSet := queryPercentEncodeSet
+				if url.IsSpecial() {
+					encodeSet = specialQueryPercentEncodeSet
+				}
+				*url.Query += percentEncodeString(string(buffer), encodeSet)
+				buffer = buffer[:0]
+
+				if c == '#' {
+					url.Fragment = new(string)
+					state = urlStateFragment
+				}
+			} else if c != urlEOF {
+				buffer = append(buffer, c)
+			}
+
+		case urlStateFragment:
+			if c != urlEOF {
+				*url.Fragment += percentEncodeRune(c, fragmentPercentEncodeSet)
+			}
+		}
+
+		if pointer >= len(codePoints) {
+			break
+		}
+	}
+
+	return url, true
+}
+
+// cloneURLComponent copies a query or fragment, which the parser appends to in place.
+func cloneURLComponent(component *string) *string {
+	if component == nil {
+		return nil
+	}
+	clone := *component
+	return &clone
+}
+
+func isASCIIAlpha(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isASCIIDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isASCIIAlphanumeric(c rune) bool {
+	return isASCIIAlpha(c) || isASCIIDigit(c)
+}
+
+func isASCIIHexDigit(c rune) bool {
+	return isASCIIDigit(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func toASCIILower(c rune) rune {
+	if c >= 'A' && c <= 'Z' {
+		return c + ('a' - 'A')
+	}
+	return c
+}
+
+func isWindowsDriveLetter(segment string) bool {
+	return len(segment) == 2 && isASCIIAlpha(rune(segment[0])) && (segment[1] == ':' || segment[1] == '|')
+}
+
+func isNormalizedWindowsDriveLetter(segment string) bool {
+	return isWindowsDriveLetter(segment) && segment[1] == ':'
+}
+
+func startsWithWindowsDriveLetter(codePoints []rune) bool {
+	if len(codePoints) < 2 || !isASCIIAlpha(codePoints[0]) || (codePoints[1] != ':' && codePoints[1] != '|') {
+		return false
+	}
+	if len(codePoints) == 2 {
+		return true
+	}
+	switch codePoints[2] {
+	case '/', '\\', '?', '#':
+		return true
+	}
+	return false
+}
+
+func isSingleDotPathSegment(segment string) bool {
+	return segment == "." || strings.EqualFold(segment, "%2e")
+}
+
+func isDoubleDotPathSegment(segment string) bool {
+	switch strings.ToLower(segment) {
+	case "..", ".%2e", "%2e.", "%2e%2e":
+		return true
+	}
+	return false
+}
+
+// percentEncodeSet reports whether an ASCII byte must be percent-encoded. Bytes outside ASCII always are.
+type percentEncodeSet func(b byte) bool
+
+func c0ControlPercentEncodeSet(b byte) bool {
+	return b < 0x20 || b > 0x7E
+}
+
+func fragmentPercentEncodeSet(b byte) bool {
+	return c0ControlPercentEncodeSet(b) || strings.IndexByte(" \"<>`", b) >= 0
+}
+
+func queryPercentEncodeSet(b byte) bool {
+	return c0ControlPercentEncodeSet(b) || strings.IndexByte(" \"#<>", b) >= 0
+}
+
+func specialQueryPercentEncodeSet(b byte) bool {
+	return queryPercentEncodeSet(b) || b == '\''
+}
+
+func pathPercentEncodeSet(b byte) bool {
+	return queryPercentEncodeSet(b) || strings.IndexByte("?`{}", b) >= 0
+}
+
+func userinfoPercentEncodeSet(b byte) bool {
+	return pathPercentEncodeSet(b) || strings.IndexByte("/:;=@[\\]^|", b) >= 0
+}
+
+func componentPercentEncodeSet(b byte) bool {
+	return userinfoPercentEncodeSet(b) || strings.IndexByte("$%&+,", b) >= 0
+}
+
+func formURLEncodedPercentEncodeSet(b byte) bool {
+	return componentPercentEncodeSet(b) || strings.IndexByte("!'()~", b) >= 0
+}
+
+const upperHexDigits = "0123456789ABCDEF"
+
+// percentEncodeString percent-encodes the UTF-8 bytes of str that are in encodeSet.
+func percentEncodeString(str string, encodeSet percentEncodeSet) string {
+	var builder strings.Builder
+	for idx := 0; idx < len(str); idx++ {
+		b := str[idx]
+		if encodeSet(b) {
+			builder.WriteByte('%')
+			builder.WriteByte(upperHexDigits[b>>4])
+			builder.WriteByte(upperHexDigits[b&0xF])
+		} else {
+			builder.WriteByte(b)
+		}
+	}
+	return builder.String()
+}
+
+func percentEncodeRune(c rune, encodeSet percentEncodeSet) string {
+	if c < utf8.RuneSelf && !encodeSet(byte(c)) {
+		return string(c)
+	}
+	return percentEncodeString(string(c), encodeSet)
+}
+
+// percentDecode decodes the percent-encoded bytes of str, leaving a "%" that isn't followed by two hex digits as it is.
+func percentDecode(str string) []byte {
+	output := make([]byte, 0, len(str))
+	for idx := 0; idx < len(str); idx++ {
+		if str[idx] == '%' && idx+2 < len(str) && isASCIIHexDigit(rune(str[idx+1])) && isASCIIHexDigit(rune(str[idx+2])) {
+			value, _ := strconv.ParseUint(str[idx+1:idx+3], 16, 8)
+			output = append(output, byte(value))
+			idx += 2
+			continue
+		}
+		output = append(output, str[idx])
+	}
+	return output
+}
+
+// parseHost parses the host of a URL, returning it serialized. Hosts of URLs with special schemes are domains or IP
+// addresses, those of other URLs are opaque, unless they are IPv6 addresses.
+func parseHost(input string, isOpaque bool) (string, bool) {
+	if strings.HasPrefix(input, "[") {
+		if !strings.HasSuffix(input, "]") {
+			return "", false
+		}
+
+		address, ok := parseIPv6(input[1 : len(input)-1])
+		if !ok {
+			return "", false
+		}
+		return "[" + serializeIPv6(address) + "]", true
+	}
+
+	if isOpaque {
+		if strings.ContainsFunc(input, isForbiddenHostCodePoint) {
+			return "", false
+		}
+		return percentEncodeString(input, c0ControlPercentEncodeSet), true
+	}
+
+	domain := strings.ToValidUTF8(string(percentDecode(input)), "\uFFFD")
+	asciiDomain, ok := domainToASCII(domain)
+	if !ok || strings.ContainsFunc(asciiDomain, isForbiddenDomainCodePoint) {
+		return "", false
+	}
+
+	if endsInANumber(asciiDomain) {
+		address, ok := parseIPv4(asciiDomain)
+		if !ok {
+			return "", false
+		}
+		return serializeIPv4(address), true
+	}
+
+	return asciiDomain, true
+}
+
+func isForbiddenHostCodePoint(c rune) bool {
+	switch c {
+	case 0, '\t', '\n', '\r', ' ', '#', '/', ':', '<', '>', '?', '@', '[', '\\', ']', '^', '|':
+		return true
+	}
+	return false
+}
+
+func isForbiddenDomainCodePoint(c rune) bool {
+	return isForbiddenHostCodePoint(c) || c <= 0x1F || c == '%' || c == 0x7F
+}
+
+// The UTS #46 processing the URL Standard uses for domains, with STD3 rules and DNS length checks left out.
+var urlIDNAProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.CheckJoiners(true),
+	idna.CheckHyphens(false),
+	idna.StrictDomainName(false),
+	idna.Transitional(false),
+	idna.VerifyDNSLength(false),
+)
+
+func domainToASCII(domain string) (string, bool) {
+	// ASCII domains without punycode labels only need lowercasing.
+	isASCII := !strings.ContainsFunc(domain, func(c rune) bool {
+		return c >= utf8.RuneSelf
+	})
+	if isASCII {
+		lower := strings.ToLower(domain)
+		if !strings.HasPrefix(lower, "xn--") && !strings.Contains(lower, ".xn--") {
+			return lower, lower != ""
+		}
+	}
+
+	result, err := urlIDNAProfile.ToASCII(domain)
+	if err != nil || result == "" {
+		return "", false
+	}
+	return result, true
+}
+
+func endsInANumber(input string) bool {
+	parts := strings.Split(input, ".")
+	if parts[len(parts)-1] == "" {
+		if len(parts) == 1 {
+			return false
+		}
+		parts = parts[:len(parts)-1]
+	}
+
+	last := parts[len(parts)-1]
+	if last != "" && !strings.ContainsFunc(last, func(c rune) bool { return !isASCIIDigit(c) }) {
+		return true
+	}
+
+	_, ok := parseIPv4Number(last)
+	return ok
+}
+
+func parseIPv4(input string) (uint32, bool) {
+	parts := strings.Split(input, ".")
+	if parts[len(parts)-1] == "" && len(parts) > 1 {
+		parts = parts[:len(parts)-1]
+	}
+
+	if len(parts) > 4 {
+		return 0, false
+	}
+
+	numbers := make([]uint64, len(parts))
+	for idx, part := range parts {
+		number, ok := parseIPv4Number(part)
+		if !ok {
+			return 0, false
+		}
+		numbers[idx] = number
+	}
+
+	for _, number := range numbers[:len(numbers)-1] {
+		if number > 255 {
+			return 0, false
+		}
+	}
+
+	last := numbers[len(numbers)-1]
+	if last >= 1<<(8*(5-len(numbers))) {
+		return 0, false
+	}
+
+	address := last
+	for idx, number := range numbers[:len(numbers)-1] {
+		address += number << (8 * (3 - idx))
+	}
+	return uint32(address), true
+}
+
+// parseIPv4Number parses a part of an IPv4 address, which may be decimal, octal with a leading 0 or hexadecimal with a
+// leading 0x. Numbers too large for any address are capped.
+func parseIPv4Number(input string) (uint64, bool) {
+	if input == "" {
+		return 0, false
+	}
+
+	radix := uint64(10)
+	if len(input) >= 2 && (input[:2] == "0x" || input[:2] == "0X") {
+		input = input[2:]
+		radix = 16
+	} else if len(input) >= 2 && input[0] == '0' {
+		input = input[1:]
+		radix = 8
+	}
+
+	number := uint64(0)
+	for _, c := range input {
+		var digit uint64
+		switch {
+		case c >= '0' && c <= '9':
+			digit = uint64(c - '0')
+		case c >= 'a' && c <= 'f':
+			digit = uint64(c-'a') + 10
+		case c >= 'A' && c <= 'F':
+			digit = uint64(c-'A') + 10
+		default:
+			return 0, false
+		}
+		if digit >= radix {
+			return 0, false
+		}
+
+		number = min(number*radix+digit, 1<<40)
+	}
+
+	return number, true
+}
+
+func serializeIPv4(address uint32) string {
+	return strconv.Itoa(int(address>>24)) + "." + strconv.Itoa(int(address>>16&0xFF)) + "." +
+		strconv.Itoa(int(address>>8&0xFF)) + "." + strconv.Itoa(int(address&0xFF))
+}
+
+func parseIPv6(input string) ([8]uint16, bool) {
+	var address [8]uint16
+	pieceIndex := 0
+	compress := -1
+
+	codePoints := []rune(input)
+	pointer := 0
+	at := func(idx int) rune {
+		if idx < len(codePoints) {
+			return codePoints[idx]
+		}
+		return urlEOF
+	}
+
+	if at(pointer) == ':' {
+		if at(pointer+1) != ':' {
+			return address, false
+		}
+		pointer += 2
+		pieceIndex++
+		compress = pieceIndex
+	}
+
+	for at(pointer) != urlEOF {
+		if pieceIndex == 8 {
+			return address, false
+		}
+
+		if at(pointer) == ':' {
+			if compress >= 0 {
+				return address, false
+			}
+			pointer++
+			pieceIndex++
+			compress = pieceIndex
+			continue
+		}
+
+		value, length := 0, 0
+		for length < 4 && isASCIIHexDigit(at(pointer)) {
+			digit, _ := strconv.ParseUint(string(at(pointer)), 16, 8)
+			value = value*0x10 + int(digit)
+			pointer++
+			length++
+		}
+
+		if at(pointer) == '.' {
+			if length == 0 {
+				return address, false
+			}
+			pointer -= length
+
+			if pieceIndex > 6 {
+				return address, false
+			}
+
+			numbersSeen := 0
+			for at(pointer) != urlEOF {
+				ipv4Piece := -1
+				if numbersSeen > 0 {
+					if at(pointer) == '.' && numbersSeen < 4 {
+						pointer++
+					} else {
+						return address, false
+					}
+				}
+
+				if !isASCIIDigit(at(pointer)) {
+					return address, false
+				}
+
+				for isASCIIDigit(at(pointer)) {
+					number := int(at(pointer) - '0')
+					if ipv4Piece < 0 {
+						ipv4Piece = number
+					} else if ipv4Piece == 0 {
+						return address, false
+					} else {
+						ipv4Piece = ipv4Piece*10 + number
+					}
+					if ipv4Piece > 255 {
+						return address, false
+					}
+					pointer++
+				}
+
+				address[pieceIndex] = address[pieceIndex]*0x100 + uint16(ipv4Piece)
+				numbersSeen++
+				if numbersSeen == 2 || numbersSeen == 4 {
+					pieceIndex++
+				}
+			}
+
+			if numbersSeen != 4 {
+				return address, false
+			}
+			break
+		} else if at(pointer) == ':' {
+			pointer++
+			if at(pointer) == urlEOF {
+				return address, false
+			}
+		} else if at(pointer) != urlEOF {
+			return address, false
+		}
+
+		address[pieceIndex] = uint16(value)
+		pieceIndex++
+	}
+
+	if compress >= 0 {
+		swaps := pieceIndex - compress
+		pieceIndex = 7
+		for pieceIndex != 0 && swaps > 0 {
+			address[pieceIndex], address[compress+swaps-1] = address[compress+swaps-1], address[pieceIndex]
+			pieceIndex--
+			swaps--
+		}
+	} else if pieceIndex != 8 {
+		return address, false
+	}
+
+	return address, true
+}
+
+// serializeIPv6 serializes an IPv6 address, compressing the first longest run of two or more zero pieces.
+func serializeIPv6(address [8]uint16) string {
+	compress, longest := -1, 1
+	for start := 0; start < 8; {
+		if address[start] != 0 {
+			start++
+			continue
+		}
+
+		end := start
+		for end < 8 && address[end] == 0 {
+			end++
+		}
+		if end-start > longest {
+			compress, longest = start, end-start
+		}
+		start = end
+	}
+
+	var builder strings.Builder
+	ignoreZero := false
+	for pieceIndex := 0; pieceIndex < 8; pieceIndex++ {
+		if ignoreZero && address[pieceIndex] == 0 {
+			continue
+		}
+		ignoreZero = false
+
+		if compress == pieceIndex {
+			if pieceIndex == 0 {
+				builder.WriteString("::")
+			} else {
+				builder.WriteString(":")
+			}
+			ignoreZero = true
+			continue
+		}
+
+		builder.WriteString(strconv.FormatUint(uint64(address[pieceIndex]), 16))
+		if pieceIndex != 7 {
+			builder.WriteByte(':')
+		}
+	}
+	return builder.String()
+}
+
+// URLSearchParam is a name-value pair of a query.
+type URLSearchParam struct {
+	Name  string
+	Value string
+}
+
+// ParseURLEncoded parses an application/x-www-form-urlencoded string, such as the query of a URL, into its pairs.
+func ParseURLEncoded(input string) []URLSearchParam {
+	params := []URLSearchParam{}
+	for sequence := range strings.SplitSeq(input, "&") {
+		if sequence == "" {
+			continue
+		}
+
+		name, value, _ := strings.Cut(sequence, "=")
+		params = append(params, URLSearchParam{
+			Name:  decodeURLEncodedComponent(name),
+			Value: decodeURLEncodedComponent(value),
+		})
+	}
+	return params
+}
+
+func decodeURLEncodedComponent(component string) string {
+	decoded := percentDecode(strings.ReplaceAll(component, "+", " "))
+	return strings.ToValidUTF8(string(decoded), "\uFFFD")
+}
+
+// SerializeURLEncoded serializes pairs as application/x-www-form-urlencoded, the inverse of ParseURLEncoded.
+func SerializeURLEncoded(params []URLSearchParam) string {
+	var builder strings.Builder
+	for idx, param := range params {
+		if idx > 0 {
+			builder.WriteByte('&')
+		}
+		builder.WriteString(encodeURLEncodedComponent(param.Name))
+		builder.WriteByte('=')
+		builder.WriteString(encodeURLEncodedComponent(param.Value))
+	}
+	return builder.String()
+}
+
+func encodeURLEncodedComponent(component string) string {
+	return strings.ReplaceAll(percentEncodeString(component, func(b byte) bool {
+		return b != ' ' && formURLEncodedPercentEncodeSet(b)
+	}), " ", "+")
+}
+
+// compareUTF16 compares strings by their UTF-16 code units, the order URLSearchParams.prototype.sort uses.
+func compareUTF16(a string, b string) int {
+	return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+}
+
+// URLSearchParamsList is the list of a URLSearchParams object, which keeps the query of its URL object up to date.
+type URLSearchParamsList struct {
+	Entries []URLSearchParam
+
+	// The URL whose query the list was parsed from, nil unless the list belongs to a URL object.
+	URL *URLRecord
+}
+
+// Update sets the query of the list's URL to the serialized list.
+func (l *URLSearchParamsList) Update() {
+	if l.URL == nil {
+		return
+	}
+
+	serialized := SerializeURLEncoded(l.Entries)
+	if serialized == "" {
+		l.URL.Query = nil
+	} else {
+		l.URL.Query = &serialized
+	}
+	l.URL.stripTrailingSpacesFromOpaquePath()
+}
@@ -0,0 +1,159 @@
+package runtime
+
+import "strings"
+
+func NewURLConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		URLConstructor,
+		1,
+		NewStringValue("URL"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// URL.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicURLPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	// URL.canParse
+	DefineBuiltinFunction(runtime, constructor, "canParse", URLCanParse, 1)
+
+	// URL.parse
+	DefineBuiltinFunction(runtime, constructor, "parse", URLParse, 1)
+
+	return constructor
+}
+
+// URL(url, base)
+func URLConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "URL constructor requires 'new'"))
+	}
+
+	record, completion := parseURLArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+	if record == nil {
+		return NewThrowCompletion(NewTypeError(runtime, "Invalid URL"))
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicURLPrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	urlVal := createCompletion.Value.(*JavaScriptValue)
+	initializeURLObject(runtime, urlVal.Value.(*Object), record)
+
+	return NewNormalCompletion(urlVal)
+}
+
+// URL.canParse(url, base)
+func URLCanParse(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	record, completion := parseURLArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewBooleanValue(record != nil))
+}
+
+// URL.parse(url, base)
+func URLParse(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	record, completion := parseURLArguments(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+	if record == nil {
+		return NewNormalCompletion(NewNullValue())
+	}
+
+	object := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicURLPrototype)).(*Object)
+	initializeURLObject(runtime, object, record)
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, object))
+}
+
+// parseURLArguments parses the url and base arguments of the URL constructor, returning a nil record if either of them
+// isn't a valid URL. It only returns a completion if converting them to strings threw.
+func parseURLArguments(runtime *Runtime, arguments []*JavaScriptValue) (*URLRecord, *Completion) {
+	urlVal := NewUndefinedValue()
+	if len(arguments) > 0 {
+		urlVal = arguments[0]
+	}
+
+	input, completion := toUSVString(runtime, urlVal)
+	if completion != nil {
+		return nil, completion
+	}
+
+	var base *URLRecord
+	if len(arguments) > 1 && arguments[1].Type != TypeUndefined {
+		baseInput, completion := toUSVString(runtime, arguments[1])
+		if completion != nil {
+			return nil, completion
+		}
+
+		var ok bool
+		if base, ok = ParseURL(baseInput, nil); !ok {
+			return nil, nil
+		}
+	}
+
+	record, ok := ParseURL(input, base)
+	if !ok {
+		return nil, nil
+	}
+	return record, nil
+}
+
+// initializeURLObject gives a URL object its URL, along with the URLSearchParams object for its query.
+func initializeURLObject(runtime *Runtime, object *Object, record *URLRecord) {
+	object.URL = record
+
+	list := &URLSearchParamsList{Entries: []URLSearchParam{}, URL: record}
+	if record.Query != nil {
+		list.Entries = ParseURLEncoded(*record.Query)
+	}
+
+	query := OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicURLSearchParamsPrototype)).(*Object)
+	query.URLSearchParams = list
+	object.URLQueryObject = query
+}
+
+// toUSVString converts a value to a string, replacing invalid UTF-8 with U+FFFD like the conversion to a USVString in
+// Web IDL replaces lone surrogates. It only returns a completion if the conversion threw.
+func toUSVString(runtime *Runtime, value *JavaScriptValue) (string, *Completion) {
+	completion := ToString(runtime, value)
+	if completion.Type != Normal {
+		return "", completion
+	}
+
+	return strings.ToValidUTF8(completion.Value.(*JavaScriptValue).Value.(*String).Value, "\uFFFD"), nil
+}
@@ -0,0 +1,622 @@
+package runtime
+
+import (
+	"strconv"
+	"strings"
+)
+
+func NewURLPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineURLPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// URL.prototype.href
+	DefineBuiltinAccessorFunction(runtime, prototype, "href", URLPrototypeHrefGetter, URLPrototypeHrefSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.origin
+	DefineBuiltinAccessorFunction(runtime, prototype, "origin", URLPrototypeOriginGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.protocol
+	DefineBuiltinAccessorFunction(runtime, prototype, "protocol", URLPrototypeProtocolGetter, URLPrototypeProtocolSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.username
+	DefineBuiltinAccessorFunction(runtime, prototype, "username", URLPrototypeUsernameGetter, URLPrototypeUsernameSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.password
+	DefineBuiltinAccessorFunction(runtime, prototype, "password", URLPrototypePasswordGetter, URLPrototypePasswordSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.host
+	DefineBuiltinAccessorFunction(runtime, prototype, "host", URLPrototypeHostGetter, URLPrototypeHostSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.hostname
+	DefineBuiltinAccessorFunction(runtime, prototype, "hostname", URLPrototypeHostnameGetter, URLPrototypeHostnameSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.port
+	DefineBuiltinAccessorFunction(runtime, prototype, "port", URLPrototypePortGetter, URLPrototypePortSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.pathname
+	DefineBuiltinAccessorFunction(runtime, prototype, "pathname", URLPrototypePathnameGetter, URLPrototypePathnameSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.search
+	DefineBuiltinAccessorFunction(runtime, prototype, "search", URLPrototypeSearchGetter, URLPrototypeSearchSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.searchParams
+	DefineBuiltinAccessorFunction(runtime, prototype, "searchParams", URLPrototypeSearchParamsGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.hash
+	DefineBuiltinAccessorFunction(runtime, prototype, "hash", URLPrototypeHashGetter, URLPrototypeHashSetter, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URL.prototype.toString
+	DefineBuiltinFunction(runtime, prototype, "toString", URLPrototypeToString, 0)
+
+	// URL.prototype.toJSON
+	DefineBuiltinFunction(runtime, prototype, "toJSON", URLPrototypeToJSON, 0)
+
+	// URL.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("URL"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func thisURL(runtime *Runtime, thisArg *JavaScriptValue, method string) (*Object, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.URL != nil {
+			return object, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "URL.prototype."+method+" called on incompatible receiver"))
+}
+
+// urlSetterArgument returns the value passed to a setter of URL.prototype as a string.
+func urlSetterArgument(runtime *Runtime, arguments []*JavaScriptValue) (string, *Completion) {
+	value := NewUndefinedValue()
+	if len(arguments) > 0 {
+		value = arguments[0]
+	}
+	return toUSVString(runtime, value)
+}
+
+// get URL.prototype.href
+func URLPrototypeHrefGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "href")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(object.URL.String()))
+}
+
+// set URL.prototype.href
+func URLPrototypeHrefSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "href")
+	if completion != nil {
+		return completion
+	}
+
+	value, completion := urlSetterArgument(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	record, ok := ParseURL(value, nil)
+	if !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "Invalid URL"))
+	}
+
+	// The URLSearchParams object keeps pointing at the same record, so replace its contents.
+	*object.URL = *record
+
+	list := object.URLQueryObject.URLSearchParams
+	list.Entries = []URLSearchParam{}
+	if record.Query != nil {
+		list.Entries = ParseURLEncoded(*record.Query)
+	}
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// get URL.prototype.origin
+func URLPrototypeOriginGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "origin")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(object.URL.Origin()))
+}
+
+// get URL.prototype.protocol
+func URLPrototypeProtocolGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "protocol")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(object.URL.Scheme + ":"))
+}
+
+// set URL.prototype.protocol
+func URLPrototypeProtocolSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "protocol")
+	if completion != nil {
+		return completion
+	}
+
+	value, completion := urlSetterArgument(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	basicURLParse(value+":", nil, object.URL, urlStateSchemeStart)
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// get URL.prototype.username
+func URLPrototypeUsernameGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "username")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(object.URL.Username))
+}
+
+// set URL.prototype.username
+func URLPrototypeUsernameSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "username")
+	if completion != nil {
+		return completion
+	}
+
+	value, completion := urlSetterArgument(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	if !object.URL.CannotHaveUsernamePasswordPort() {
+		object.URL.Username = percentEncodeString(value, userinfoPercentEncodeSet)
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// get URL.prototype.password
+func URLPrototypePasswordGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "password")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(object.URL.Password))
+}
+
+// set URL.prototype.password
+func URLPrototypePasswordSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "password")
+	if completion != nil {
+		return completion
+	}
+
+	value, completion := urlSetterArgument(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	if !object.URL.CannotHaveUsernamePasswordPort() {
+		object.URL.Password = percentEncodeString(value, userinfoPercentEncodeSet)
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// get URL.prototype.host
+func URLPrototypeHostGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "host")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(object.URL.HostString()))
+}
+
+// set URL.prototype.host
+func URLPrototypeHostSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "host")
+	if completion != nil {
+		return completion
+	}
+
+	value, completion := urlSetterArgument(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	if !object.URL.OpaquePath {
+		basicURLParse(value, nil, object.URL, urlStateHost)
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// get URL.prototype.hostname
+func URLPrototypeHostnameGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "hostname")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(object.URL.Host))
+}
+
+// set URL.prototype.hostname
+func URLPrototypeHostnameSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "hostname")
+	if completion != nil {
+		return completion
+	}
+
+	value, completion := urlSetterArgument(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	if !object.URL.OpaquePath {
+		basicURLParse(value, nil, object.URL, urlStateHostname)
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// get URL.prototype.port
+func URLPrototypePortGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "port")
+	if completion != nil {
+		return completion
+	}
+
+	if object.URL.Port < 0 {
+		return NewNormalCompletion(NewStringValue(""))
+	}
+	return NewNormalCompletion(NewStringValue(strconv.Itoa(object.URL.Port)))
+}
+
+// set URL.prototype.port
+func URLPrototypePortSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "port")
+	if completion != nil {
+		return completion
+	}
+
+	value, completion := urlSetterArgument(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	if object.URL.CannotHaveUsernamePasswordPort() {
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	if value == "" {
+		object.URL.Port = -1
+	} else {
+		basicURLParse(value, nil, object.URL, urlStatePort)
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// get URL.prototype.pathname
+func URLPrototypePathnameGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "pathname")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(object.URL.PathString()))
+}
+
+// set URL.prototype.pathname
+func URLPrototypePathnameSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "pathname")
+	if completion != nil {
+		return completion
+	}
+
+	value, completion := urlSetterArgument(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	if !object.URL.OpaquePath {
+		object.URL.Path = []string{}
+		basicURLParse(value, nil, object.URL, urlStatePathStart)
+	}
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// get URL.prototype.search
+func URLPrototypeSearchGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "search")
+	if completion != nil {
+		return completion
+	}
+
+	if object.URL.Query == nil || *object.URL.Query == "" {
+		return NewNormalCompletion(NewStringValue(""))
+	}
+	return NewNormalCompletion(NewStringValue("?" + *object.URL.Query))
+}
+
+// set URL.prototype.search
+func URLPrototypeSearchSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "search")
+	if completion != nil {
+		return completion
+	}
+
+	value, completion := urlSetterArgument(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	list := object.URLQueryObject.URLSearchParams
+	if value == "" {
+		object.URL.Query = nil
+		list.Entries = []URLSearchParam{}
+		object.URL.stripTrailingSpacesFromOpaquePath()
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	input := strings.TrimPrefix(value, "?")
+	object.URL.Query = new(string)
+	basicURLParse(input, nil, object.URL, urlStateQuery)
+	list.Entries = ParseURLEncoded(input)
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// get URL.prototype.searchParams
+func URLPrototypeSearchParamsGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "searchParams")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, object.URLQueryObject))
+}
+
+// get URL.prototype.hash
+func URLPrototypeHashGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "hash")
+	if completion != nil {
+		return completion
+	}
+
+	if object.URL.Fragment == nil || *object.URL.Fragment == "" {
+		return NewNormalCompletion(NewStringValue(""))
+	}
+	return NewNormalCompletion(NewStringValue("#" + *object.URL.Fragment))
+}
+
+// set URL.prototype.hash
+func URLPrototypeHashSetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "hash")
+	if completion != nil {
+		return completion
+	}
+
+	value, completion := urlSetterArgument(runtime, arguments)
+	if completion != nil {
+		return completion
+	}
+
+	if value == "" {
+		object.URL.Fragment = nil
+		object.URL.stripTrailingSpacesFromOpaquePath()
+		return NewNormalCompletion(NewUndefinedValue())
+	}
+
+	object.URL.Fragment = new(string)
+	basicURLParse(strings.TrimPrefix(value, "#"), nil, object.URL, urlStateFragment)
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// URL.prototype.toString()
+func URLPrototypeToString(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "toString")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(object.URL.String()))
+}
+
+// URL.prototype.toJSON()
+func URLPrototypeToJSON(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, completion := thisURL(runtime, thisArg, "toJSON")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewStringValue(object.URL.String()))
+}
@@ -0,0 +1,157 @@
+package runtime
+
+import "strings"
+
+func NewURLSearchParamsConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		URLSearchParamsConstructor,
+		0,
+		NewStringValue("URLSearchParams"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// URLSearchParams.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicURLSearchParamsPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+// URLSearchParams(init)
+func URLSearchParamsConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "URLSearchParams constructor requires 'new'"))
+	}
+
+	entries := []URLSearchParam{}
+	if len(arguments) > 0 && arguments[0].Type != TypeUndefined {
+		var completion *Completion
+		entries, completion = urlSearchParamsInit(runtime, arguments[0])
+		if completion != nil {
+			return completion
+		}
+	}
+
+	createCompletion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicURLSearchParamsPrototype)
+	if createCompletion.Type != Normal {
+		return createCompletion
+	}
+
+	paramsVal := createCompletion.Value.(*JavaScriptValue)
+	paramsVal.Value.(*Object).URLSearchParams = &URLSearchParamsList{Entries: entries}
+
+	return NewNormalCompletion(paramsVal)
+}
+
+// urlSearchParamsInit returns the pairs of the init argument of the URLSearchParams constructor, which is either an
+// iterable of pairs, a record of names to values, or a query string. It only returns a completion if reading init
+// threw.
+func urlSearchParamsInit(runtime *Runtime, init *JavaScriptValue) ([]URLSearchParam, *Completion) {
+	if init.Type != TypeObject {
+		query, completion := toUSVString(runtime, init)
+		if completion != nil {
+			return nil, completion
+		}
+		return ParseURLEncoded(strings.TrimPrefix(query, "?")), nil
+	}
+
+	completion := GetMethod(runtime, init, runtime.SymbolIterator)
+	if completion.Type != Normal {
+		return nil, completion
+	}
+	method := completion.Value.(*JavaScriptValue)
+
+	entries := []URLSearchParam{}
+
+	// A record, such as a plain object.
+	if method.Type == TypeUndefined {
+		object := init.Value.(ObjectInterface)
+		completion = EnumerableOwnProperties(runtime, object, EnumerableOwnPropertiesKindKey)
+		if completion.Type != Normal {
+			return nil, completion
+		}
+
+		for _, key := range completion.Value.([]*JavaScriptValue) {
+			completion := object.Get(runtime, key, init)
+			if completion.Type != Normal {
+				return nil, completion
+			}
+
+			pair, pairCompletion := urlSearchParamsPair(runtime, key, completion.Value.(*JavaScriptValue))
+			if pairCompletion != nil {
+				return nil, pairCompletion
+			}
+			entries = append(entries, pair)
+		}
+		return entries, nil
+	}
+
+	// A sequence of pairs, such as an array or another URLSearchParams.
+	completion = GetIteratorFromMethod(runtime, method, init)
+	if completion.Type != Normal {
+		return nil, completion
+	}
+
+	completion = IteratorToList(runtime, completion.Value.(*Iterator))
+	if completion.Type != Normal {
+		return nil, completion
+	}
+
+	for _, item := range completion.Value.([]*JavaScriptValue) {
+		if item.Type != TypeObject {
+			return nil, NewThrowCompletion(NewTypeError(runtime, "Each query pair must be an iterable [name, value] tuple"))
+		}
+
+		completion := GetIterator(runtime, item, IteratorKindSync)
+		if completion.Type != Normal {
+			return nil, completion
+		}
+
+		completion = IteratorToList(runtime, completion.Value.(*Iterator))
+		if completion.Type != Normal {
+			return nil, completion
+		}
+
+		values := completion.Value.([]*JavaScriptValue)
+		if len(values) != 2 {
+			return nil, NewThrowCompletion(NewTypeError(runtime, "Each query pair must be an iterable [name, value] tuple"))
+		}
+
+		pair, pairCompletion := urlSearchParamsPair(runtime, values[0], values[1])
+		if pairCompletion != nil {
+			return nil, pairCompletion
+		}
+		entries = append(entries, pair)
+	}
+
+	return entries, nil
+}
+
+// urlSearchParamsPair converts a name and a value to strings.
+func urlSearchParamsPair(runtime *Runtime, name *JavaScriptValue, value *JavaScriptValue) (URLSearchParam, *Completion) {
+	nameString, completion := toUSVString(runtime, name)
+	if completion != nil {
+		return URLSearchParam{}, completion
+	}
+
+	valueString, completion := toUSVString(runtime, value)
+	if completion != nil {
+		return URLSearchParam{}, completion
+	}
+
+	return URLSearchParam{Name: nameString, Value: valueString}, nil
+}
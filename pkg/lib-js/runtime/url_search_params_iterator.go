@@ -0,0 +1,67 @@
+package runtime
+
+// CreateURLSearchParamsIterator creates an iterator over the pairs of a URLSearchParams. Like an array iterator, it
+// sees the pairs added or removed while iterating.
+func CreateURLSearchParamsIterator(runtime *Runtime, list *URLSearchParamsList, kind ArrayIteratorKind) ObjectInterface {
+	closure := []Instruction{
+		EmitEvaluateNativeCallback(func(runtime *Runtime, vm *ExecutionVM) *Completion {
+			vm.ScratchSpace["index"] = int(0)
+			return nil
+		}),
+		// Loop starts here.
+		EmitEvaluateNativeCallback(func(runtime *Runtime, vm *ExecutionVM) *Completion {
+			// Break the loop if no more pairs are left.
+			index := vm.ScratchSpace["index"].(int)
+			if index >= len(list.Entries) {
+				return NewNormalCompletion(NewBooleanValue(true))
+			}
+
+			param := list.Entries[index]
+
+			var result *JavaScriptValue
+			switch kind {
+			case ArrayIteratorKindKey:
+				result = NewStringValue(param.Name)
+			case ArrayIteratorKindValue:
+				result = NewStringValue(param.Value)
+			default:
+				resultArray := CreateArrayFromList(runtime, []*JavaScriptValue{NewStringValue(param.Name), NewStringValue(param.Value)})
+				result = NewJavaScriptValue(TypeObject, resultArray)
+			}
+
+			vm.ScratchSpace["result"] = CreateIteratorResultObject(runtime, result, false)
+			return NewNormalCompletion(NewBooleanValue(false))
+		}),
+		// If the previous completion was true, break the loop and complete the closure.
+		EmitJumpIfTrue(3),
+		// Yield the result.
+		EmitYield(func(runtime *Runtime, vm *ExecutionVM) *JavaScriptValue {
+			result := vm.ScratchSpace["result"].(*JavaScriptValue)
+			vm.ScratchSpace["result"] = nil
+			return result
+		}),
+		// Increment the index.
+		EmitEvaluateNativeCallback(func(runtime *Runtime, vm *ExecutionVM) *Completion {
+			vm.ScratchSpace["index"] = vm.ScratchSpace["index"].(int) + 1
+			return nil
+		}),
+	}
+
+	// Loop back to just after the initial setup.
+	closure = append(closure, EmitJump(-len(closure)))
+
+	// Clean up the scratch space and return undefined.
+	cleanup := EmitEvaluateNativeCallback(func(runtime *Runtime, vm *ExecutionVM) *Completion {
+		delete(vm.ScratchSpace, "index")
+		delete(vm.ScratchSpace, "result")
+		return NewNormalCompletion(NewUndefinedValue())
+	})
+	closure = append(closure, cleanup)
+
+	return CreateIteratorFromClosure(
+		runtime,
+		closure,
+		"%URLSearchParamsIteratorPrototype%",
+		runtime.GetRunningRealm().GetIntrinsic(IntrinsicURLSearchParamsIteratorPrototype),
+	)
+}
@@ -0,0 +1,33 @@
+package runtime
+
+func NewURLSearchParamsIteratorPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicIteratorPrototype))
+}
+
+func DefineURLSearchParamsIteratorPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// %URLSearchParamsIteratorPrototype%.next
+	DefineBuiltinFunction(runtime, prototype, "next", URLSearchParamsIteratorPrototypeNext, 0)
+
+	// %URLSearchParamsIteratorPrototype%[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("URLSearchParams Iterator"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func URLSearchParamsIteratorPrototypeNext(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	object, ok := thisArg.Value.(*Object)
+	if thisArg.Type != TypeObject || !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "%URLSearchParamsIteratorPrototype%.next called on incompatible receiver"))
+	}
+
+	return GeneratorResume(runtime, object, nil, "%URLSearchParamsIteratorPrototype%")
+}
@@ -0,0 +1,422 @@
+package runtime
+
+import (
+	"slices"
+	"strconv"
+)
+
+func NewURLSearchParamsPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineURLSearchParamsPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// URLSearchParams.prototype.size
+	DefineBuiltinAccessorFunction(runtime, prototype, "size", URLSearchParamsPrototypeSizeGetter, nil, &AccessorPropertyDescriptor{
+		Enumerable:   true,
+		Configurable: true,
+	})
+
+	// URLSearchParams.prototype.append
+	DefineBuiltinFunction(runtime, prototype, "append", URLSearchParamsPrototypeAppend, 2)
+
+	// URLSearchParams.prototype.delete
+	DefineBuiltinFunction(runtime, prototype, "delete", URLSearchParamsPrototypeDelete, 1)
+
+	// URLSearchParams.prototype.get
+	DefineBuiltinFunction(runtime, prototype, "get", URLSearchParamsPrototypeGet, 1)
+
+	// URLSearchParams.prototype.getAll
+	DefineBuiltinFunction(runtime, prototype, "getAll", URLSearchParamsPrototypeGetAll, 1)
+
+	// URLSearchParams.prototype.has
+	DefineBuiltinFunction(runtime, prototype, "has", URLSearchParamsPrototypeHas, 1)
+
+	// URLSearchParams.prototype.set
+	DefineBuiltinFunction(runtime, prototype, "set", URLSearchParamsPrototypeSet, 2)
+
+	// URLSearchParams.prototype.sort
+	DefineBuiltinFunction(runtime, prototype, "sort", URLSearchParamsPrototypeSort, 0)
+
+	// URLSearchParams.prototype.toString
+	DefineBuiltinFunction(runtime, prototype, "toString", URLSearchParamsPrototypeToString, 0)
+
+	// URLSearchParams.prototype.forEach
+	DefineBuiltinFunction(runtime, prototype, "forEach", URLSearchParamsPrototypeForEach, 1)
+
+	// URLSearchParams.prototype.entries
+	DefineBuiltinFunction(runtime, prototype, "entries", URLSearchParamsPrototypeEntries, 0)
+
+	// URLSearchParams.prototype.keys
+	DefineBuiltinFunction(runtime, prototype, "keys", URLSearchParamsPrototypeKeys, 0)
+
+	// URLSearchParams.prototype.values
+	DefineBuiltinFunction(runtime, prototype, "values", URLSearchParamsPrototypeValues, 0)
+
+	// URLSearchParams.prototype[%Symbol.iterator%]
+	DefineBuiltinSymbolFunction(runtime, prototype, runtime.SymbolIterator, URLSearchParamsPrototypeEntries, 0)
+
+	// URLSearchParams.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("URLSearchParams"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func thisURLSearchParams(runtime *Runtime, thisArg *JavaScriptValue, method string) (*URLSearchParamsList, *Completion) {
+	if thisArg.Type == TypeObject {
+		if object, ok := thisArg.Value.(*Object); ok && object.URLSearchParams != nil {
+			return object.URLSearchParams, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "URLSearchParams.prototype."+method+" called on incompatible receiver"))
+}
+
+// urlSearchParamsArguments converts the first count arguments of a URLSearchParams method to strings, throwing if
+// fewer than required were passed. Arguments that weren't passed are returned as nil.
+func urlSearchParamsArguments(
+	runtime *Runtime,
+	arguments []*JavaScriptValue,
+	method string,
+	required int,
+	count int,
+) ([]*string, *Completion) {
+	if len(arguments) < required {
+		return nil, NewThrowCompletion(NewTypeError(
+			runtime,
+			"URLSearchParams.prototype."+method+" requires "+strconv.Itoa(required)+" arguments, but only "+strconv.Itoa(len(arguments))+" present",
+		))
+	}
+
+	values := make([]*string, count)
+	for idx := range count {
+		if idx >= len(arguments) || (idx >= required && arguments[idx].Type == TypeUndefined) {
+			continue
+		}
+
+		value, completion := toUSVString(runtime, arguments[idx])
+		if completion != nil {
+			return nil, completion
+		}
+		values[idx] = &value
+	}
+
+	return values, nil
+}
+
+// get URLSearchParams.prototype.size
+func URLSearchParamsPrototypeSizeGetter(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "size")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewNumberValue(float64(len(list.Entries)), false))
+}
+
+// URLSearchParams.prototype.append(name, value)
+func URLSearchParamsPrototypeAppend(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "append")
+	if completion != nil {
+		return completion
+	}
+
+	values, completion := urlSearchParamsArguments(runtime, arguments, "append", 2, 2)
+	if completion != nil {
+		return completion
+	}
+
+	list.Entries = append(list.Entries, URLSearchParam{Name: *values[0], Value: *values[1]})
+	list.Update()
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// URLSearchParams.prototype.delete(name, value)
+func URLSearchParamsPrototypeDelete(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "delete")
+	if completion != nil {
+		return completion
+	}
+
+	values, completion := urlSearchParamsArguments(runtime, arguments, "delete", 1, 2)
+	if completion != nil {
+		return completion
+	}
+
+	list.Entries = slices.DeleteFunc(list.Entries, func(param URLSearchParam) bool {
+		return param.Name == *values[0] && (values[1] == nil || param.Value == *values[1])
+	})
+	list.Update()
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// URLSearchParams.prototype.get(name)
+func URLSearchParamsPrototypeGet(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "get")
+	if completion != nil {
+		return completion
+	}
+
+	values, completion := urlSearchParamsArguments(runtime, arguments, "get", 1, 1)
+	if completion != nil {
+		return completion
+	}
+
+	for _, param := range list.Entries {
+		if param.Name == *values[0] {
+			return NewNormalCompletion(NewStringValue(param.Value))
+		}
+	}
+
+	return NewNormalCompletion(NewNullValue())
+}
+
+// URLSearchParams.prototype.getAll(name)
+func URLSearchParamsPrototypeGetAll(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "getAll")
+	if completion != nil {
+		return completion
+	}
+
+	values, completion := urlSearchParamsArguments(runtime, arguments, "getAll", 1, 1)
+	if completion != nil {
+		return completion
+	}
+
+	matches := []*JavaScriptValue{}
+	for _, param := range list.Entries {
+		if param.Name == *values[0] {
+			matches = append(matches, NewStringValue(param.Value))
+		}
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, CreateArrayFromList(runtime, matches)))
+}
+
+// URLSearchParams.prototype.has(name, value)
+func URLSearchParamsPrototypeHas(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "has")
+	if completion != nil {
+		return completion
+	}
+
+	values, completion := urlSearchParamsArguments(runtime, arguments, "has", 1, 2)
+	if completion != nil {
+		return completion
+	}
+
+	found := slices.ContainsFunc(list.Entries, func(param URLSearchParam) bool {
+		return param.Name == *values[0] && (values[1] == nil || param.Value == *values[1])
+	})
+
+	return NewNormalCompletion(NewBooleanValue(found))
+}
+
+// URLSearchParams.prototype.set(name, value)
+func URLSearchParamsPrototypeSet(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "set")
+	if completion != nil {
+		return completion
+	}
+
+	values, completion := urlSearchParamsArguments(runtime, arguments, "set", 2, 2)
+	if completion != nil {
+		return completion
+	}
+	name, value := *values[0], *values[1]
+
+	// Replace the value of the first pair with the name and remove the others.
+	idx := slices.IndexFunc(list.Entries, func(param URLSearchParam) bool {
+		return param.Name == name
+	})
+	if idx < 0 {
+		list.Entries = append(list.Entries, URLSearchParam{Name: name, Value: value})
+	} else {
+		list.Entries[idx].Value = value
+		rest := slices.DeleteFunc(list.Entries[idx+1:], func(param URLSearchParam) bool {
+			return param.Name == name
+		})
+		list.Entries = list.Entries[:idx+1+len(rest)]
+	}
+	list.Update()
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// URLSearchParams.prototype.sort()
+func URLSearchParamsPrototypeSort(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "sort")
+	if completion != nil {
+		return completion
+	}
+
+	slices.SortStableFunc(list.Entries, func(a URLSearchParam, b URLSearchParam) int {
+		return compareUTF16(a.Name, b.Name)
+	})
+	list.Update()
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// URLSearchParams.prototype.toString()
+func URLSearchParamsPrototypeToString(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "toString")
+	if completion != nil {
+		return completion
+	}
+
+	// Percent-encoding makes the output up to three times longer than the names and values, so it is checked once it
+	// is serialized.
+	serialized := SerializeURLEncoded(list.Entries)
+	if completion := runtime.CheckStringLength(len(serialized)); completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeString, runtime.trackString(&String{Value: serialized})))
+}
+
+// URLSearchParams.prototype.forEach(callback, thisArg)
+func URLSearchParamsPrototypeForEach(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "forEach")
+	if completion != nil {
+		return completion
+	}
+
+	for idx := range 2 {
+		if idx >= len(arguments) {
+			arguments = append(arguments, NewUndefinedValue())
+		}
+	}
+
+	callbackFunc, ok := arguments[0].Value.(FunctionInterface)
+	if arguments[0].Type != TypeObject || !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "Callback is not a function."))
+	}
+
+	// The callback may change the list, so its length is read on every iteration.
+	for idx := 0; idx < len(list.Entries); idx++ {
+		param := list.Entries[idx]
+		completion := callbackFunc.Call(runtime, arguments[1], []*JavaScriptValue{
+			NewStringValue(param.Value),
+			NewStringValue(param.Name),
+			thisArg,
+		})
+		if completion.Type != Normal {
+			return completion
+		}
+	}
+
+	return NewNormalCompletion(NewUndefinedValue())
+}
+
+// URLSearchParams.prototype.entries()
+func URLSearchParamsPrototypeEntries(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "entries")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, CreateURLSearchParamsIterator(runtime, list, ArrayIteratorKindEntry)))
+}
+
+// URLSearchParams.prototype.keys()
+func URLSearchParamsPrototypeKeys(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "keys")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, CreateURLSearchParamsIterator(runtime, list, ArrayIteratorKindKey)))
+}
+
+// URLSearchParams.prototype.values()
+func URLSearchParamsPrototypeValues(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	list, completion := thisURLSearchParams(runtime, thisArg, "values")
+	if completion != nil {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, CreateURLSearchParamsIterator(runtime, list, ArrayIteratorKindValue)))
+}
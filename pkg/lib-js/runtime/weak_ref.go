@@ -0,0 +1,170 @@
+package runtime
+
+import (
+	"reflect"
+	goruntime "runtime"
+	"unsafe"
+	"weak"
+)
+
+// WeakReference refers to an object or a symbol without keeping it alive. The zero WeakReference is empty.
+type WeakReference struct {
+	pointer   weak.Pointer[byte]
+	pointerTo reflect.Type
+	valueType JavaScriptType
+}
+
+// NewWeakReference returns a weak reference to a value for which CanBeHeldWeakly is true.
+func NewWeakReference(value *JavaScriptValue) WeakReference {
+	pointer := reflect.ValueOf(value.Value)
+	return WeakReference{
+		pointer:   weak.Make((*byte)(pointer.UnsafePointer())),
+		pointerTo: pointer.Type(),
+		valueType: value.Type,
+	}
+}
+
+// Deref returns the value, or nil if it was collected or the reference is empty.
+func (w WeakReference) Deref() *JavaScriptValue {
+	pointer := w.pointer.Value()
+	if pointer == nil {
+		return nil
+	}
+	return NewJavaScriptValue(w.valueType, reflect.NewAt(w.pointerTo.Elem(), unsafe.Pointer(pointer)).Interface())
+}
+
+// IsEmpty reports whether the reference was never set.
+func (w WeakReference) IsEmpty() bool {
+	return w.pointerTo == nil
+}
+
+// Refers reports whether the reference was made to value, even if value has been collected since.
+func (w WeakReference) Refers(value *JavaScriptValue) bool {
+	if w.IsEmpty() || (value.Type != TypeObject && value.Type != TypeSymbol) {
+		return false
+	}
+
+	other := NewWeakReference(value)
+	return w.pointer == other.pointer && w.pointerTo == other.pointerTo
+}
+
+// CanBeHeldWeakly reports whether a value can be the target of a WeakRef or a FinalizationRegistry: objects, and
+// symbols that aren't registered with Symbol.for.
+func CanBeHeldWeakly(runtime *Runtime, value *JavaScriptValue) bool {
+	switch value.Type {
+	case TypeObject:
+		return true
+	case TypeSymbol:
+		_, registered := runtime.SymbolRegistry.KeyFor(value.Value.(*Symbol))
+		return !registered
+	}
+	return false
+}
+
+// AddToKeptObjects keeps an object alive until the end of the current job, so a WeakRef that was just created or
+// dereferenced keeps returning it while the job runs.
+func (r *Runtime) AddToKeptObjects(value *JavaScriptValue) {
+	r.keptObjects = append(r.keptObjects, value)
+}
+
+// ClearKeptObjects lets the objects kept for the job that finished be collected.
+func (r *Runtime) ClearKeptObjects() {
+	r.keptObjects = nil
+}
+
+// FinalizationRegistryCell is a target registered with a FinalizationRegistry, with the value its cleanup callback is
+// called with and the token that unregisters it.
+type FinalizationRegistryCell struct {
+	Target          WeakReference
+	HeldValue       *JavaScriptValue
+	UnregisterToken WeakReference
+}
+
+// registerFinalization records that a registry has cells, and asks for the registries to be checked once target is
+// collected.
+func (r *Runtime) registerFinalization(registry *Object, target *JavaScriptValue) {
+	if r.finalizationRegistries == nil {
+		r.finalizationRegistries = make(map[*Object]struct{})
+	}
+	r.finalizationRegistries[registry] = struct{}{}
+
+	// The cleanup runs on another goroutine, which may only enqueue jobs. It refers to the runtime weakly so that
+	// targets that outlive the runtime don't keep it alive.
+	goruntime.AddCleanup((*byte)(reflect.ValueOf(target.Value).UnsafePointer()), func(runtime weak.Pointer[Runtime]) {
+		if r := runtime.Value(); r != nil {
+			r.queueFinalizationCheck()
+		}
+	}, weak.Make(r))
+}
+
+// queueFinalizationCheck enqueues a job that checks the registries for collected targets, unless one is queued.
+func (r *Runtime) queueFinalizationCheck() {
+	if !r.finalizationCheckQueued.CompareAndSwap(false, true) {
+		return
+	}
+
+	r.Jobs.enqueue(&Job{Callback: func(runtime *Runtime) *Completion {
+		runtime.finalizationCheckQueued.Store(false)
+		runtime.checkFinalizationRegistries()
+		return nil
+	}})
+}
+
+// checkFinalizationRegistries enqueues a cleanup job for each registry with a collected target.
+func (r *Runtime) checkFinalizationRegistries() {
+	for registry := range r.finalizationRegistries {
+		if len(registry.FinalizationRegistryCells) == 0 {
+			delete(r.finalizationRegistries, registry)
+			continue
+		}
+
+		for _, cell := range registry.FinalizationRegistryCells {
+			if cell.Target.Deref() == nil {
+				r.HostEnqueueFinalizationRegistryCleanupJob(registry)
+				break
+			}
+		}
+	}
+}
+
+// CollectGarbage runs the Go garbage collector, so WeakRefs to objects scripts no longer reach deref to undefined,
+// and enqueues the cleanup jobs of FinalizationRegistries whose targets were collected. Objects kept alive for the
+// running job aren't collected. Without it, collection happens whenever Go collects, and the cleanup jobs are
+// enqueued some time after.
+func (r *Runtime) CollectGarbage() {
+	goruntime.GC()
+	r.checkFinalizationRegistries()
+}
+
+func (r *Runtime) HostEnqueueFinalizationRegistryCleanupJob(registry *Object) {
+	r.HostEnqueueGenericJob(func(runtime *Runtime) *Completion {
+		return CleanupFinalizationRegistry(runtime, registry)
+	}, registry.FinalizationRegistryRealm)
+}
+
+func CleanupFinalizationRegistry(runtime *Runtime, registry *Object) *Completion {
+	callback := registry.FinalizationRegistryCleanupCallback
+
+	for {
+		idx := -1
+		for cellIdx, cell := range registry.FinalizationRegistryCells {
+			if cell.Target.Deref() == nil {
+				idx = cellIdx
+				break
+			}
+		}
+		if idx < 0 {
+			break
+		}
+
+		cell := registry.FinalizationRegistryCells[idx]
+		registry.FinalizationRegistryCells = append(registry.FinalizationRegistryCells[:idx], registry.FinalizationRegistryCells[idx+1:]...)
+
+		completion := Call(runtime, callback, NewUndefinedValue(), []*JavaScriptValue{cell.HeldValue})
+		if completion.Type != Normal {
+			return completion
+		}
+	}
+
+	return NewUnusedCompletion()
+}
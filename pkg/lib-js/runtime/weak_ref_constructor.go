@@ -0,0 +1,59 @@
+package runtime
+
+func NewWeakRefConstructor(runtime *Runtime) *FunctionObject {
+	realm := runtime.GetRunningRealm()
+	constructor := CreateBuiltinFunction(
+		runtime,
+		WeakRefConstructor,
+		1,
+		NewStringValue("WeakRef"),
+		realm,
+		realm.GetIntrinsic(IntrinsicFunctionPrototype),
+	)
+	MakeConstructor(runtime, constructor)
+
+	// WeakRef.prototype
+	constructor.DefineOwnProperty(runtime, NewStringValue("prototype"), &DataPropertyDescriptor{
+		Value:        NewJavaScriptValue(TypeObject, realm.GetIntrinsic(IntrinsicWeakRefPrototype)),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: false,
+	})
+
+	return constructor
+}
+
+func WeakRefConstructor(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	if newTarget == nil || newTarget.Type == TypeUndefined {
+		return NewThrowCompletion(NewTypeError(runtime, "WeakRef constructor requires 'new'"))
+	}
+
+	target := NewUndefinedValue()
+	if len(arguments) > 0 {
+		target = arguments[0]
+	}
+
+	if !CanBeHeldWeakly(runtime, target) {
+		return NewThrowCompletion(NewTypeError(runtime, "WeakRef target must be an object or a non-registered symbol"))
+	}
+
+	completion := OrdinaryCreateFromConstructor(runtime, newTarget.Value.(FunctionInterface), IntrinsicWeakRefPrototype)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	weakRefVal := completion.Value.(*JavaScriptValue)
+	weakRef := weakRefVal.Value.(*Object)
+
+	runtime.AddToKeptObjects(target)
+	weakRef.IsWeakRef = true
+	weakRef.WeakRefTarget = NewWeakReference(target)
+
+	return NewNormalCompletion(weakRefVal)
+}
@@ -0,0 +1,56 @@
+package runtime
+
+func NewWeakRefPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicObjectPrototype))
+}
+
+func DefineWeakRefPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// WeakRef.prototype.deref
+	DefineBuiltinFunction(runtime, prototype, "deref", WeakRefPrototypeDeref, 0)
+
+	// WeakRef.prototype[%Symbol.toStringTag%]
+	prototype.DefineOwnProperty(runtime, runtime.SymbolToStringTag, &DataPropertyDescriptor{
+		Value:        NewStringValue("WeakRef"),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+}
+
+func WeakRefPrototypeDeref(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	weakRef, ok := thisWeakRef(thisArg)
+	if !ok {
+		return NewThrowCompletion(NewTypeError(runtime, "WeakRef.prototype.deref called on incompatible receiver"))
+	}
+
+	return NewNormalCompletion(WeakRefDeref(runtime, weakRef))
+}
+
+func thisWeakRef(thisArg *JavaScriptValue) (*Object, bool) {
+	if thisArg.Type != TypeObject {
+		return nil, false
+	}
+
+	object, ok := thisArg.Value.(*Object)
+	if !ok || !object.IsWeakRef {
+		return nil, false
+	}
+
+	return object, true
+}
+
+func WeakRefDeref(runtime *Runtime, weakRef *Object) *JavaScriptValue {
+	target := weakRef.WeakRefTarget.Deref()
+	if target == nil {
+		return NewUndefinedValue()
+	}
+
+	runtime.AddToKeptObjects(target)
+	return target
+}
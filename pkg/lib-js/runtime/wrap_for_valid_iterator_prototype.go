@@ -0,0 +1,65 @@
+package runtime
+
+func NewWrapForValidIteratorPrototype(runtime *Runtime) ObjectInterface {
+	return OrdinaryObjectCreate(runtime.GetRunningRealm().GetIntrinsic(IntrinsicIteratorPrototype))
+}
+
+func DefineWrapForValidIteratorPrototypeProperties(runtime *Runtime, prototype ObjectInterface) {
+	// %WrapForValidIteratorPrototype%.next
+	DefineBuiltinFunction(runtime, prototype, "next", WrapForValidIteratorPrototypeNext, 0)
+
+	// %WrapForValidIteratorPrototype%.return
+	DefineBuiltinFunction(runtime, prototype, "return", WrapForValidIteratorPrototypeReturn, 0)
+}
+
+func thisWrapForValidIteratorValue(runtime *Runtime, value *JavaScriptValue) (*Iterator, *Completion) {
+	if value.Type == TypeObject {
+		if object, ok := value.Value.(*Object); ok && object.IteratedIterator != nil {
+			return object.IteratedIterator, nil
+		}
+	}
+
+	return nil, NewThrowCompletion(NewTypeError(runtime, "Iterator wrapper method called on incompatible receiver"))
+}
+
+func WrapForValidIteratorPrototypeNext(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	iteratorRecord, errCompletion := thisWrapForValidIteratorValue(runtime, thisArg)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	return Call(runtime, iteratorRecord.Next, iteratorRecord.Iterator, []*JavaScriptValue{})
+}
+
+func WrapForValidIteratorPrototypeReturn(
+	runtime *Runtime,
+	function *FunctionObject,
+	thisArg *JavaScriptValue,
+	arguments []*JavaScriptValue,
+	newTarget *JavaScriptValue,
+) *Completion {
+	iteratorRecord, errCompletion := thisWrapForValidIteratorValue(runtime, thisArg)
+	if errCompletion != nil {
+		return errCompletion
+	}
+
+	iterator := iteratorRecord.Iterator
+
+	completion := GetMethod(runtime, iterator, returnString)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	returnMethod := completion.Value.(*JavaScriptValue)
+	if returnMethod.Type == TypeUndefined {
+		return NewNormalCompletion(CreateIteratorResultObject(runtime, NewUndefinedValue(), true))
+	}
+
+	return Call(runtime, returnMethod, iterator, []*JavaScriptValue{})
+}
@@ -0,0 +1,213 @@
+package runtime
+
+import "math"
+
+// WrappedFunction is a function that crosses a ShadowRealm boundary. Calling it calls the target in its own realm,
+// passing primitives as they are and wrapping callable objects, so no object of one realm is reachable from the other.
+type WrappedFunction struct {
+	Prototype       ObjectInterface
+	Properties      *PropertyStore
+	Extensible      bool
+	PrivateElements []*PrivateElement
+
+	WrappedTargetFunction *JavaScriptValue
+	Realm                 *Realm
+}
+
+func WrappedFunctionCreate(runtime *Runtime, callerRealm *Realm, target *JavaScriptValue) *Completion {
+	wrapped := &WrappedFunction{
+		Prototype:             callerRealm.GetIntrinsic(IntrinsicFunctionPrototype),
+		Properties:            NewPropertyStore(),
+		Extensible:            true,
+		PrivateElements:       make([]*PrivateElement, 0),
+		WrappedTargetFunction: target,
+		Realm:                 callerRealm,
+	}
+
+	completion := CopyNameAndLength(runtime, wrapped, target.Value.(ObjectInterface), "", 0)
+	if completion.Type == Throw {
+		return NewThrowCompletion(NewTypeError(runtime, "Cannot wrap a function whose name or length throws"))
+	}
+	if completion.Type != Normal {
+		return completion
+	}
+
+	return NewNormalCompletion(NewJavaScriptValue(TypeObject, wrapped))
+}
+
+func CopyNameAndLength(runtime *Runtime, function ObjectInterface, target ObjectInterface, prefix string, argCount int) *Completion {
+	length := 0.0
+
+	completion := HasOwnProperty(runtime, target, lengthStr)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	if completion.Value.(*JavaScriptValue).Value.(*Boolean).Value {
+		completion = target.Get(runtime, lengthStr, NewJavaScriptValue(TypeObject, target))
+		if completion.Type != Normal {
+			return completion
+		}
+
+		targetLength := completion.Value.(*JavaScriptValue)
+		if targetLength.Type == TypeNumber {
+			completion = ToIntegerOrInfinity(runtime, targetLength)
+			if completion.Type != Normal {
+				return completion
+			}
+
+			length = math.Max(completion.Value.(*JavaScriptValue).Value.(*Number).Value-float64(argCount), 0)
+		}
+	}
+
+	completion = DefinePropertyOrThrow(runtime, function, lengthStr, &DataPropertyDescriptor{
+		Value:        NewNumberValue(length, false),
+		Writable:     false,
+		Enumerable:   false,
+		Configurable: true,
+	})
+	if completion.Type != Normal {
+		return completion
+	}
+
+	completion = target.Get(runtime, nameStr, NewJavaScriptValue(TypeObject, target))
+	if completion.Type != Normal {
+		return completion
+	}
+
+	targetName := completion.Value.(*JavaScriptValue)
+	if targetName.Type != TypeString {
+		targetName = NewStringValue("")
+	}
+
+	SetFunctionNameWithPrefix(runtime, function, targetName, prefix)
+	return NewUnusedCompletion()
+}
+
+// GetWrappedValue prepares a value to cross into callerRealm: primitives cross as they are, callable objects are
+// wrapped, and other objects throw a TypeError.
+func GetWrappedValue(runtime *Runtime, callerRealm *Realm, value *JavaScriptValue) *Completion {
+	if value.Type != TypeObject {
+		return NewNormalCompletion(value)
+	}
+
+	if !IsCallable(value) {
+		return NewThrowCompletion(NewTypeError(runtime, "Cannot pass a non-callable object across a ShadowRealm boundary"))
+	}
+
+	return WrappedFunctionCreate(runtime, callerRealm, value)
+}
+
+func (o *WrappedFunction) Call(runtime *Runtime, thisArg *JavaScriptValue, arguments []*JavaScriptValue) *Completion {
+	runtime.PushExecutionContext(&ExecutionContext{
+		Realm: o.Realm,
+	})
+	defer runtime.PopExecutionContext()
+
+	completion := GetFunctionRealm(runtime, o.WrappedTargetFunction.Value.(FunctionInterface))
+	if completion.Type != Normal {
+		return completion
+	}
+
+	targetRealm := completion.Value.(*Realm)
+
+	wrappedArgs := make([]*JavaScriptValue, 0, len(arguments))
+	for _, argument := range arguments {
+		completion := GetWrappedValue(runtime, targetRealm, argument)
+		if completion.Type != Normal {
+			return completion
+		}
+		wrappedArgs = append(wrappedArgs, completion.Value.(*JavaScriptValue))
+	}
+
+	completion = GetWrappedValue(runtime, targetRealm, thisArg)
+	if completion.Type != Normal {
+		return completion
+	}
+
+	wrappedThis := completion.Value.(*JavaScriptValue)
+
+	completion = Call(runtime, o.WrappedTargetFunction, wrappedThis, wrappedArgs)
+	if completion.Type == Normal {
+		return GetWrappedValue(runtime, o.Realm, completion.Value.(*JavaScriptValue))
+	}
+	if completion.Type == Throw {
+		// The error of the other realm mustn't leak, only its description.
+		return NewThrowCompletion(NewTypeError(runtime, shadowRealmErrorMessage(runtime, completion.Value.(*JavaScriptValue))))
+	}
+
+	return completion
+}
+
+func (o *WrappedFunction) Construct(runtime *Runtime, arguments []*JavaScriptValue, newTarget *JavaScriptValue) *Completion {
+	return NewThrowCompletion(NewTypeError(runtime, "Wrapped function is not a constructor"))
+}
+
+func (o *WrappedFunction) GetPrototype() ObjectInterface {
+	return o.Prototype
+}
+
+func (o *WrappedFunction) SetPrototype(prototype ObjectInterface) {
+	o.Prototype = prototype
+}
+
+func (o *WrappedFunction) GetProperties() *PropertyStore {
+	return o.Properties
+}
+
+func (o *WrappedFunction) IsExtensible(runtime *Runtime) *Completion {
+	return NewNormalCompletion(NewBooleanValue(o.Extensible))
+}
+
+func (o *WrappedFunction) GetPrototypeOf(runtime *Runtime) *Completion {
+	return OrdinaryGetPrototypeOf(o)
+}
+
+func (o *WrappedFunction) SetPrototypeOf(runtime *Runtime, prototype *JavaScriptValue) *Completion {
+	return OrdinarySetPrototypeOf(runtime, o, prototype)
+}
+
+func (o *WrappedFunction) GetOwnProperty(runtime *Runtime, key *JavaScriptValue) *Completion {
+	return OrdinaryGetOwnProperty(runtime, o, key)
+}
+
+func (o *WrappedFunction) HasProperty(runtime *Runtime, key *JavaScriptValue) *Completion {
+	return OrdinaryHasProperty(runtime, o, key)
+}
+
+func (o *WrappedFunction) DefineOwnProperty(runtime *Runtime, key *JavaScriptValue, descriptor PropertyDescriptor) *Completion {
+	return OrdinaryDefineOwnProperty(runtime, o, key, descriptor)
+}
+
+func (o *WrappedFunction) Set(runtime *Runtime, key *JavaScriptValue, value *JavaScriptValue, receiver *JavaScriptValue) *Completion {
+	return OrdinarySet(runtime, o, key, value, receiver)
+}
+
+func (o *WrappedFunction) Get(runtime *Runtime, key *JavaScriptValue, receiver *JavaScriptValue) *Completion {
+	return OrdinaryGet(runtime, o, key, receiver)
+}
+
+func (o *WrappedFunction) Delete(runtime *Runtime, key *JavaScriptValue) *Completion {
+	return OrdinaryDelete(runtime, o, key)
+}
+
+func (o *WrappedFunction) OwnPropertyKeys(runtime *Runtime) *Completion {
+	return NewNormalCompletion(OrdinaryOwnPropertyKeys(o))
+}
+
+func (o *WrappedFunction) PreventExtensions(runtime *Runtime) *Completion {
+	o.Extensible = false
+	return NewNormalCompletion(NewBooleanValue(true))
+}
+
+func (o *WrappedFunction) GetPrivateElements() []*PrivateElement {
+	return o.PrivateElements
+}
+
+func (o *WrappedFunction) SetPrivateElements(privateElements []*PrivateElement) {
+	o.PrivateElements = privateElements
+}
+
+func (o *WrappedFunction) HasConstructMethod() bool {
+	return false
+}